@@ -17,16 +17,19 @@ import (
 	"io"
 	"mime"
 	"net/http"
+	"strconv"
 
 	"github.com/go-chi/chi/v5"
 	dshelp "github.com/ipfs/boxo/datastore/dshelp"
 	dag "github.com/ipfs/boxo/ipld/merkledag"
 	"github.com/ipfs/go-cid"
 	ds "github.com/ipfs/go-datastore"
+	ipld "github.com/ipfs/go-ipld-format"
 	"github.com/multiformats/go-multihash"
 	"github.com/pkg/errors"
 
 	corecrdt "github.com/sourcenetwork/defradb/core/crdt"
+	"github.com/sourcenetwork/defradb/datastore"
 	"github.com/sourcenetwork/defradb/events"
 )
 
@@ -34,6 +37,7 @@ const (
 	contentTypeJSON           = "application/json"
 	contentTypeGraphQL        = "application/graphql"
 	contentTypeFormURLEncoded = "application/x-www-form-urlencoded"
+	contentTypeOctetStream    = "application/octet-stream"
 )
 
 func rootHandler(rw http.ResponseWriter, req *http.Request) {
@@ -276,6 +280,41 @@ func getBlockHandler(rw http.ResponseWriter, req *http.Request) {
 	)
 }
 
+// getBlobHandler streams the content of the blob with the given CID, chunk by chunk.
+func getBlobHandler(rw http.ResponseWriter, req *http.Request) {
+	cID, err := cid.Decode(chi.URLParam(req, "cid"))
+	if err != nil {
+		handleErr(req.Context(), rw, err, http.StatusBadRequest)
+		return
+	}
+
+	db, err := dbFromContext(req.Context())
+	if err != nil {
+		handleErr(req.Context(), rw, err, http.StatusInternalServerError)
+		return
+	}
+
+	root, err := datastore.GetBlobRoot(req.Context(), db.Blockstore(), cID)
+	if err != nil {
+		if ipld.IsNotFound(err) {
+			handleErr(req.Context(), rw, err, http.StatusNotFound)
+			return
+		}
+		handleErr(req.Context(), rw, err, http.StatusInternalServerError)
+		return
+	}
+
+	rw.Header().Set("Content-Type", contentTypeOctetStream)
+	rw.Header().Set("Content-Length", strconv.FormatUint(datastore.BlobSize(root), 10))
+	rw.WriteHeader(http.StatusOK)
+
+	err = datastore.WriteBlob(req.Context(), db.Blockstore(), root, rw)
+	if err != nil {
+		// The status has already been sent, the response is cut short.
+		log.ErrorE(req.Context(), "Failed to write blob", err)
+	}
+}
+
 func peerIDHandler(rw http.ResponseWriter, req *http.Request) {
 	peerID, ok := req.Context().Value(ctxPeerID{}).(string)
 	if !ok || peerID == "" {
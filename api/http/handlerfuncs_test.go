@@ -27,8 +27,10 @@ import (
 	"github.com/ipfs/go-cid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 
 	"github.com/sourcenetwork/defradb/client"
+	"github.com/sourcenetwork/defradb/datastore"
 	badgerds "github.com/sourcenetwork/defradb/datastore/badger/v3"
 	"github.com/sourcenetwork/defradb/db"
 	"github.com/sourcenetwork/defradb/errors"
@@ -488,6 +490,59 @@ mutation {
 	assert.Contains(t, users[0].Key, "bae-")
 }
 
+func TestExecGQLHandlerWithPeerIDRecordsCommitAuthor(t *testing.T) {
+	ctx := context.Background()
+	defra := testNewInMemoryDB(t, ctx)
+	defer defra.Close(ctx)
+	defra.SetPeerID("12D3KooWFpi6VTYKLtxUftJKEyfX8jDfKi8n15eaygH8ggfYFZbR")
+
+	// load schema
+	testLoadSchema(t, ctx, defra)
+
+	// add document
+	stmt := `
+mutation {
+	create_user(data: "{\"age\": 31, \"name\": \"Bob\"}", message: "Add Bob") {
+		_key
+	}
+}`
+
+	buf := bytes.NewBuffer([]byte(stmt))
+	users := []testUser{}
+	resp := DataResponse{
+		Data: &users,
+	}
+	testRequest(testOptions{
+		Testing:        t,
+		DB:             defra,
+		Method:         "POST",
+		Path:           GraphQLPath,
+		Body:           buf,
+		Headers:        map[string]string{"Content-Type": contentTypeGraphQL},
+		ExpectedStatus: 200,
+		ResponseData:   &resp,
+		ServerOptions: serverOptions{
+			peerID: "12D3KooWFpi6VTYKLtxUftJKEyfX8jDfKi8n15eaygH8ggfYFZbR",
+		},
+	})
+
+	result := defra.ExecRequest(ctx, `query {
+		commits(filter: {author: {_eq: "12D3KooWFpi6VTYKLtxUftJKEyfX8jDfKi8n15eaygH8ggfYFZbR"}}) {
+			dockey
+			message
+		}
+	}`)
+	require.Empty(t, result.GQL.Errors)
+
+	commits := result.GQL.Data.([]map[string]any)
+	// the composite commit and the commits of both fields
+	require.Len(t, commits, 3)
+	for _, commit := range commits {
+		assert.Equal(t, users[0].Key, commit["dockey"])
+		assert.Equal(t, "Add Bob", commit["message"])
+	}
+}
+
 func TestExecGQLHandlerContentTypeText(t *testing.T) {
 	ctx := context.Background()
 	defra := testNewInMemoryDB(t, ctx)
@@ -899,6 +954,54 @@ query {
 	}
 }
 
+func TestGetBlobHandlerWithNotFoundBlob(t *testing.T) {
+	t.Cleanup(CleanupEnv)
+	env = "dev"
+	ctx := context.Background()
+	defra := testNewInMemoryDB(t, ctx)
+	defer defra.Close(ctx)
+
+	errResponse := ErrorResponse{}
+	testRequest(testOptions{
+		Testing:        t,
+		DB:             defra,
+		Method:         "GET",
+		Path:           BlobsPath + "/bafybeidembipteezluioakc2zyke4h5fnj4rr3uaougfyxd35u3qzefzhm",
+		Body:           nil,
+		ExpectedStatus: 404,
+		ResponseData:   &errResponse,
+	})
+
+	assert.Equal(t, http.StatusNotFound, errResponse.Errors[0].Extensions.Status)
+	assert.Equal(t, "ipld: could not find bafybeidembipteezluioakc2zyke4h5fnj4rr3uaougfyxd35u3qzefzhm", errResponse.Errors[0].Message)
+}
+
+func TestGetBlobHandlerWithValidBlob(t *testing.T) {
+	ctx := context.Background()
+	defra := testNewInMemoryDB(t, ctx)
+	defer defra.Close(ctx)
+
+	content := bytes.Repeat([]byte("Source Inc"), datastore.BlobChunkSize/4)
+	blobCID, err := datastore.PutBlob(ctx, defra.Blockstore(), content)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	req, err := http.NewRequest("GET", BlobsPath+"/"+blobCID.String(), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	h := newHandler(defra, serverOptions{})
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusOK, rec.Result().StatusCode)
+	assert.Equal(t, "application/octet-stream", rec.Result().Header.Get("Content-Type"))
+	assert.Equal(t, fmt.Sprint(len(content)), rec.Result().Header.Get("Content-Length"))
+	assert.Equal(t, content, rec.Body.Bytes())
+}
+
 func TestPeerIDHandler(t *testing.T) {
 	resp := DataResponse{}
 	testRequest(testOptions{
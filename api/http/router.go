@@ -29,6 +29,7 @@ const (
 	PingPath        string = versionedAPIPath + "/ping"
 	DumpPath        string = versionedAPIPath + "/debug/dump"
 	BlocksPath      string = versionedAPIPath + "/blocks"
+	BlobsPath       string = versionedAPIPath + "/blobs"
 	GraphQLPath     string = versionedAPIPath + "/graphql"
 	SchemaLoadPath  string = versionedAPIPath + "/schema/load"
 	SchemaPatchPath string = versionedAPIPath + "/schema/patch"
@@ -56,6 +57,7 @@ func setRoutes(h *handler) *handler {
 	h.Get(PingPath, h.handle(pingHandler))
 	h.Get(DumpPath, h.handle(dumpHandler))
 	h.Get(BlocksPath+"/{cid}", h.handle(getBlockHandler))
+	h.Get(BlobsPath+"/{cid}", h.handle(getBlobHandler))
 	h.Get(GraphQLPath, h.handle(execGQLHandler))
 	h.Post(GraphQLPath, h.handle(execGQLHandler))
 	h.Post(SchemaLoadPath, h.handle(loadSchemaHandler))
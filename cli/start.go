@@ -240,9 +240,15 @@ func start(ctx context.Context, cfg *config.Config) (*defraInstance, error) {
 		return nil, errors.Wrap("failed to open datastore", err)
 	}
 
+	maxClockOffset, err := cfg.Net.MaxClockOffsetDuration()
+	if err != nil {
+		return nil, errors.Wrap("failed to parse max clock offset", err)
+	}
+
 	options := []db.Option{
 		db.WithUpdateEvents(),
 		db.WithMaxRetries(cfg.Datastore.MaxTxnRetries),
+		db.WithMaxClockOffset(maxClockOffset),
 	}
 
 	db, err := db.NewDB(ctx, rootstore, options...)
@@ -94,6 +94,13 @@ type Collection interface {
 	//
 	// Returns an ErrDocumentNotFound if a document is not found for any given DocKey.
 	UpdateWithKeys(context.Context, []DocKey, string) (*UpdateResult, error)
+	// IncrementWithKey increments the counter fields of the document matching the given DocKey.
+	//
+	// The provided increments must be a JSON object of counter field names to the (possibly negative)
+	// amounts to increment them by.
+	//
+	// Returns an ErrDocumentNotFound if a document matching the given DocKey is not found.
+	IncrementWithKey(ctx context.Context, key DocKey, increments string) (*UpdateResult, error)
 
 	// DeleteWith deletes a target document.
 	//
@@ -136,6 +143,14 @@ type Collection interface {
 
 	// GetAllDocKeys returns all the document keys that exist in the collection.
 	GetAllDocKeys(ctx context.Context) (<-chan DocKeysResult, error)
+
+	// GetUniqueConflicts returns the conflicts between documents holding the same values for
+	// the fields of a unique index.
+	//
+	// Such documents may only be received from other peers, as local writes violating a unique
+	// constraint are rejected.  A conflict is cleared once either of its documents no longer
+	// holds the conflicting values.
+	GetUniqueConflicts(ctx context.Context) ([]UniqueConflict, error)
 }
 
 // DocKeysResult wraps the result of an attempt at a DocKey retrieval operation.
@@ -162,6 +177,17 @@ type DeleteResult struct {
 	DocKeys []string
 }
 
+// UniqueConflict describes two documents holding the same values for the fields of a
+// unique index.
+type UniqueConflict struct {
+	// IndexName is the name of the unique index.
+	IndexName string
+	// DocKey is the key of the first of the conflicting documents.
+	DocKey string
+	// ConflictingDocKey is the key of the other conflicting document.
+	ConflictingDocKey string
+}
+
 // P2PCollection is the gRPC response representation of a P2P collection topic
 type P2PCollection struct {
 	// The collection ID
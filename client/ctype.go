@@ -22,4 +22,20 @@ const (
 	LWW_REGISTER
 	OBJECT
 	COMPOSITE
+	PN_COUNTER
+	OR_SET
+	RGA_TEXT
+	MV_REGISTER
+	LWW_HLC_REGISTER
 )
+
+// IsSupportedFieldCType returns true if the values of document fields may be held by CRDTs of
+// this type.
+func (t CType) IsSupportedFieldCType() bool {
+	switch t {
+	case NONE_CRDT, LWW_REGISTER, PN_COUNTER, OR_SET, RGA_TEXT, MV_REGISTER, LWW_HLC_REGISTER:
+		return true
+	default:
+		return false
+	}
+}
@@ -72,6 +72,10 @@ type DB interface {
 	// Currently this is only used within the P2P system and will not affect operations initiated by users.
 	MaxTxnRetries() int
 
+	// SetPeerID sets the ID of the peer this DefraDB instance is running as, which authors the
+	// commits made by it unless they are given another author.
+	SetPeerID(peerID string)
+
 	// PrintDump logs the entire contents of the rootstore (all the data managed by this DefraDB instance).
 	//
 	// It is likely unwise to call this on a large database instance.
@@ -0,0 +1,212 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package client
+
+import (
+	"math/big"
+	"strconv"
+	"strings"
+
+	"github.com/fxamacker/cbor/v2"
+)
+
+// DecimalPrecision is the number of digits after the decimal point that decimals without
+// an exact decimal representation, such as averages, are rounded to.
+const DecimalPrecision = 34
+
+// cborTagDecimalFraction is the CBOR tag of decimal fractions, `[exponent, mantissa]`
+// arrays whose value is `mantissa * 10^exponent`.
+const cborTagDecimalFraction = 4
+
+// ParseDecimal returns the exact value of the given decimal string or number.
+//
+// Floats are read from their shortest decimal representation, so that `1.1` is exactly
+// `11/10`, but values that need more precision than a float holds should be given as strings.
+func ParseDecimal(value any) (*big.Rat, error) {
+	switch v := value.(type) {
+	case *big.Rat:
+		return v, nil
+	case *big.Int:
+		return new(big.Rat).SetInt(v), nil
+	case big.Int:
+		return new(big.Rat).SetInt(&v), nil
+	case int:
+		return new(big.Rat).SetInt64(int64(v)), nil
+	case int64:
+		return new(big.Rat).SetInt64(v), nil
+	case uint64:
+		return new(big.Rat).SetInt(new(big.Int).SetUint64(v)), nil
+	case float64:
+		return parseDecimalString(strconv.FormatFloat(v, 'f', -1, 64))
+	case string:
+		return parseDecimalString(v)
+	case cbor.Tag:
+		return decodeDecimal(v)
+	default:
+		return nil, NewErrInvalidDecimal(value)
+	}
+}
+
+func parseDecimalString(value string) (*big.Rat, error) {
+	// big.Rat also accepts fractions such as "1/3", which are not decimals.
+	if strings.Contains(value, "/") {
+		return nil, NewErrInvalidDecimal(value)
+	}
+	r, ok := new(big.Rat).SetString(value)
+	if !ok {
+		return nil, NewErrInvalidDecimal(value)
+	}
+	return r, nil
+}
+
+// ParseBigInt returns the exact value of the given integer string or number.
+func ParseBigInt(value any) (*big.Int, error) {
+	switch v := value.(type) {
+	case *big.Int:
+		return v, nil
+	case big.Int:
+		return &v, nil
+	case int:
+		return big.NewInt(int64(v)), nil
+	case int64:
+		return big.NewInt(v), nil
+	case uint64:
+		return new(big.Int).SetUint64(v), nil
+	case float64:
+		r, err := ParseDecimal(v)
+		if err != nil || !r.IsInt() {
+			return nil, NewErrInvalidBigInt(value)
+		}
+		return r.Num(), nil
+	case string:
+		i, ok := new(big.Int).SetString(v, 10)
+		if !ok {
+			return nil, NewErrInvalidBigInt(value)
+		}
+		return i, nil
+	default:
+		return nil, NewErrInvalidBigInt(value)
+	}
+}
+
+// FormatDecimal returns the decimal string representation of the given value.
+//
+// Values with an exact decimal representation are formatted exactly, others are rounded
+// to [DecimalPrecision] digits after the decimal point.
+func FormatDecimal(r *big.Rat) string {
+	scale, exact := decimalScale(r)
+	if exact {
+		return r.FloatString(scale)
+	}
+	s := strings.TrimRight(r.FloatString(DecimalPrecision), "0")
+	return strings.TrimSuffix(s, ".")
+}
+
+// NewDecimalValue returns a CBOR value of the given CRDT type holding the decimal parsed
+// from the given value.
+func NewDecimalValue(t CType, val Value) (WriteableValue, error) {
+	r, err := ParseDecimal(val.Value())
+	if err != nil {
+		return nil, err
+	}
+	return newCBORValue(t, r), nil
+}
+
+// NewBigIntValue returns a CBOR value of the given CRDT type holding the integer parsed
+// from the given value.
+func NewBigIntValue(t CType, val Value) (WriteableValue, error) {
+	i, err := ParseBigInt(val.Value())
+	if err != nil {
+		return nil, err
+	}
+	return newCBORValue(t, i), nil
+}
+
+// EncodeDecimal returns the given decimal as a CBOR decimal fraction, which may be encoded
+// in place of the decimal.
+//
+// Decimals without an exact decimal representation are rounded to [DecimalPrecision] digits.
+func EncodeDecimal(r *big.Rat) cbor.Tag {
+	scale, exact := decimalScale(r)
+	if !exact {
+		r, _ = parseDecimalString(r.FloatString(DecimalPrecision))
+		scale, _ = decimalScale(r)
+	}
+
+	mantissa := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(scale)), nil)
+	mantissa.Mul(mantissa, r.Num())
+	mantissa.Quo(mantissa, r.Denom())
+
+	return cbor.Tag{
+		Number:  cborTagDecimalFraction,
+		Content: []any{-int64(scale), mantissa},
+	}
+}
+
+// decodeDecimal returns the decimal held by the given CBOR decimal fraction.
+func decodeDecimal(tag cbor.Tag) (*big.Rat, error) {
+	content, ok := tag.Content.([]any)
+	if tag.Number != cborTagDecimalFraction || !ok || len(content) != 2 {
+		return nil, NewErrInvalidDecimal(tag)
+	}
+
+	exponent, err := ParseBigInt(content[0])
+	if err != nil || !exponent.IsInt64() {
+		return nil, NewErrInvalidDecimal(tag)
+	}
+	mantissa, err := ParseBigInt(content[1])
+	if err != nil {
+		return nil, NewErrInvalidDecimal(tag)
+	}
+
+	exp := exponent.Int64()
+	scale := new(big.Int).Exp(big.NewInt(10), big.NewInt(abs(exp)), nil)
+	if exp < 0 {
+		return new(big.Rat).SetFrac(mantissa, scale), nil
+	}
+	return new(big.Rat).SetInt(scale.Mul(scale, mantissa)), nil
+}
+
+// decimalScale returns the number of digits after the decimal point needed to represent
+// the given value exactly, and whether it has an exact decimal representation at all.
+func decimalScale(r *big.Rat) (int, bool) {
+	denom := new(big.Int).Set(r.Denom())
+	two, five := big.NewInt(2), big.NewInt(5)
+	rem := new(big.Int)
+
+	var twos, fives int
+	for {
+		if _, rem = new(big.Int).QuoRem(denom, two, rem); rem.Sign() != 0 {
+			break
+		}
+		denom.Quo(denom, two)
+		twos++
+	}
+	for {
+		if _, rem = new(big.Int).QuoRem(denom, five, rem); rem.Sign() != 0 {
+			break
+		}
+		denom.Quo(denom, five)
+		fives++
+	}
+
+	if twos > fives {
+		return twos, denom.IsInt64() && denom.Int64() == 1
+	}
+	return fives, denom.IsInt64() && denom.Int64() == 1
+}
+
+func abs(v int64) int64 {
+	if v < 0 {
+		return -v
+	}
+	return v
+}
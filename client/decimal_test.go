@@ -0,0 +1,66 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package client
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/fxamacker/cbor/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseDecimal(t *testing.T) {
+	r, err := ParseDecimal("1.10")
+	require.NoError(t, err)
+	assert.Equal(t, big.NewRat(11, 10), r)
+
+	r, err = ParseDecimal(0.1)
+	require.NoError(t, err)
+	assert.Equal(t, big.NewRat(1, 10), r)
+
+	_, err = ParseDecimal("1/3")
+	assert.ErrorIs(t, err, ErrInvalidDecimal)
+}
+
+func TestParseBigInt(t *testing.T) {
+	i, err := ParseBigInt("123456789012345678901234567890")
+	require.NoError(t, err)
+	assert.Equal(t, "123456789012345678901234567890", i.String())
+
+	_, err = ParseBigInt(1.5)
+	assert.ErrorIs(t, err, ErrInvalidBigInt)
+}
+
+func TestFormatDecimal(t *testing.T) {
+	assert.Equal(t, "3", FormatDecimal(big.NewRat(3, 1)))
+	assert.Equal(t, "-0.125", FormatDecimal(big.NewRat(-1, 8)))
+	assert.Equal(t, "0.6666666666666666666666666666666667", FormatDecimal(big.NewRat(2, 3)))
+}
+
+func TestDecimalCBORRoundTrip(t *testing.T) {
+	for _, value := range []string{"0", "12.5", "-0.001", "123456789012345678901234567890.0123456789"} {
+		expected, err := ParseDecimal(value)
+		require.NoError(t, err)
+
+		buf, err := NewCBORValue(LWW_REGISTER, expected).Bytes()
+		require.NoError(t, err)
+
+		var decoded any
+		err = cbor.Unmarshal(buf, &decoded)
+		require.NoError(t, err)
+
+		actual, err := ParseDecimal(decoded)
+		require.NoError(t, err)
+		assert.Equal(t, 0, expected.Cmp(actual), value)
+	}
+}
@@ -0,0 +1,71 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package client
+
+import (
+	"encoding/json"
+)
+
+// DefaultValue is the JSON encoding of the default value of a field, or empty if the field
+// has no default value.
+//
+// It is held as a string so that field descriptions remain comparable, and is serialized
+// as the JSON value it encodes.
+type DefaultValue string
+
+// NewDefaultValue returns the default value encoding the given value.
+func NewDefaultValue(value any) (DefaultValue, error) {
+	if value == nil {
+		return "", nil
+	}
+	buf, err := json.Marshal(value)
+	if err != nil {
+		return "", err
+	}
+	return DefaultValue(buf), nil
+}
+
+// HasValue returns true if this is a default value, rather than the absence of one.
+func (v DefaultValue) HasValue() bool {
+	return v != ""
+}
+
+// Value returns the decoded default value, as returned by [json.Unmarshal].
+func (v DefaultValue) Value() (any, error) {
+	if !v.HasValue() {
+		return nil, nil
+	}
+	var value any
+	err := json.Unmarshal([]byte(v), &value)
+	if err != nil {
+		return nil, err
+	}
+	return value, nil
+}
+
+// MarshalJSON returns the JSON value encoded by this default value.
+func (v DefaultValue) MarshalJSON() ([]byte, error) {
+	if !v.HasValue() {
+		return []byte("null"), nil
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalJSON sets this default value to the given JSON value.
+func (v *DefaultValue) UnmarshalJSON(data []byte) error {
+	var value any
+	err := json.Unmarshal(data, &value)
+	if err != nil {
+		return err
+	}
+	*v, err = NewDefaultValue(value)
+	return err
+}
@@ -12,6 +12,7 @@ package client
 
 import (
 	"fmt"
+	"strings"
 )
 
 // CollectionDescription describes a Collection and all its associated metadata.
@@ -29,6 +30,12 @@ type CollectionDescription struct {
 
 	// Schema contains the data type information that this Collection uses.
 	Schema SchemaDescription
+
+	// Indexes contains the secondary indexes that this Collection maintains.
+	//
+	// Indexes are local to the node hosting the DefraDB instance and are not
+	// part of the (global) schema.
+	Indexes []IndexDescription
 }
 
 // IDString returns the collection ID as a string.
@@ -60,6 +67,107 @@ func (col CollectionDescription) GetRelation(name string) (FieldDescription, boo
 	return FieldDescription{}, false
 }
 
+// GetIndexesOnField returns the secondary indexes that cover the field of the given name.
+func (col CollectionDescription) GetIndexesOnField(name string) []IndexDescription {
+	result := []IndexDescription{}
+	for _, index := range col.Indexes {
+		for _, field := range index.Fields {
+			if field.Name == name {
+				result = append(result, index)
+				break
+			}
+		}
+	}
+	return result
+}
+
+// HasFullTextIndex returns true if the Collection has a full-text index.
+func (col CollectionDescription) HasFullTextIndex() bool {
+	for _, index := range col.Indexes {
+		if index.FullText {
+			return true
+		}
+	}
+	return false
+}
+
+// HasVectorField returns true if the Collection has a vector field.
+func (col CollectionDescription) HasVectorField() bool {
+	for _, field := range col.Schema.Fields {
+		if field.Kind == FieldKind_FLOAT_VECTOR {
+			return true
+		}
+	}
+	return false
+}
+
+// HasMVRegisterField returns true if the Collection has a field held by an MV register.
+func (col CollectionDescription) HasMVRegisterField() bool {
+	for _, field := range col.Schema.Fields {
+		if field.Typ == MV_REGISTER {
+			return true
+		}
+	}
+	return false
+}
+
+// HasHLCField returns true if the Collection has a field held by a register ordered by
+// hybrid logical clock time.
+func (col CollectionDescription) HasHLCField() bool {
+	for _, field := range col.Schema.Fields {
+		if field.Typ == LWW_HLC_REGISTER {
+			return true
+		}
+	}
+	return false
+}
+
+// IndexDescription describes a secondary index on a Collection.
+type IndexDescription struct {
+	// Name contains the name of this index.
+	//
+	// It is unique within the Collection.
+	Name string
+
+	// ID is the local identifier of this index.
+	//
+	// It is unique within the Collection and is immutable.
+	ID uint32
+
+	// Fields contains the fields that this index is built from.
+	Fields []IndexedFieldDescription
+
+	// Unique is true if no two documents may share the same values for the indexed fields.
+	//
+	// Documents with a nil value for any of the indexed fields are exempt from this constraint.
+	Unique bool
+
+	// FullText is true if this is an inverted index over the terms of a single string field,
+	// used to serve full-text searches.
+	FullText bool
+
+	// Vector is true if this is an approximate nearest-neighbour index over the values of a
+	// single vector field, used to serve similarity searches.
+	Vector bool
+
+	// Metric is the metric by which the distance between the vectors of a vector index is
+	// measured, either "COSINE" or "L2".
+	//
+	// The index may only serve similarity searches using the same metric.
+	Metric string
+}
+
+// IDString returns the index ID as a string.
+func (index IndexDescription) IDString() string {
+	return fmt.Sprint(index.ID)
+}
+
+// IndexedFieldDescription describes a field that a secondary index is built from.
+type IndexedFieldDescription struct {
+	// Name contains the name of the indexed field.
+	Name string
+}
+
 // SchemaDescription describes a Schema and its associated metadata.
 type SchemaDescription struct {
 	// SchemaID is the version agnostic identifier for this schema.
@@ -85,6 +193,21 @@ type SchemaDescription struct {
 	//
 	// Currently new fields may be added after initial declaration, but they cannot be removed.
 	Fields []FieldDescription
+
+	// Enums contains the enums that the enum fields within this Schema hold values of.
+	//
+	// New enums, and new values of existing enums, may be added after initial declaration,
+	// but they cannot be removed.  It is omitted from the serialized description if empty
+	// so as not to change the version IDs of existing schemas.
+	Enums []EnumDescription `json:",omitempty"`
+
+	// Constraints contains the constraints that the values of the fields within this Schema
+	// must satisfy, at most one per field.
+	//
+	// They may be added, changed, and removed after initial declaration, but are only enforced
+	// on writes made after the change.  It is omitted from the serialized description if empty
+	// so as not to change the version IDs of existing schemas.
+	Constraints []ConstraintDescription `json:",omitempty"`
 }
 
 // IsEmpty returns true if the SchemaDescription is empty and uninitialized
@@ -92,6 +215,40 @@ func (sd SchemaDescription) IsEmpty() bool {
 	return len(sd.Fields) == 0
 }
 
+// GetEnum returns the enum of the given name.
+func (sd SchemaDescription) GetEnum(name string) (EnumDescription, bool) {
+	for _, enum := range sd.Enums {
+		if enum.Name == name {
+			return enum, true
+		}
+	}
+	return EnumDescription{}, false
+}
+
+// GetConstraint returns the constraint of the field of the given name.
+func (sd SchemaDescription) GetConstraint(fieldName string) (ConstraintDescription, bool) {
+	for _, constraint := range sd.Constraints {
+		if constraint.Field == fieldName {
+			return constraint, true
+		}
+	}
+	return ConstraintDescription{}, false
+}
+
+// GetEmbeddedFields returns the fields of the embedded object held by the field of the
+// given name, excluding the fields of any objects embedded within it.
+func (sd SchemaDescription) GetEmbeddedFields(name string) []FieldDescription {
+	prefix := name + EmbeddedFieldSeparator
+	fields := []FieldDescription{}
+	for _, field := range sd.Fields {
+		if strings.HasPrefix(field.Name, prefix) &&
+			!strings.Contains(field.Name[len(prefix):], EmbeddedFieldSeparator) {
+			fields = append(fields, field)
+		}
+	}
+	return fields
+}
+
 // GetFieldKey returns the field ID for the given field name.
 func (sd SchemaDescription) GetFieldKey(fieldName string) uint32 {
 	for _, field := range sd.Fields {
@@ -115,14 +272,14 @@ const (
 	FieldKind_INT_ARRAY    FieldKind = 5
 	FieldKind_FLOAT        FieldKind = 6
 	FieldKind_FLOAT_ARRAY  FieldKind = 7
-	_                      FieldKind = 8 // safe to repurpose (was never used)
-	_                      FieldKind = 9 // safe to repurpose (previoulsy old field)
+	FieldKind_FLOAT_VECTOR FieldKind = 8 // fixed-dimension float array
+	FieldKind_DECIMAL      FieldKind = 9 // arbitrary precision decimal
 	FieldKind_DATETIME     FieldKind = 10
 	FieldKind_STRING       FieldKind = 11
 	FieldKind_STRING_ARRAY FieldKind = 12
-	_                      FieldKind = 13 // safe to repurpose (was never used)
-	_                      FieldKind = 14 // safe to repurpose (was never used)
-	_                      FieldKind = 15 // safe to repurpose (was never used)
+	FieldKind_JSON         FieldKind = 13 // arbitrary JSON value
+	FieldKind_BLOB         FieldKind = 14 // content-addressed binary content
+	FieldKind_BIG_INT      FieldKind = 15 // arbitrary precision integer
 
 	// Embedded object, but accessed via foreign keys
 	FieldKind_FOREIGN_OBJECT FieldKind = 16
@@ -134,8 +291,20 @@ const (
 	FieldKind_NILLABLE_INT_ARRAY    FieldKind = 19
 	FieldKind_NILLABLE_FLOAT_ARRAY  FieldKind = 20
 	FieldKind_NILLABLE_STRING_ARRAY FieldKind = 21
+
+	// Value of an enum, whose name is held by the field's Schema
+	FieldKind_ENUM FieldKind = 22
+
+	// Object stored inline in the host document, whose type name is held by the field's
+	// Schema.  Its own fields are held by separate fields of the host schema, named by
+	// their path from the host.
+	FieldKind_EMBEDDED_OBJECT FieldKind = 23
 )
 
+// EmbeddedFieldSeparator separates the names of the fields of embedded objects from the
+// name of the embedded object field that holds them, e.g. `address.city`.
+const EmbeddedFieldSeparator = "."
+
 // FieldKindStringToEnumMapping maps string representations of [FieldKind] values to
 // their enum values.
 //
@@ -155,9 +324,16 @@ var FieldKindStringToEnumMapping = map[string]FieldKind{
 	"Float":      FieldKind_FLOAT,
 	"[Float]":    FieldKind_NILLABLE_FLOAT_ARRAY,
 	"[Float!]":   FieldKind_FLOAT_ARRAY,
+	"Vector":     FieldKind_FLOAT_VECTOR,
 	"String":     FieldKind_STRING,
 	"[String]":   FieldKind_NILLABLE_STRING_ARRAY,
 	"[String!]":  FieldKind_STRING_ARRAY,
+	"JSON":       FieldKind_JSON,
+	"Blob":       FieldKind_BLOB,
+	"Decimal":    FieldKind_DECIMAL,
+	"BigInt":     FieldKind_BIG_INT,
+	"Enum":       FieldKind_ENUM,
+	"Embedded":   FieldKind_EMBEDDED_OBJECT,
 }
 
 // RelationType describes the type of relation between two types.
@@ -203,7 +379,8 @@ type FieldDescription struct {
 	Kind FieldKind
 
 	// Schema contains the schema name of the type this field contains if this field is
-	// a relation field.  Otherwise this will be empty.
+	// a relation or embedded object field, or the name of the enum it contains if it is an
+	// enum field. Otherwise this will be empty.
 	Schema string
 
 	// RelationName the name of the relationship that this field represents if this field is
@@ -218,6 +395,75 @@ type FieldDescription struct {
 	// RelationType contains the relationship type if this field is a relation field. Otherwise this
 	// will be empty.
 	RelationType RelationType
+
+	// Dimensions contains the number of elements held by this field if it is a vector field.
+	// Otherwise this will be zero, and will be omitted from the serialized description so as
+	// not to change the version IDs of existing schemas.
+	//
+	// It is currently immutable.
+	Dimensions int `json:",omitempty"`
+
+	// DefaultValue contains the value that this field is given on create if no value is
+	// provided, and that existing documents without a value for it read as.  It will be
+	// omitted from the serialized description if empty so as not to change the version IDs
+	// of existing schemas.
+	//
+	// It is currently immutable.
+	DefaultValue DefaultValue `json:",omitempty"`
+
+	// IsRequired is true if documents must hold a non-null value for this field, which is
+	// validated on create and on update.  It will be omitted from the serialized description
+	// if false so as not to change the version IDs of existing schemas.
+	//
+	// Fields added to an existing schema may only be required if they have a default value.
+	// It is currently immutable.
+	IsRequired bool `json:",omitempty"`
+}
+
+// ConstraintDescription describes the constraints that the values of a field must satisfy.
+//
+// Null values satisfy all constraints.
+type ConstraintDescription struct {
+	// Field is the name of the field that this constraint applies to.
+	Field string
+
+	// Min and Max contain the inclusive bounds of the values of a numeric field, if any.
+	Min *float64 `json:",omitempty"`
+	Max *float64 `json:",omitempty"`
+
+	// MinLength and MaxLength contain the inclusive bounds of the number of characters of the
+	// values of a string field, or of the number of items of the values of an array field,
+	// if any.
+	MinLength *int `json:",omitempty"`
+	MaxLength *int `json:",omitempty"`
+
+	// Pattern contains the regular expression, in RE2 syntax, that the values of a string
+	// field must match, if any.
+	Pattern string `json:",omitempty"`
+}
+
+// EnumDescription describes an enum, the set of named values that enum fields may hold.
+type EnumDescription struct {
+	// Name is the name of this enum.
+	//
+	// It is immutable.
+	Name string
+
+	// Values contains the values of this enum, in the order they were declared.
+	//
+	// New values may be appended after initial declaration, but they cannot be removed
+	// or reordered.
+	Values []string
+}
+
+// HasValue returns true if the given value is one of the values of this enum.
+func (e EnumDescription) HasValue(value string) bool {
+	for _, enumValue := range e.Values {
+		if enumValue == value {
+			return true
+		}
+	}
+	return false
 }
 
 // IsObject returns true if this field is an object type.
@@ -226,6 +472,16 @@ func (f FieldDescription) IsObject() bool {
 		(f.Kind == FieldKind_FOREIGN_OBJECT_ARRAY)
 }
 
+// IsEmbedded returns true if this field is an embedded object type.
+func (f FieldDescription) IsEmbedded() bool {
+	return f.Kind == FieldKind_EMBEDDED_OBJECT
+}
+
+// IsEmbeddedField returns true if this field is a field of an embedded object.
+func (f FieldDescription) IsEmbeddedField() bool {
+	return strings.Contains(f.Name, EmbeddedFieldSeparator)
+}
+
 // IsObjectArray returns true if this field is an object array type.
 func (f FieldDescription) IsObjectArray() bool {
 	return (f.Kind == FieldKind_FOREIGN_OBJECT_ARRAY)
@@ -439,6 +439,7 @@ func (doc *Document) toMap() (map[string]any, error) {
 				return nil, err
 			}
 			docMap[k] = subDocMap
+			continue
 		}
 
 		docMap[k] = value.Value()
@@ -464,6 +465,7 @@ func (doc *Document) toMapWithKey() (map[string]any, error) {
 				return nil, err
 			}
 			docMap[k] = subDocMap
+			continue
 		}
 
 		docMap[k] = value.Value()
@@ -473,6 +475,24 @@ func (doc *Document) toMapWithKey() (map[string]any, error) {
 	return docMap, nil
 }
 
+// NewJSONValue returns a CBOR value of the given CRDT type holding the JSON object of the
+// given sub-document value.
+//
+// JSON objects are parsed into sub-documents like any other object, they are converted
+// back into maps so that they may be encoded as a single value.
+func NewJSONValue(t CType, val Value) (WriteableValue, error) {
+	subDoc, ok := val.Value().(*Document)
+	if !ok {
+		return nil, NewErrUnexpectedType[*Document]("value", val.Value())
+	}
+
+	obj, err := subDoc.toMap()
+	if err != nil {
+		return nil, err
+	}
+	return newCBORValue(t, obj), nil
+}
+
 // DocumentStatus represent the state of the document in the DAG store.
 // It can either be `Active“ or `Deleted`.
 type DocumentStatus uint8
@@ -23,6 +23,8 @@ const (
 	errParsingFailed         string = "failed to parse argument"
 	errUninitializeProperty  string = "invalid state, required property is uninitialized"
 	errMaxTxnRetries         string = "reached maximum transaction reties"
+	errInvalidDecimal        string = "invalid decimal value"
+	errInvalidBigInt         string = "invalid big integer value"
 )
 
 // Errors returnable from this package.
@@ -45,6 +47,8 @@ var (
 	ErrMalformedDocKey       = errors.New("malformed DocKey, missing either version or cid")
 	ErrInvalidDocKeyVersion  = errors.New("invalid DocKey version")
 	ErrMaxTxnRetries         = errors.New(errMaxTxnRetries)
+	ErrInvalidDecimal        = errors.New(errInvalidDecimal)
+	ErrInvalidBigInt         = errors.New(errInvalidBigInt)
 )
 
 // NewErrFieldNotExist returns an error indicating that the given field does not exist.
@@ -105,3 +109,15 @@ func NewErrUninitializeProperty(host string, propertyName string) error {
 func NewErrMaxTxnRetries(inner error) error {
 	return errors.Wrap(errMaxTxnRetries, inner)
 }
+
+// NewErrInvalidDecimal returns an error indicating that the given value is not a valid
+// decimal.
+func NewErrInvalidDecimal(value any) error {
+	return errors.New(errInvalidDecimal, errors.NewKV("Value", value))
+}
+
+// NewErrInvalidBigInt returns an error indicating that the given value is not a valid
+// integer.
+func NewErrInvalidBigInt(value any) error {
+	return errors.New(errInvalidBigInt, errors.NewKV("Value", value))
+}
@@ -22,8 +22,9 @@ type AggregateTarget struct {
 	HostName  string
 	ChildName immutables.Option[string]
 
-	Limit   immutables.Option[uint64]
-	Offset  immutables.Option[uint64]
-	OrderBy immutables.Option[OrderBy]
-	Filter  immutables.Option[Filter]
+	Limit    immutables.Option[uint64]
+	Offset   immutables.Option[uint64]
+	OrderBy  immutables.Option[OrderBy]
+	Filter   immutables.Option[Filter]
+	Distinct immutables.Option[Distinct]
 }
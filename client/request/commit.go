@@ -23,6 +23,7 @@ type CommitSelect struct {
 	FieldName immutable.Option[string]
 	Cid       immutable.Option[string]
 	Depth     immutable.Option[uint64]
+	Filter    immutable.Option[Filter]
 
 	Limit   immutable.Option[uint64]
 	Offset  immutable.Option[uint64]
@@ -38,6 +39,7 @@ func (c CommitSelect) ToSelect() *Select {
 			Name:  c.Name,
 			Alias: c.Alias,
 		},
+		Filter:  c.Filter,
 		Limit:   c.Limit,
 		Offset:  c.Offset,
 		OrderBy: c.OrderBy,
@@ -17,6 +17,7 @@ const (
 
 	Cid         = "cid"
 	Data        = "data"
+	Message     = "message"
 	DocKey      = "dockey"
 	DocKeys     = "dockeys"
 	FieldName   = "field"
@@ -24,20 +25,27 @@ const (
 	Ids         = "ids"
 	ShowDeleted = "showDeleted"
 
-	FilterClause  = "filter"
-	GroupByClause = "groupBy"
-	LimitClause   = "limit"
-	OffsetClause  = "offset"
-	OrderClause   = "order"
-	DepthClause   = "depth"
-
-	AverageFieldName = "_avg"
-	CountFieldName   = "_count"
-	KeyFieldName     = "_key"
-	GroupFieldName   = "_group"
-	DeletedFieldName = "_deleted"
-	SumFieldName     = "_sum"
-	VersionFieldName = "_version"
+	DistinctClause = "distinct"
+	FilterClause   = "filter"
+	GroupByClause  = "groupBy"
+	LimitClause    = "limit"
+	OffsetClause   = "offset"
+	OrderClause    = "order"
+	SimilarClause  = "_similar"
+	DepthClause    = "depth"
+
+	AverageFieldName   = "_avg"
+	ConflictsFieldName = "_conflicts"
+	CountFieldName     = "_count"
+	KeyFieldName       = "_key"
+	GroupFieldName     = "_group"
+	DeletedFieldName   = "_deleted"
+	DistanceFieldName  = "_distance"
+	MaxFieldName       = "_max"
+	MinFieldName       = "_min"
+	ScoreFieldName     = "_score"
+	SumFieldName       = "_sum"
+	VersionFieldName   = "_version"
 
 	ExplainLabel = "explain"
 
@@ -52,6 +60,12 @@ const (
 	CollectionIDFieldName    = "collectionID"
 	SchemaVersionIDFieldName = "schemaVersionId"
 	DeltaFieldName           = "delta"
+	TimeFieldName            = "time"
+	AuthorFieldName          = "author"
+	AuthorTimeFieldName      = "authorTime"
+	MessageFieldName         = "message"
+
+	ConflictValueField = "value"
 
 	LinksNameFieldName = "name"
 	LinksCidFieldName  = "cid"
@@ -67,20 +81,27 @@ var (
 	}
 
 	ReservedFields = map[string]bool{
-		TypeNameFieldName: true,
-		VersionFieldName:  true,
-		GroupFieldName:    true,
-		CountFieldName:    true,
-		SumFieldName:      true,
-		AverageFieldName:  true,
-		KeyFieldName:      true,
-		DeletedFieldName:  true,
+		TypeNameFieldName:  true,
+		VersionFieldName:   true,
+		GroupFieldName:     true,
+		CountFieldName:     true,
+		SumFieldName:       true,
+		AverageFieldName:   true,
+		MinFieldName:       true,
+		MaxFieldName:       true,
+		KeyFieldName:       true,
+		DeletedFieldName:   true,
+		ScoreFieldName:     true,
+		DistanceFieldName:  true,
+		ConflictsFieldName: true,
 	}
 
 	Aggregates = map[string]struct{}{
 		CountFieldName:   {},
 		SumFieldName:     {},
 		AverageFieldName: {},
+		MinFieldName:     {},
+		MaxFieldName:     {},
 	}
 
 	CommitQueries = map[string]struct{}{
@@ -95,6 +116,10 @@ var (
 		CollectionIDFieldName,
 		SchemaVersionIDFieldName,
 		DeltaFieldName,
+		TimeFieldName,
+		AuthorFieldName,
+		AuthorTimeFieldName,
+		MessageFieldName,
 	}
 
 	LinksFields = []string{
@@ -0,0 +1,20 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package request
+
+// Distinct restricts the results to the first of each set of results that share the
+// same values for the given fields.
+type Distinct struct {
+	// Fields contains the names of the fields by which results are considered distinct.
+	//
+	// It is empty when targeting an inline array, the items themselves are then compared.
+	Fields []string
+}
@@ -19,6 +19,7 @@ const (
 	CreateObjects
 	UpdateObjects
 	DeleteObjects
+	IncrementObjects
 )
 
 // ObjectMutation is a field on the `mutation` operation of a graphql request. It includes
@@ -35,6 +36,9 @@ type ObjectMutation struct {
 	Filter immutable.Option[Filter]
 	Data   string
 
+	// Message is the optional message recorded in the commits made by the mutation.
+	Message string
+
 	Fields []Selection
 }
 
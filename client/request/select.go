@@ -36,11 +36,13 @@ type Select struct {
 	// Root is the top level type of parsed request
 	Root SelectionType
 
-	Limit   immutable.Option[uint64]
-	Offset  immutable.Option[uint64]
-	OrderBy immutable.Option[OrderBy]
-	GroupBy immutable.Option[GroupBy]
-	Filter  immutable.Option[Filter]
+	Limit    immutable.Option[uint64]
+	Offset   immutable.Option[uint64]
+	OrderBy  immutable.Option[OrderBy]
+	GroupBy  immutable.Option[GroupBy]
+	Distinct immutable.Option[Distinct]
+	Filter   immutable.Option[Filter]
+	Similar  immutable.Option[Similar]
 
 	Fields []Selection
 
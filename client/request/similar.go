@@ -0,0 +1,39 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package request
+
+// SimilarityMetric is the metric by which the distance between two vectors is measured.
+type SimilarityMetric string
+
+const (
+	// CosineMetric measures the distance between two vectors as one minus the cosine of
+	// the angle between them.
+	CosineMetric SimilarityMetric = "COSINE"
+
+	// L2Metric measures the euclidean distance between two vectors.
+	L2Metric SimilarityMetric = "L2"
+)
+
+// Similar is a nearest-neighbour search, restricting the results to the K documents
+// with the values of the given vector field nearest to the given vector.
+type Similar struct {
+	// Field is the name of the vector field to search by.
+	Field string
+
+	// Vector is the vector that the values of the field are compared to.
+	Vector []float64
+
+	// K is the maximum number of documents to return.
+	K uint64
+
+	// Metric is the metric by which the distance between vectors is measured.
+	Metric SimilarityMetric
+}
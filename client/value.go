@@ -11,6 +11,8 @@
 package client
 
 import (
+	"math/big"
+
 	"github.com/fxamacker/cbor/v2"
 )
 
@@ -107,5 +109,8 @@ func newCBORValue(t CType, val any) WriteableValue {
 }
 
 func (v cborValue) Bytes() ([]byte, error) {
+	if r, ok := v.value.(*big.Rat); ok {
+		return cbor.Marshal(EncodeDecimal(r))
+	}
 	return cbor.Marshal(v.value)
 }
@@ -360,6 +360,7 @@ type NetConfig struct {
 	RPCMaxConnectionIdle string
 	RPCTimeout           string
 	TCPAddress           string
+	MaxClockOffset       string
 }
 
 func defaultNetConfig() *NetConfig {
@@ -373,6 +374,7 @@ func defaultNetConfig() *NetConfig {
 		RPCMaxConnectionIdle: "5m",
 		RPCTimeout:           "10s",
 		TCPAddress:           "/ip4/0.0.0.0/tcp/9161",
+		MaxClockOffset:       "5m",
 	}
 }
 
@@ -385,6 +387,10 @@ func (netcfg *NetConfig) validate() error {
 	if err != nil {
 		return NewErrInvalidRPCMaxConnectionIdle(err, netcfg.RPCMaxConnectionIdle)
 	}
+	_, err = time.ParseDuration(netcfg.MaxClockOffset)
+	if err != nil {
+		return NewErrInvalidMaxClockOffset(err, netcfg.MaxClockOffset)
+	}
 	_, err = ma.NewMultiaddr(netcfg.P2PAddress)
 	if err != nil {
 		return NewErrInvalidP2PAddress(err, netcfg.P2PAddress)
@@ -425,6 +431,15 @@ func (netcfg *NetConfig) RPCMaxConnectionIdleDuration() (time.Duration, error) {
 }
 
 // NodeConfig provides the Node-specific configuration, from the top-level Net config.
+// MaxClockOffsetDuration gives the maximum clock offset as a time.Duration.
+func (netcfg *NetConfig) MaxClockOffsetDuration() (time.Duration, error) {
+	d, err := time.ParseDuration(netcfg.MaxClockOffset)
+	if err != nil {
+		return d, NewErrInvalidMaxClockOffset(err, netcfg.MaxClockOffset)
+	}
+	return d, nil
+}
+
 func (cfg *Config) NodeConfig() node.NodeOpt {
 	return func(opt *node.Options) error {
 		var err error
@@ -462,6 +462,23 @@ func TestValidationInvalidRPCTimeoutDuration(t *testing.T) {
 	assert.ErrorIs(t, err, ErrInvalidRPCTimeout)
 }
 
+func TestValidationMaxClockOffsetDuration(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.Net.MaxClockOffset = "30s"
+	err := cfg.validate()
+	assert.NoError(t, err)
+	duration, err := cfg.Net.MaxClockOffsetDuration()
+	assert.NoError(t, err)
+	assert.Equal(t, duration, 30*time.Second)
+}
+
+func TestValidationInvalidMaxClockOffset(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.Net.MaxClockOffset = "123123"
+	err := cfg.validate()
+	assert.ErrorIs(t, err, ErrInvalidMaxClockOffset)
+}
+
 func TestValidationRPCMaxConnectionIdleDuration(t *testing.T) {
 	cfg := DefaultConfig()
 	cfg.Net.RPCMaxConnectionIdle = "1s"
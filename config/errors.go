@@ -27,6 +27,7 @@ const (
 	errInvalidDatabaseURL          string = "invalid database URL"
 	errInvalidRPCTimeout           string = "invalid RPC timeout"
 	errInvalidRPCMaxConnectionIdle string = "invalid RPC MaxConnectionIdle"
+	errInvalidMaxClockOffset       string = "invalid max clock offset"
 	errInvalidP2PAddress           string = "invalid P2P address"
 	errInvalidRPCAddress           string = "invalid RPC address"
 	errInvalidBootstrapPeers       string = "invalid bootstrap peers"
@@ -63,6 +64,7 @@ var (
 	ErrFailedToValidateConfig      = errors.New(errFailedToValidateConfig)
 	ErrInvalidRPCTimeout           = errors.New(errInvalidRPCTimeout)
 	ErrInvalidRPCMaxConnectionIdle = errors.New(errInvalidRPCMaxConnectionIdle)
+	ErrInvalidMaxClockOffset       = errors.New(errInvalidMaxClockOffset)
 	ErrInvalidP2PAddress           = errors.New(errInvalidP2PAddress)
 	ErrInvalidRPCAddress           = errors.New(errInvalidRPCAddress)
 	ErrInvalidBootstrapPeers       = errors.New(errInvalidBootstrapPeers)
@@ -130,6 +132,10 @@ func NewErrInvalidRPCMaxConnectionIdle(inner error, timeout string) error {
 	return errors.Wrap(errInvalidRPCMaxConnectionIdle, inner, errors.NewKV("timeout", timeout))
 }
 
+func NewErrInvalidMaxClockOffset(inner error, offset string) error {
+	return errors.Wrap(errInvalidMaxClockOffset, inner, errors.NewKV("offset", offset))
+}
+
 func NewErrInvalidP2PAddress(inner error, address string) error {
 	return errors.Wrap(errInvalidP2PAddress, inner, errors.NewKV("address", address))
 }
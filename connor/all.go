@@ -0,0 +1,95 @@
+package connor
+
+import (
+	"reflect"
+
+	"github.com/sourcenetwork/immutable"
+
+	"github.com/sourcenetwork/defradb/core"
+)
+
+// all is an operator which passes if every item of the array data matches
+// the condition.
+func all(condition, data any) (bool, error) {
+	items := arrayItems(data)
+	for _, item := range items {
+		m, err := eq(condition, item)
+		if err != nil {
+			return false, err
+		}
+		if !m {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// arrayItems returns the items of the given array data, such as the values of
+// an inline array or the documents of a one-to-many relation.
+//
+// Nil data has no items, and data that is not an array is treated as an array
+// of a single item.
+func arrayItems(data any) []any {
+	switch d := data.(type) {
+	case nil:
+		return nil
+	case []core.Doc:
+		items := make([]any, len(d))
+		for i, item := range d {
+			items[i] = item
+		}
+		return items
+	case []any:
+		return d
+	}
+
+	value := reflect.ValueOf(data)
+	if value.Kind() != reflect.Slice {
+		return []any{data}
+	}
+	items := make([]any, value.Len())
+	for i := range items {
+		items[i] = optionValue(value.Index(i).Interface())
+	}
+	return items
+}
+
+// optionValue returns the value of the given nillable array item, or nil if it has none,
+// so that items are matched in the same way as the values of nillable fields.
+func optionValue(item any) any {
+	switch i := item.(type) {
+	case immutable.Option[bool]:
+		if i.HasValue() {
+			return i.Value()
+		}
+		return nil
+	case immutable.Option[int64]:
+		if i.HasValue() {
+			return i.Value()
+		}
+		return nil
+	case immutable.Option[float64]:
+		if i.HasValue() {
+			return i.Value()
+		}
+		return nil
+	case immutable.Option[string]:
+		if i.HasValue() {
+			return i.Value()
+		}
+		return nil
+	default:
+		return item
+	}
+}
+
+// isArrayOperator returns true if the given operator matches against an array as
+// a whole, rather than against each of its items.
+func isArrayOperator(op string) bool {
+	switch op {
+	case "_all", "_any", "_none":
+		return true
+	default:
+		return false
+	}
+}
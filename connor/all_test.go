@@ -0,0 +1,53 @@
+package connor
+
+import (
+	"testing"
+
+	"github.com/sourcenetwork/immutable"
+	"github.com/stretchr/testify/require"
+)
+
+type testOperator string
+
+func (k testOperator) GetProp(data any) any { return data }
+
+func (k testOperator) GetOperatorOrDefault(defaultOp string) string { return string(k) }
+
+func (k testOperator) Equal(other FilterKey) bool { return k == other }
+
+func TestArrayOperators(t *testing.T) {
+	data := []int64{1, 2, 3}
+	greaterThanOne := map[FilterKey]any{testOperator("_gt"): int64(1)}
+
+	result, err := anyOf(greaterThanOne, data)
+	require.NoError(t, err)
+	require.True(t, result)
+
+	result, err = all(greaterThanOne, data)
+	require.NoError(t, err)
+	require.False(t, result)
+
+	result, err = none(greaterThanOne, data)
+	require.NoError(t, err)
+	require.False(t, result)
+
+	// empty arrays
+	result, err = all(greaterThanOne, []int64{})
+	require.NoError(t, err)
+	require.True(t, result)
+
+	result, err = none(greaterThanOne, nil)
+	require.NoError(t, err)
+	require.True(t, result)
+
+	// nillable items
+	nillableData := []immutable.Option[string]{immutable.Some("a"), immutable.None[string]()}
+
+	result, err = anyOf(map[FilterKey]any{testOperator("_eq"): nil}, nillableData)
+	require.NoError(t, err)
+	require.True(t, result)
+
+	result, err = all(map[FilterKey]any{testOperator("_ne"): nil}, nillableData)
+	require.NoError(t, err)
+	require.False(t, result)
+}
@@ -0,0 +1,16 @@
+package connor
+
+// anyOf is an operator which passes if at least one item of the array data
+// matches the condition.
+func anyOf(condition, data any) (bool, error) {
+	for _, item := range arrayItems(data) {
+		m, err := eq(condition, item)
+		if err != nil {
+			return false, err
+		}
+		if m {
+			return true, nil
+		}
+	}
+	return false, nil
+}
@@ -1,6 +1,7 @@
 package connor
 
 import (
+	"math/big"
 	"reflect"
 	"time"
 
@@ -16,6 +17,30 @@ import (
 func eq(condition, data any) (bool, error) {
 	switch arr := data.(type) {
 	case []core.Doc:
+		if cn, ok := condition.(map[FilterKey]any); ok {
+			// Array operators match against the related documents as a whole, the
+			// remaining conditions are matched if any of the documents matches them.
+			hasArrayConditions := false
+			itemConditions := make(map[FilterKey]any, len(cn))
+			for prop, cond := range cn {
+				op := prop.GetOperatorOrDefault("")
+				if !isArrayOperator(op) {
+					itemConditions[prop] = cond
+					continue
+				}
+				hasArrayConditions = true
+				m, err := matchWith(op, cond, arr)
+				if err != nil || !m {
+					return false, err
+				}
+			}
+			if hasArrayConditions {
+				if len(itemConditions) == 0 {
+					return true, nil
+				}
+				condition = itemConditions
+			}
+		}
 		for _, item := range arr {
 			m, err := eq(condition, item)
 			if err != nil {
@@ -65,10 +90,16 @@ func eq(condition, data any) (bool, error) {
 		return numbers.Equal(cn, data), nil
 	case float64:
 		return numbers.Equal(cn, data), nil
+	case *big.Int, *big.Rat:
+		return numbers.Equal(cn, data), nil
 	case map[FilterKey]any:
+		cn, data, err := resolvePath(cn, data)
+		if err != nil {
+			return false, err
+		}
+
 		m := true
 		for prop, cond := range cn {
-			var err error
 			m, err = matchWith(prop.GetOperatorOrDefault("_eq"), cond, prop.GetProp(data))
 			if err != nil {
 				return false, err
@@ -0,0 +1,103 @@
+/*
+Package fulltext provides the text analysis used by full-text search.
+
+Text is split into terms on any character that is neither a letter nor a digit, terms are
+lowercased, common English stop words are dropped, and the remaining terms are reduced to
+their stem using the Porter stemming algorithm.
+*/
+package fulltext
+
+import (
+	"math"
+	"strings"
+	"unicode"
+)
+
+// stopWords are the common English words that are not indexed, nor matched against.
+var stopWords = map[string]struct{}{
+	"a": {}, "an": {}, "and": {}, "are": {}, "as": {}, "at": {}, "be": {}, "but": {}, "by": {},
+	"for": {}, "if": {}, "in": {}, "into": {}, "is": {}, "it": {}, "no": {}, "not": {}, "of": {},
+	"on": {}, "or": {}, "such": {}, "that": {}, "the": {}, "their": {}, "then": {}, "there": {},
+	"these": {}, "they": {}, "this": {}, "to": {}, "was": {}, "will": {}, "with": {},
+}
+
+// Tokenize splits the given text into its terms, in the order in which they appear.
+//
+// Terms may appear multiple times.
+func Tokenize(text string) []string {
+	words := strings.FieldsFunc(text, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+
+	terms := make([]string, 0, len(words))
+	for _, word := range words {
+		word = strings.ToLower(word)
+		if _, isStopWord := stopWords[word]; isStopWord {
+			continue
+		}
+		terms = append(terms, Stem(word))
+	}
+	return terms
+}
+
+// Terms returns the distinct terms of the given text.
+func Terms(text string) []string {
+	tokens := Tokenize(text)
+	seen := make(map[string]struct{}, len(tokens))
+	terms := make([]string, 0, len(tokens))
+	for _, token := range tokens {
+		if _, isSeen := seen[token]; isSeen {
+			continue
+		}
+		seen[token] = struct{}{}
+		terms = append(terms, token)
+	}
+	return terms
+}
+
+// Match returns true if the given text contains all the terms of the given query.
+//
+// A query without any terms matches nothing.
+func Match(text string, query string) bool {
+	queryTerms := Terms(query)
+	if len(queryTerms) == 0 {
+		return false
+	}
+
+	textTerms := make(map[string]struct{})
+	for _, term := range Tokenize(text) {
+		textTerms[term] = struct{}{}
+	}
+
+	for _, term := range queryTerms {
+		if _, ok := textTerms[term]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// Score returns the relevance of the given text to the given query.
+//
+// The score is the number of occurrences of the query terms within the text, normalised by the
+// square root of the number of terms in the text, such that texts mentioning the query terms
+// more often, or with fewer other terms, are more relevant.  Texts without any of the query
+// terms have a score of zero.
+func Score(text string, query string) float64 {
+	textTerms := Tokenize(text)
+	if len(textTerms) == 0 {
+		return 0
+	}
+
+	frequencies := make(map[string]int, len(textTerms))
+	for _, term := range textTerms {
+		frequencies[term]++
+	}
+
+	occurrences := 0
+	for _, term := range Terms(query) {
+		occurrences += frequencies[term]
+	}
+
+	return float64(occurrences) / math.Sqrt(float64(len(textTerms)))
+}
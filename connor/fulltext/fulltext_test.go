@@ -0,0 +1,59 @@
+package fulltext
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStem(t *testing.T) {
+	cases := map[string]string{
+		"caresses":       "caress",
+		"ponies":         "poni",
+		"cats":           "cat",
+		"feed":           "feed",
+		"agreed":         "agre",
+		"plastered":      "plaster",
+		"motoring":       "motor",
+		"sing":           "sing",
+		"conflated":      "conflat",
+		"troubled":       "troubl",
+		"sized":          "size",
+		"hopping":        "hop",
+		"falling":        "fall",
+		"filing":         "file",
+		"happy":          "happi",
+		"relational":     "relat",
+		"conditional":    "condit",
+		"rational":       "ration",
+		"generalization": "gener",
+		"running":        "run",
+		"searching":      "search",
+		"controll":       "control",
+		"adoption":       "adopt",
+		"go":             "go",
+		"café":           "café",
+	}
+
+	for word, expected := range cases {
+		assert.Equal(t, expected, Stem(word), word)
+	}
+}
+
+func TestTokenize(t *testing.T) {
+	tokens := Tokenize("The Quick brown-fox, jumping over the lazy dogs!")
+	require.Equal(t, []string{"quick", "brown", "fox", "jump", "over", "lazi", "dog"}, tokens)
+}
+
+func TestMatch(t *testing.T) {
+	assert.True(t, Match("Searching for the lost dogs", "dog search"))
+	assert.False(t, Match("Searching for the lost dogs", "dog cat"))
+	assert.False(t, Match("Searching for the lost dogs", "the"))
+}
+
+func TestScore(t *testing.T) {
+	assert.Equal(t, float64(0), Score("Searching for the lost dogs", "cat"))
+	assert.Greater(t, Score("dogs and more dogs", "dog"), Score("dogs and cats", "dog"))
+	assert.Greater(t, Score("dogs", "dog"), Score("dogs and cats", "dog"))
+}
@@ -0,0 +1,273 @@
+package fulltext
+
+import "sort"
+
+// stemRule replaces the suffix of a word with the given replacement, if the stem
+// remaining once the suffix is removed satisfies the condition of the rule.
+type stemRule struct {
+	suffix      string
+	replacement string
+	condition   func(stem []byte) bool
+}
+
+func hasMeasureAbove(n int) func(stem []byte) bool {
+	return func(stem []byte) bool {
+		return measure(stem) > n
+	}
+}
+
+var step2Rules = sortRules([]stemRule{
+	{"ational", "ate", hasMeasureAbove(0)},
+	{"tional", "tion", hasMeasureAbove(0)},
+	{"enci", "ence", hasMeasureAbove(0)},
+	{"anci", "ance", hasMeasureAbove(0)},
+	{"izer", "ize", hasMeasureAbove(0)},
+	{"bli", "ble", hasMeasureAbove(0)},
+	{"alli", "al", hasMeasureAbove(0)},
+	{"entli", "ent", hasMeasureAbove(0)},
+	{"eli", "e", hasMeasureAbove(0)},
+	{"ousli", "ous", hasMeasureAbove(0)},
+	{"ization", "ize", hasMeasureAbove(0)},
+	{"ation", "ate", hasMeasureAbove(0)},
+	{"ator", "ate", hasMeasureAbove(0)},
+	{"alism", "al", hasMeasureAbove(0)},
+	{"iveness", "ive", hasMeasureAbove(0)},
+	{"fulness", "ful", hasMeasureAbove(0)},
+	{"ousness", "ous", hasMeasureAbove(0)},
+	{"aliti", "al", hasMeasureAbove(0)},
+	{"iviti", "ive", hasMeasureAbove(0)},
+	{"biliti", "ble", hasMeasureAbove(0)},
+	{"logi", "log", hasMeasureAbove(0)},
+})
+
+var step3Rules = sortRules([]stemRule{
+	{"icate", "ic", hasMeasureAbove(0)},
+	{"ative", "", hasMeasureAbove(0)},
+	{"alize", "al", hasMeasureAbove(0)},
+	{"iciti", "ic", hasMeasureAbove(0)},
+	{"ical", "ic", hasMeasureAbove(0)},
+	{"ful", "", hasMeasureAbove(0)},
+	{"ness", "", hasMeasureAbove(0)},
+})
+
+var step4Rules = sortRules([]stemRule{
+	{"al", "", hasMeasureAbove(1)},
+	{"ance", "", hasMeasureAbove(1)},
+	{"ence", "", hasMeasureAbove(1)},
+	{"er", "", hasMeasureAbove(1)},
+	{"ic", "", hasMeasureAbove(1)},
+	{"able", "", hasMeasureAbove(1)},
+	{"ible", "", hasMeasureAbove(1)},
+	{"ant", "", hasMeasureAbove(1)},
+	{"ement", "", hasMeasureAbove(1)},
+	{"ment", "", hasMeasureAbove(1)},
+	{"ent", "", hasMeasureAbove(1)},
+	{"ion", "", func(stem []byte) bool {
+		return measure(stem) > 1 && len(stem) > 0 && (stem[len(stem)-1] == 's' || stem[len(stem)-1] == 't')
+	}},
+	{"ou", "", hasMeasureAbove(1)},
+	{"ism", "", hasMeasureAbove(1)},
+	{"ate", "", hasMeasureAbove(1)},
+	{"iti", "", hasMeasureAbove(1)},
+	{"ous", "", hasMeasureAbove(1)},
+	{"ive", "", hasMeasureAbove(1)},
+	{"ize", "", hasMeasureAbove(1)},
+})
+
+// sortRules sorts the given rules such that the longest matching suffix is always found first.
+func sortRules(rules []stemRule) []stemRule {
+	sort.SliceStable(rules, func(i, j int) bool {
+		return len(rules[i].suffix) > len(rules[j].suffix)
+	})
+	return rules
+}
+
+// Stem returns the stem of the given lowercase English word, as defined by the
+// Porter stemming algorithm.
+//
+// Words of two or fewer characters, and words containing characters other than ASCII
+// lowercase letters, are returned unchanged.
+func Stem(word string) string {
+	if len(word) <= 2 {
+		return word
+	}
+	for i := 0; i < len(word); i++ {
+		if word[i] < 'a' || word[i] > 'z' {
+			return word
+		}
+	}
+
+	w := []byte(word)
+	w = step1a(w)
+	w = step1b(w)
+	w = step1c(w)
+	w = applyRules(w, step2Rules)
+	w = applyRules(w, step3Rules)
+	w = applyRules(w, step4Rules)
+	w = step5(w)
+	return string(w)
+}
+
+// isConsonant returns true if the letter at the given index of the word is a consonant.
+//
+// The letter 'y' is a consonant unless it is preceded by a consonant.
+func isConsonant(w []byte, i int) bool {
+	switch w[i] {
+	case 'a', 'e', 'i', 'o', 'u':
+		return false
+	case 'y':
+		return i == 0 || !isConsonant(w, i-1)
+	default:
+		return true
+	}
+}
+
+// measure returns the number of vowel-consonant sequences within the given stem.
+func measure(w []byte) int {
+	m := 0
+	i := 0
+	// skip the leading consonants
+	for i < len(w) && isConsonant(w, i) {
+		i++
+	}
+	for i < len(w) {
+		for i < len(w) && !isConsonant(w, i) {
+			i++
+		}
+		if i >= len(w) {
+			break
+		}
+		for i < len(w) && isConsonant(w, i) {
+			i++
+		}
+		m++
+	}
+	return m
+}
+
+func containsVowel(w []byte) bool {
+	for i := range w {
+		if !isConsonant(w, i) {
+			return true
+		}
+	}
+	return false
+}
+
+func endsWithDoubleConsonant(w []byte) bool {
+	n := len(w)
+	return n >= 2 && w[n-1] == w[n-2] && isConsonant(w, n-1)
+}
+
+// endsWithCVC returns true if the given stem ends consonant-vowel-consonant, where the
+// final consonant is not 'w', 'x' or 'y'.
+func endsWithCVC(w []byte) bool {
+	n := len(w)
+	if n < 3 || !isConsonant(w, n-3) || isConsonant(w, n-2) || !isConsonant(w, n-1) {
+		return false
+	}
+	switch w[n-1] {
+	case 'w', 'x', 'y':
+		return false
+	default:
+		return true
+	}
+}
+
+func hasSuffix(w []byte, suffix string) bool {
+	return len(w) >= len(suffix) && string(w[len(w)-len(suffix):]) == suffix
+}
+
+func replaceSuffix(w []byte, suffix string, replacement string) []byte {
+	return append(w[:len(w)-len(suffix)], replacement...)
+}
+
+// applyRules applies the rule with the longest suffix matching the given word, if its
+// condition is satisfied.
+func applyRules(w []byte, rules []stemRule) []byte {
+	for _, rule := range rules {
+		if !hasSuffix(w, rule.suffix) {
+			continue
+		}
+		if rule.condition(w[:len(w)-len(rule.suffix)]) {
+			return replaceSuffix(w, rule.suffix, rule.replacement)
+		}
+		return w
+	}
+	return w
+}
+
+// step1a removes plurals.
+func step1a(w []byte) []byte {
+	switch {
+	case hasSuffix(w, "sses"):
+		return replaceSuffix(w, "sses", "ss")
+	case hasSuffix(w, "ies"):
+		return replaceSuffix(w, "ies", "i")
+	case hasSuffix(w, "ss"):
+		return w
+	case hasSuffix(w, "s"):
+		return replaceSuffix(w, "s", "")
+	default:
+		return w
+	}
+}
+
+// step1b removes past participles, and the -ing suffix.
+func step1b(w []byte) []byte {
+	if hasSuffix(w, "eed") {
+		if measure(w[:len(w)-3]) > 0 {
+			return replaceSuffix(w, "eed", "ee")
+		}
+		return w
+	}
+
+	var stem []byte
+	switch {
+	case hasSuffix(w, "ed") && containsVowel(w[:len(w)-2]):
+		stem = w[:len(w)-2]
+	case hasSuffix(w, "ing") && containsVowel(w[:len(w)-3]):
+		stem = w[:len(w)-3]
+	default:
+		return w
+	}
+
+	switch {
+	case hasSuffix(stem, "at"), hasSuffix(stem, "bl"), hasSuffix(stem, "iz"):
+		return append(stem, 'e')
+	case endsWithDoubleConsonant(stem):
+		switch stem[len(stem)-1] {
+		case 'l', 's', 'z':
+			return stem
+		default:
+			return stem[:len(stem)-1]
+		}
+	case measure(stem) == 1 && endsWithCVC(stem):
+		return append(stem, 'e')
+	default:
+		return stem
+	}
+}
+
+// step1c replaces a terminal 'y' with an 'i' if the stem contains a vowel.
+func step1c(w []byte) []byte {
+	if hasSuffix(w, "y") && containsVowel(w[:len(w)-1]) {
+		return replaceSuffix(w, "y", "i")
+	}
+	return w
+}
+
+// step5 removes a terminal 'e', and reduces a terminal double 'l'.
+func step5(w []byte) []byte {
+	if hasSuffix(w, "e") {
+		stem := w[:len(w)-1]
+		m := measure(stem)
+		if m > 1 || (m == 1 && !endsWithCVC(stem)) {
+			w = stem
+		}
+	}
+	if hasSuffix(w, "ll") && measure(w) > 1 {
+		w = w[:len(w)-1]
+	}
+	return w
+}
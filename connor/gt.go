@@ -25,10 +25,18 @@ func gt(condition, data any) (bool, error) {
 				return false, err
 			}
 			return dt.After(c), nil
+		case nil:
+			// a null value is never ordered against a time
+			return false, nil
 		default:
 			return false, client.NewErrUnhandledType("data", d)
 		}
 	default:
+		if numbers.IsBig(condition) || numbers.IsBig(data) {
+			res, ok := numbers.Compare(data, condition)
+			return ok && res > 0, nil
+		}
+
 		switch cn := numbers.TryUpcast(condition).(type) {
 		case float64:
 			switch dn := numbers.TryUpcast(data).(type) {
@@ -0,0 +1,26 @@
+package connor
+
+import (
+	"strings"
+
+	"github.com/sourcenetwork/immutable"
+)
+
+// ilike is an operator which performs case-insensitive string equality
+// tests.
+func ilike(condition, data any) (bool, error) {
+	switch d := data.(type) {
+	case immutable.Option[string]:
+		if d.HasValue() {
+			data = immutable.Some(strings.ToLower(d.Value()))
+		}
+	case string:
+		data = strings.ToLower(d)
+	}
+
+	if cn, ok := condition.(string); ok {
+		condition = strings.ToLower(cn)
+	}
+
+	return like(condition, data)
+}
@@ -0,0 +1,32 @@
+package connor
+
+import (
+	"testing"
+
+	"github.com/sourcenetwork/immutable"
+	"github.com/stretchr/testify/require"
+)
+
+func TestILike(t *testing.T) {
+	const testString = "Source is the glue of web3"
+
+	// exact match
+	result, err := ilike("source is the GLUE of Web3", testString)
+	require.NoError(t, err)
+	require.True(t, result)
+
+	// match prefix
+	result, err = ilike("SOURCE%", testString)
+	require.NoError(t, err)
+	require.True(t, result)
+
+	// match contains
+	result, err = ilike("%Glue%", immutable.Some(testString))
+	require.NoError(t, err)
+	require.True(t, result)
+
+	// nil data
+	result, err = ilike("%glue%", immutable.None[string]())
+	require.NoError(t, err)
+	require.False(t, result)
+}
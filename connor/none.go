@@ -0,0 +1,11 @@
+package connor
+
+// none is an operator which passes if no item of the array data matches
+// the condition.
+func none(condition, data any) (bool, error) {
+	m, err := anyOf(condition, data)
+	if err != nil {
+		return false, err
+	}
+	return !m, nil
+}
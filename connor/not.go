@@ -0,0 +1,11 @@
+package connor
+
+// not is an operator which allows the inverse evaluation
+// of a condition, matching if it does not match.
+func not(condition, data any) (bool, error) {
+	m, err := eq(condition, data)
+	if err != nil {
+		return false, err
+	}
+	return !m, nil
+}
@@ -0,0 +1,53 @@
+package numbers
+
+import (
+	"math/big"
+	"strconv"
+)
+
+// IsBig returns true if the given value is an arbitrary precision number.
+func IsBig(n any) bool {
+	switch n.(type) {
+	case *big.Int, *big.Rat:
+		return true
+	default:
+		return false
+	}
+}
+
+// ToRat returns the exact value of the given number as a rational.
+//
+// Floats are converted from their shortest decimal representation, so that `0.1` is
+// exactly `1/10`.
+func ToRat(n any) (*big.Rat, bool) {
+	switch nn := TryUpcast(n).(type) {
+	case *big.Rat:
+		return nn, true
+	case *big.Int:
+		return new(big.Rat).SetInt(nn), true
+	case int64:
+		return new(big.Rat).SetInt64(nn), true
+	case uint64:
+		return new(big.Rat).SetInt(new(big.Int).SetUint64(nn)), true
+	case float64:
+		return new(big.Rat).SetString(strconv.FormatFloat(nn, 'f', -1, 64))
+	default:
+		return nil, false
+	}
+}
+
+// Compare compares the two given numbers exactly, returning -1 if a < b, 0 if a == b
+// and 1 if a > b.
+//
+// False is returned if either value is not a number.
+func Compare(a, b any) (int, bool) {
+	ra, ok := ToRat(a)
+	if !ok {
+		return 0, false
+	}
+	rb, ok := ToRat(b)
+	if !ok {
+		return 0, false
+	}
+	return ra.Cmp(rb), true
+}
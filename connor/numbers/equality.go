@@ -1,6 +1,11 @@
 package numbers
 
 func Equal(condition, data any) bool {
+	if IsBig(condition) || IsBig(data) {
+		c, ok := Compare(data, condition)
+		return ok && c == 0
+	}
+
 	uc := TryUpcast(condition)
 	ud := TryUpcast(data)
 
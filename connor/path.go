@@ -0,0 +1,68 @@
+package connor
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/sourcenetwork/defradb/client"
+)
+
+// pathOperator selects the value, at a dot separated path within the data, that the
+// other conditions of its block are matched against.
+//
+// E.g. `{_path: "a.b", _eq: 3}` matches `{"a": {"b": 3}}`.
+const pathOperator = "_path"
+
+// resolvePath returns the conditions left to match, and the data to match them against.
+//
+// If the given conditions contain a path, the data at that path is returned alongside
+// the conditions without the path. Otherwise they are returned unchanged.
+func resolvePath(conditions map[FilterKey]any, data any) (map[FilterKey]any, any, error) {
+	for key, condition := range conditions {
+		if key.GetOperatorOrDefault("") != pathOperator {
+			continue
+		}
+
+		path, ok := condition.(string)
+		if !ok {
+			return nil, nil, client.NewErrUnhandledType("condition", condition)
+		}
+
+		remaining := make(map[FilterKey]any, len(conditions)-1)
+		for otherKey, otherCondition := range conditions {
+			if otherKey != key {
+				remaining[otherKey] = otherCondition
+			}
+		}
+		return remaining, getAtPath(path, data), nil
+	}
+	return conditions, data, nil
+}
+
+// getAtPath returns the value at the given dot separated path within the given data, or
+// nil if there is no value at that path.
+//
+// Path segments that are numbers index into arrays, an empty path returns the data itself.
+func getAtPath(path string, data any) any {
+	if path == "" {
+		return data
+	}
+
+	for _, segment := range strings.Split(path, ".") {
+		switch d := data.(type) {
+		case map[string]any:
+			data = d[segment]
+
+		case []any:
+			index, err := strconv.Atoi(segment)
+			if err != nil || index < 0 || index >= len(d) {
+				return nil
+			}
+			data = d[index]
+
+		default:
+			return nil
+		}
+	}
+	return data
+}
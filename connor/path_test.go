@@ -0,0 +1,34 @@
+package connor
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetAtPath(t *testing.T) {
+	data := map[string]any{
+		"a": map[string]any{
+			"b": int64(3),
+		},
+		"tags": []any{"x", "y"},
+	}
+
+	// nested object
+	require.Equal(t, int64(3), getAtPath("a.b", data))
+
+	// array index
+	require.Equal(t, "y", getAtPath("tags.1", data))
+
+	// empty path
+	require.Equal(t, data, getAtPath("", data))
+
+	// missing key
+	require.Nil(t, getAtPath("a.c", data))
+
+	// array index out of range
+	require.Nil(t, getAtPath("tags.2", data))
+
+	// path through a scalar
+	require.Nil(t, getAtPath("a.b.c", data))
+}
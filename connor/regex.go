@@ -0,0 +1,43 @@
+package connor
+
+import (
+	"regexp"
+
+	"github.com/sourcenetwork/immutable"
+
+	"github.com/sourcenetwork/defradb/client"
+)
+
+// regex is an operator which passes if the data matches the RE2 regular
+// expression of the condition.
+//
+// The condition may be given pre-compiled so that it is not compiled for
+// every document matched against it.
+func regex(condition, data any) (bool, error) {
+	switch arr := data.(type) {
+	case immutable.Option[string]:
+		if !arr.HasValue() {
+			return false, nil
+		}
+		data = arr.Value()
+	}
+
+	var re *regexp.Regexp
+	switch cn := condition.(type) {
+	case *regexp.Regexp:
+		re = cn
+	case string:
+		compiled, err := regexp.Compile(cn)
+		if err != nil {
+			return false, NewErrInvalidRegex(cn, err)
+		}
+		re = compiled
+	default:
+		return false, client.NewErrUnhandledType("condition", cn)
+	}
+
+	if d, ok := data.(string); ok {
+		return re.MatchString(d), nil
+	}
+	return false, nil
+}
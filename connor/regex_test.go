@@ -0,0 +1,37 @@
+package connor
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/sourcenetwork/immutable"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegex(t *testing.T) {
+	const testString = "Source is the glue of web3"
+
+	// pattern match
+	result, err := regex("^Source .* web[0-9]$", testString)
+	require.NoError(t, err)
+	require.True(t, result)
+
+	// pattern mismatch
+	result, err = regex("^glue", testString)
+	require.NoError(t, err)
+	require.False(t, result)
+
+	// compiled pattern match
+	result, err = regex(regexp.MustCompile("(?i)GLUE"), immutable.Some(testString))
+	require.NoError(t, err)
+	require.True(t, result)
+
+	// nil data
+	result, err = regex("glue", immutable.None[string]())
+	require.NoError(t, err)
+	require.False(t, result)
+
+	// invalid pattern
+	_, err = regex("(glue", testString)
+	require.ErrorIs(t, err, ErrInvalidRegex)
+}
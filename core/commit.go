@@ -0,0 +1,91 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package core
+
+import (
+	"context"
+	"time"
+)
+
+// CommitMetadata is the metadata of a commit, recorded in each of its blocks for audit trails.
+//
+// As the metadata holds the time and author of the commit, identical writes made at different
+// times or by different nodes produce different blocks, and so different CIDs, see
+// docs/data_format_changes/i-commit-metadata-in-blocks.md.  Deltas omit the metadata from
+// their encoding if it is unset, and the metadata omits its own unset fields, so that the
+// deltas of the blocks created before it was introduced are encoded as they were.
+type CommitMetadata struct {
+	// Author is the identity of the writer of the commit, such as the ID of the peer that
+	// made it.
+	Author string `codec:",omitempty"`
+
+	// Time is the wall-clock time at which the commit was made, in milliseconds since the
+	// Unix epoch.
+	Time int64 `codec:",omitempty"`
+
+	// Message is an optional description of the commit, given to the mutation that made it.
+	Message string `codec:",omitempty"`
+}
+
+type (
+	commitAuthorContextKey  struct{}
+	commitMessageContextKey struct{}
+	commitTimeContextKey    struct{}
+)
+
+// WithCommitAuthor returns a copy of the given context, in which commits are made by the
+// given author.
+func WithCommitAuthor(ctx context.Context, author string) context.Context {
+	if author == "" {
+		return ctx
+	}
+	return context.WithValue(ctx, commitAuthorContextKey{}, author)
+}
+
+// WithDefaultCommitAuthor returns a copy of the given context, in which commits are made by the
+// given author unless the context already carries one.
+func WithDefaultCommitAuthor(ctx context.Context, author string) context.Context {
+	if _, ok := ctx.Value(commitAuthorContextKey{}).(string); ok {
+		return ctx
+	}
+	return WithCommitAuthor(ctx, author)
+}
+
+// WithCommitMessage returns a copy of the given context, in which commits are made with the
+// given message.
+func WithCommitMessage(ctx context.Context, message string) context.Context {
+	if message == "" {
+		return ctx
+	}
+	return context.WithValue(ctx, commitMessageContextKey{}, message)
+}
+
+// WithCommitTime returns a copy of the given context, in which commits are made at the given
+// time rather than the current time.
+func WithCommitTime(ctx context.Context, commitTime time.Time) context.Context {
+	return context.WithValue(ctx, commitTimeContextKey{}, commitTime)
+}
+
+// NewCommitMetadata returns the metadata of a commit made in the given context, at the time it
+// carries or otherwise now.
+func NewCommitMetadata(ctx context.Context) *CommitMetadata {
+	author, _ := ctx.Value(commitAuthorContextKey{}).(string)
+	message, _ := ctx.Value(commitMessageContextKey{}).(string)
+	commitTime, ok := ctx.Value(commitTimeContextKey{}).(time.Time)
+	if !ok {
+		commitTime = time.Now()
+	}
+	return &CommitMetadata{
+		Author:  author,
+		Time:    commitTime.UnixMilli(),
+		Message: message,
+	}
+}
@@ -0,0 +1,53 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package core
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewCommitMetadataWithoutAuthorOrMessage(t *testing.T) {
+	metadata := NewCommitMetadata(context.Background())
+	require.NotNil(t, metadata)
+	assert.Empty(t, metadata.Author)
+	assert.Empty(t, metadata.Message)
+	assert.NotZero(t, metadata.Time)
+}
+
+func TestNewCommitMetadataWithAuthorAndMessage(t *testing.T) {
+	ctx := WithCommitMessage(WithCommitAuthor(context.Background(), "peer"), "Fix typo")
+
+	metadata := NewCommitMetadata(ctx)
+	require.NotNil(t, metadata)
+	assert.Equal(t, "peer", metadata.Author)
+	assert.Equal(t, "Fix typo", metadata.Message)
+	assert.NotZero(t, metadata.Time)
+}
+
+func TestNewCommitMetadataWithDefaultAuthor(t *testing.T) {
+	ctx := WithDefaultCommitAuthor(context.Background(), "node")
+	assert.Equal(t, "node", NewCommitMetadata(ctx).Author)
+
+	ctx = WithDefaultCommitAuthor(WithCommitAuthor(context.Background(), "peer"), "node")
+	assert.Equal(t, "peer", NewCommitMetadata(ctx).Author)
+}
+
+func TestNewCommitMetadataWithTime(t *testing.T) {
+	commitTime := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := WithCommitTime(context.Background(), commitTime)
+
+	assert.Equal(t, commitTime.UnixMilli(), NewCommitMetadata(ctx).Time)
+}
@@ -18,6 +18,7 @@ import (
 
 	"github.com/sourcenetwork/defradb/core"
 	"github.com/sourcenetwork/defradb/datastore"
+	"github.com/sourcenetwork/defradb/db/base"
 	"github.com/sourcenetwork/defradb/errors"
 )
 
@@ -70,3 +71,17 @@ func (base baseCRDT) getPriority(ctx context.Context, key core.DataStoreKey) (ui
 	}
 	return prio, nil
 }
+
+// valueKey returns the key that the value of this CRDT is stored under, which is flagged
+// as deleted if the document it belongs to has been deleted.
+func (crdt baseCRDT) valueKey(ctx context.Context) (core.DataStoreKey, error) {
+	key := crdt.key.WithValueFlag()
+	marker, err := crdt.store.Get(ctx, crdt.key.ToPrimaryDataStoreKey().ToDS())
+	if err != nil && !errors.Is(err, ds.ErrNotFound) {
+		return core.DataStoreKey{}, err
+	}
+	if len(marker) == 1 && marker[0] == base.DeletedObjectMarker {
+		key = key.WithDeletedFlag()
+	}
+	return key, nil
+}
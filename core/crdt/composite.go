@@ -30,8 +30,9 @@ import (
 )
 
 var (
-	_ core.ReplicatedData = (*CompositeDAG)(nil)
-	_ core.CompositeDelta = (*CompositeDAGDelta)(nil)
+	_ core.ReplicatedData   = (*CompositeDAG)(nil)
+	_ core.CompositeDelta   = (*CompositeDAGDelta)(nil)
+	_ core.TimestampedDelta = (*CompositeDAGDelta)(nil)
 )
 
 // CompositeDAGDelta represents a delta-state update made of sub-MerkleCRDTs.
@@ -47,6 +48,12 @@ type CompositeDAGDelta struct {
 	// Status represents the status of the document. By default it is `Active`.
 	// Alternatively, if can be set to `Deleted`.
 	Status client.DocumentStatus
+	// Timestamp is the hybrid logical clock time the delta was created at.
+	//
+	// It is only set for documents with fields ordered by timestamp, and is zero otherwise.
+	Timestamp core.HLCTimestamp
+	// Metadata is the metadata of the commit the delta is part of, if any was recorded.
+	Metadata *core.CommitMetadata
 }
 
 // GetPriority gets the current priority for this delta.
@@ -59,6 +66,16 @@ func (delta *CompositeDAGDelta) SetPriority(prio uint64) {
 	delta.Priority = prio
 }
 
+// SetMetadata sets the metadata of the commit this delta is part of.
+func (delta *CompositeDAGDelta) SetMetadata(metadata *core.CommitMetadata) {
+	delta.Metadata = metadata
+}
+
+// GetTimestamp gets the hybrid logical clock time this delta was created at.
+func (delta *CompositeDAGDelta) GetTimestamp() core.HLCTimestamp {
+	return delta.Timestamp
+}
+
 // Marshal will serialize this delta to a byte array.
 func (delta *CompositeDAGDelta) Marshal() ([]byte, error) {
 	h := &codec.CborHandle{}
@@ -70,7 +87,11 @@ func (delta *CompositeDAGDelta) Marshal() ([]byte, error) {
 		Data            []byte
 		DocKey          []byte
 		Status          uint8
-	}{delta.SchemaVersionID, delta.Priority, delta.Data, delta.DocKey, delta.Status.UInt8()})
+		// The timestamp and metadata are omitted if unset so that the deltas of the blocks
+		// created before they were introduced are encoded as they were.
+		Timestamp core.HLCTimestamp    `codec:",omitempty"`
+		Metadata  *core.CommitMetadata `codec:",omitempty"`
+	}{delta.SchemaVersionID, delta.Priority, delta.Data, delta.DocKey, delta.Status.UInt8(), delta.Timestamp, delta.Metadata})
 	if err != nil {
 		return nil, err
 	}
@@ -95,6 +116,10 @@ type CompositeDAG struct {
 	//
 	// It can be used to identify the collection datastructure state at time of commit.
 	schemaVersionKey core.CollectionSchemaVersionKey
+
+	// hlc is the hybrid logical clock timestamping the deltas of the composite DAG, if they
+	// are timestamped.
+	hlc *core.HLC
 }
 
 func NewCompositeDAG(
@@ -110,6 +135,13 @@ func NewCompositeDAG(
 	}
 }
 
+// WithTimestamps returns a copy of the composite DAG CRDT that timestamps its deltas
+// with the time of the given hybrid logical clock they are created at.
+func (c CompositeDAG) WithTimestamps(hlc *core.HLC) CompositeDAG {
+	c.hlc = hlc
+	return c
+}
+
 // GetSchemaID returns the schema ID of the composite DAG CRDT.
 func (c CompositeDAG) ID() string {
 	return c.key.ToString()
@@ -126,12 +158,16 @@ func (c CompositeDAG) Set(patch []byte, links []core.DAGLink) *CompositeDAGDelta
 	sort.Slice(links, func(i, j int) bool {
 		return strings.Compare(links[i].Cid.String(), links[j].Cid.String()) < 0
 	})
-	return &CompositeDAGDelta{
+	delta := &CompositeDAGDelta{
 		Data:            patch,
 		DocKey:          []byte(c.key.DocKey),
 		SubDAGs:         links,
 		SchemaVersionID: c.schemaVersionKey.SchemaVersionId,
 	}
+	if c.hlc != nil {
+		delta.Timestamp = c.hlc.Now()
+	}
+	return delta
 }
 
 // Merge implements ReplicatedData interface.
@@ -17,6 +17,10 @@ import (
 const (
 	errFailedToGetPriority string = "failed to get priority"
 	errFailedToStoreValue  string = "failed to store value"
+	errInvalidCounterValue string = "counter values must be numbers"
+	errInvalidSetValue     string = "set values must be arrays"
+	errInvalidTextValue    string = "text values must be strings"
+	errInvalidTextPosition string = "text edit position is out of range"
 )
 
 // Errors returnable from this package.
@@ -26,8 +30,13 @@ const (
 var (
 	ErrFailedToGetPriority = errors.New(errFailedToGetPriority)
 	ErrFailedToStoreValue  = errors.New(errFailedToStoreValue)
+	ErrInvalidCounterValue = errors.New(errInvalidCounterValue)
+	ErrInvalidSetValue     = errors.New(errInvalidSetValue)
+	ErrInvalidTextValue    = errors.New(errInvalidTextValue)
+	ErrInvalidTextPosition = errors.New(errInvalidTextPosition)
 	ErrEncodingPriority    = errors.New("error encoding priority")
 	ErrDecodingPriority    = errors.New("error decoding priority")
+	ErrDecodingTimestamp   = errors.New("error decoding timestamp")
 	// ErrMismatchedMergeType - Tying to merge two ReplicatedData of different types
 	ErrMismatchedMergeType = errors.New("given type to merge does not match source")
 )
@@ -41,3 +50,29 @@ func NewErrFailedToGetPriority(inner error) error {
 func NewErrFailedToStoreValue(inner error) error {
 	return errors.Wrap(errFailedToStoreValue, inner)
 }
+
+// NewErrInvalidCounterValue returns an error indicating that the given value is not a number,
+// and so can not be held by, or added to, a counter.
+func NewErrInvalidCounterValue(value any) error {
+	return errors.New(errInvalidCounterValue, errors.NewKV("Value", value))
+}
+
+// NewErrInvalidSetValue returns an error indicating that the given value is not an array.
+func NewErrInvalidSetValue(value any) error {
+	return errors.New(errInvalidSetValue, errors.NewKV("Value", value))
+}
+
+// NewErrInvalidTextValue returns an error indicating that the given value is not a string.
+func NewErrInvalidTextValue(value any) error {
+	return errors.New(errInvalidTextValue, errors.NewKV("Value", value))
+}
+
+// NewErrInvalidTextPosition returns an error indicating that the given edit position is
+// beyond the end of the text.
+func NewErrInvalidTextPosition(position int, length int) error {
+	return errors.New(
+		errInvalidTextPosition,
+		errors.NewKV("Position", position),
+		errors.NewKV("Length", length),
+	)
+}
@@ -13,6 +13,7 @@ package crdt
 import (
 	"bytes"
 	"context"
+	"encoding/binary"
 
 	dag "github.com/ipfs/boxo/ipld/merkledag"
 	ds "github.com/ipfs/go-datastore"
@@ -22,14 +23,13 @@ import (
 	"github.com/sourcenetwork/defradb/client"
 	"github.com/sourcenetwork/defradb/core"
 	"github.com/sourcenetwork/defradb/datastore"
-	"github.com/sourcenetwork/defradb/db/base"
 	"github.com/sourcenetwork/defradb/errors"
 )
 
 var (
 	// ensure types implements core interfaces
-	_ core.ReplicatedData = (*LWWRegister)(nil)
-	_ core.Delta          = (*LWWRegDelta)(nil)
+	_ core.ReplicatedData   = (*LWWRegister)(nil)
+	_ core.TimestampedDelta = (*LWWRegDelta)(nil)
 )
 
 // LWWRegDelta is a single delta operation for an LWWRegister
@@ -39,6 +39,12 @@ type LWWRegDelta struct {
 	Priority        uint64
 	Data            []byte
 	DocKey          []byte
+	// Timestamp is the hybrid logical clock time the delta was created at.
+	//
+	// It is only set by registers ordering their values by timestamp, and is zero otherwise.
+	Timestamp core.HLCTimestamp
+	// Metadata is the metadata of the commit the delta is part of, if any was recorded.
+	Metadata *core.CommitMetadata
 }
 
 // GetPriority gets the current priority for this delta.
@@ -51,6 +57,16 @@ func (delta *LWWRegDelta) SetPriority(prio uint64) {
 	delta.Priority = prio
 }
 
+// SetMetadata sets the metadata of the commit this delta is part of.
+func (delta *LWWRegDelta) SetMetadata(metadata *core.CommitMetadata) {
+	delta.Metadata = metadata
+}
+
+// GetTimestamp gets the hybrid logical clock time this delta was created at.
+func (delta *LWWRegDelta) GetTimestamp() core.HLCTimestamp {
+	return delta.Timestamp
+}
+
 // Marshal encodes the delta using CBOR.
 // for now le'ts do cbor (quick to implement)
 func (delta *LWWRegDelta) Marshal() ([]byte, error) {
@@ -62,7 +78,11 @@ func (delta *LWWRegDelta) Marshal() ([]byte, error) {
 		Priority        uint64
 		Data            []byte
 		DocKey          []byte
-	}{delta.SchemaVersionID, delta.Priority, delta.Data, delta.DocKey})
+		// The timestamp and metadata are omitted if unset so that the deltas of the blocks
+		// created before they were introduced are encoded as they were.
+		Timestamp core.HLCTimestamp    `codec:",omitempty"`
+		Metadata  *core.CommitMetadata `codec:",omitempty"`
+	}{delta.SchemaVersionID, delta.Priority, delta.Data, delta.DocKey, delta.Timestamp, delta.Metadata})
 	if err != nil {
 		return nil, err
 	}
@@ -82,6 +102,13 @@ type LWWRegister struct {
 	//
 	// It can be used to identify the collection datastructure state at time of commit.
 	schemaVersionKey core.CollectionSchemaVersionKey
+
+	// orderByTimestamp is true if the register is ordered by the hybrid logical clock time
+	// of its deltas instead of by their priority.
+	orderByTimestamp bool
+	// hlc is the hybrid logical clock timestamping the deltas of the register, if it is
+	// ordered by their timestamps.
+	hlc *core.HLC
 }
 
 // NewLWWRegister returns a new instance of the LWWReg with the given ID.
@@ -100,6 +127,23 @@ func NewLWWRegister(
 	}
 }
 
+// NewHLCLWWRegister returns a new instance of the LWWReg with the given ID, that timestamps
+// its deltas with the given clock and keeps the value of the delta with the latest timestamp.
+//
+// Unlike the priority, the timestamp follows the physical time, so that the latest write wins
+// even if it was made to a shorter branch of the DAG, for example by a node that was offline.
+func NewHLCLWWRegister(
+	store datastore.DSReaderWriter,
+	schemaVersionKey core.CollectionSchemaVersionKey,
+	key core.DataStoreKey,
+	hlc *core.HLC,
+) LWWRegister {
+	reg := NewLWWRegister(store, schemaVersionKey, key)
+	reg.orderByTimestamp = true
+	reg.hlc = hlc
+	return reg
+}
+
 // Value gets the current register value
 // RETURN STATE
 func (reg LWWRegister) Value(ctx context.Context) ([]byte, error) {
@@ -117,11 +161,15 @@ func (reg LWWRegister) Value(ctx context.Context) ([]byte, error) {
 // RETURN DELTA
 func (reg LWWRegister) Set(value []byte) *LWWRegDelta {
 	// return NewLWWRegister(reg.id, value, reg.clock.Apply(), reg.clock)
-	return &LWWRegDelta{
+	delta := &LWWRegDelta{
 		Data:            value,
 		DocKey:          []byte(reg.key.DocKey),
 		SchemaVersionID: reg.schemaVersionKey.SchemaVersionId,
 	}
+	if reg.orderByTimestamp {
+		delta.Timestamp = reg.hlc.Now()
+	}
+	return delta
 }
 
 func (reg LWWRegister) ID() string {
@@ -146,6 +194,9 @@ func (reg LWWRegister) Merge(ctx context.Context, delta core.Delta, id string) e
 		return ErrMismatchedMergeType
 	}
 
+	if reg.orderByTimestamp {
+		return reg.setTimestampedValue(ctx, d.Data, d.GetPriority(), d.Timestamp)
+	}
 	return reg.setValue(ctx, d.Data, d.GetPriority())
 }
 
@@ -158,14 +209,10 @@ func (reg LWWRegister) setValue(ctx context.Context, val []byte, priority uint64
 	// if the current priority is higher ignore put
 	// else if the current value is lexicographically
 	// greater than the new then ignore
-	key := reg.key.WithValueFlag()
-	marker, err := reg.store.Get(ctx, reg.key.ToPrimaryDataStoreKey().ToDS())
-	if err != nil && !errors.Is(err, ds.ErrNotFound) {
+	key, err := reg.valueKey(ctx)
+	if err != nil {
 		return err
 	}
-	if bytes.Equal(marker, []byte{base.DeletedObjectMarker}) {
-		key = key.WithDeletedFlag()
-	}
 	if priority < curPrio {
 		return nil
 	} else if priority == curPrio {
@@ -190,6 +237,81 @@ func (reg LWWRegister) setValue(ctx context.Context, val []byte, priority uint64
 	return reg.setPriority(ctx, reg.key, priority)
 }
 
+func (reg LWWRegister) setTimestampedValue(
+	ctx context.Context,
+	val []byte,
+	priority uint64,
+	timestamp core.HLCTimestamp,
+) error {
+	curTimestamp, err := reg.getTimestamp(ctx)
+	if err != nil {
+		return err
+	}
+
+	// the priority is still tracked as it is the height of the DAG
+	curPrio, err := reg.getPriority(ctx, reg.key)
+	if err != nil {
+		return NewErrFailedToGetPriority(err)
+	}
+	if priority > curPrio {
+		err = reg.setPriority(ctx, reg.key, priority)
+		if err != nil {
+			return err
+		}
+	}
+
+	// if the current timestamp is later ignore put
+	// else if the current value is lexicographically
+	// greater than the new then ignore
+	key, err := reg.valueKey(ctx)
+	if err != nil {
+		return err
+	}
+	if timestamp < curTimestamp {
+		return nil
+	} else if timestamp == curTimestamp {
+		curValue, _ := reg.store.Get(ctx, key.ToDS())
+		if len(curValue) > 0 {
+			curValue = curValue[1:]
+		}
+		if bytes.Compare(curValue, val) >= 0 {
+			return nil
+		}
+	}
+
+	buf := append([]byte{byte(client.LWW_HLC_REGISTER)}, val...)
+	err = reg.store.Put(ctx, key.ToDS(), buf)
+	if err != nil {
+		return NewErrFailedToStoreValue(err)
+	}
+
+	return reg.setTimestamp(ctx, timestamp)
+}
+
+// getTimestamp returns the timestamp of the current value of the register, which is zero
+// if it has no value.
+func (reg LWWRegister) getTimestamp(ctx context.Context) (core.HLCTimestamp, error) {
+	buf, err := reg.store.Get(ctx, reg.key.WithStateFlag().ToDS())
+	if err != nil {
+		if errors.Is(err, ds.ErrNotFound) {
+			return 0, nil
+		}
+		return 0, err
+	}
+
+	timestamp, n := binary.Uvarint(buf)
+	if n <= 0 {
+		return 0, ErrDecodingTimestamp
+	}
+	return core.HLCTimestamp(timestamp), nil
+}
+
+func (reg LWWRegister) setTimestamp(ctx context.Context, timestamp core.HLCTimestamp) error {
+	buf := make([]byte, binary.MaxVarintLen64)
+	n := binary.PutUvarint(buf, uint64(timestamp))
+	return reg.store.Put(ctx, reg.key.WithStateFlag().ToDS(), buf[:n])
+}
+
 // DeltaDecode is a typed helper to extract
 // a LWWRegDelta from a ipld.Node
 // for now let's do cbor (quick to implement)
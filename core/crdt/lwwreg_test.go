@@ -14,6 +14,7 @@ import (
 	"context"
 	"reflect"
 	"testing"
+	"time"
 
 	dag "github.com/ipfs/boxo/ipld/merkledag"
 	"github.com/ipfs/go-cid"
@@ -253,3 +254,96 @@ func TestLWWRegisterDeltaDecode(t *testing.T) {
 		return
 	}
 }
+
+func setupHLCLWWRegister() LWWRegister {
+	store := newMockStore()
+	key := core.DataStoreKey{DocKey: "AAAA-BBBB"}
+	return NewHLCLWWRegister(store, core.CollectionSchemaVersionKey{}, key, core.NewHLC(time.Now, 0))
+}
+
+func TestHLCLWWRegisterSetTimestampsDelta(t *testing.T) {
+	lww := setupHLCLWWRegister()
+	first := lww.Set([]byte("first"))
+	second := lww.Set([]byte("second"))
+
+	if first.Timestamp == 0 {
+		t.Error("Expected delta to be timestamped")
+		return
+	}
+	if second.Timestamp <= first.Timestamp {
+		t.Errorf("Expected later delta timestamp %v to be after %v", second.Timestamp, first.Timestamp)
+	}
+}
+
+func TestLWWRegisterSetDoesNotTimestampDelta(t *testing.T) {
+	lww := setupLWWRegister()
+	delta := lww.Set([]byte("test"))
+
+	if delta.Timestamp != 0 {
+		t.Errorf("Expected delta not to be timestamped, has %v", delta.Timestamp)
+	}
+}
+
+func TestHLCLWWRegisterMergeOfLaterTimestampWithLowerPriority(t *testing.T) {
+	ctx := context.Background()
+	lww := setupHLCLWWRegister()
+
+	older := lww.Set([]byte("older"))
+	older.SetPriority(5)
+	newer := lww.Set([]byte("newer"))
+	newer.SetPriority(2)
+
+	for _, delta := range []*LWWRegDelta{newer, older} {
+		err := lww.Merge(ctx, delta, "test")
+		if err != nil {
+			t.Errorf("Unexpected error: %s\n", err)
+			return
+		}
+	}
+
+	val, err := lww.Value(ctx)
+	if err != nil {
+		t.Errorf("Unexpected error: %s\n", err)
+		return
+	}
+	if string(val) != "newer" {
+		t.Errorf("LWWRegister value is %s, expected %s", val, "newer")
+	}
+
+	prio, err := lww.getPriority(ctx, lww.key)
+	if err != nil {
+		t.Errorf("Unexpected error: %s\n", err)
+		return
+	}
+	if prio != 5 {
+		t.Errorf("LWWRegister priority is %v, expected %v", prio, 5)
+	}
+}
+
+func TestHLCLWWRegisterDeltaDecode(t *testing.T) {
+	delta := &LWWRegDelta{
+		Data:      []byte("test"),
+		Priority:  uint64(10),
+		Timestamp: core.HLCTimestamp(1 << 20),
+	}
+
+	node, err := makeNode(delta, []cid.Cid{})
+	if err != nil {
+		t.Errorf("Received errors while creating node: %v", err)
+		return
+	}
+
+	extractedDelta, err := setupHLCLWWRegister().DeltaDecode(node)
+	if err != nil {
+		t.Errorf("Received error while extracing node: %v", err)
+		return
+	}
+
+	if !reflect.DeepEqual(extractedDelta, delta) {
+		t.Errorf(
+			"Extracted delta is not the same value as the original. Expected %v, have %v",
+			delta,
+			extractedDelta,
+		)
+	}
+}
@@ -0,0 +1,329 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package crdt
+
+import (
+	"bytes"
+	"context"
+	"sort"
+
+	"github.com/fxamacker/cbor/v2"
+	dag "github.com/ipfs/boxo/ipld/merkledag"
+	ds "github.com/ipfs/go-datastore"
+	ipld "github.com/ipfs/go-ipld-format"
+	"github.com/ugorji/go/codec"
+
+	"github.com/sourcenetwork/defradb/client"
+	"github.com/sourcenetwork/defradb/core"
+	"github.com/sourcenetwork/defradb/datastore"
+	"github.com/sourcenetwork/defradb/errors"
+)
+
+var (
+	// ensure types implements core interfaces
+	_ core.ReplicatedData = (*MVRegister)(nil)
+	_ core.Delta          = (*MVRegDelta)(nil)
+)
+
+// MVRegDelta is a single delta operation for an MVRegister, holding the value written and the
+// IDs of the blocks of the values it replaces.
+type MVRegDelta struct {
+	SchemaVersionID string
+	Priority        uint64
+	Data            []byte
+	DocKey          []byte
+	Replaces        []string
+	// Metadata is the metadata of the commit the delta is part of, if any was recorded.
+	Metadata *core.CommitMetadata
+}
+
+// GetPriority gets the current priority for this delta.
+func (delta *MVRegDelta) GetPriority() uint64 {
+	return delta.Priority
+}
+
+// SetPriority will set the priority for this delta.
+func (delta *MVRegDelta) SetPriority(prio uint64) {
+	delta.Priority = prio
+}
+
+// SetMetadata sets the metadata of the commit this delta is part of.
+func (delta *MVRegDelta) SetMetadata(metadata *core.CommitMetadata) {
+	delta.Metadata = metadata
+}
+
+// Marshal encodes the delta using CBOR.
+func (delta *MVRegDelta) Marshal() ([]byte, error) {
+	h := &codec.CborHandle{}
+	buf := bytes.NewBuffer(nil)
+	enc := codec.NewEncoder(buf, h)
+	err := enc.Encode(struct {
+		SchemaVersionID string
+		Priority        uint64
+		Data            []byte
+		DocKey          []byte
+		Replaces        []string
+		Metadata        *core.CommitMetadata `codec:",omitempty"`
+	}{delta.SchemaVersionID, delta.Priority, delta.Data, delta.DocKey, delta.Replaces, delta.Metadata})
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func (delta *MVRegDelta) Value() any {
+	return delta.Data
+}
+
+// MVRegister, Multi-Value Register, is a register keeping every value written concurrently,
+// rather than picking a winner amongst them.
+//
+// Each write replaces the values the writer has observed, so concurrent writes are kept
+// side by side until a later write, having observed all of them, replaces them again.
+//
+// The value of the register is that of its newest value, by priority and then by value as
+// for LWWRegisters, so that it may be read as any other field.  Its internal state, holding
+// all of its values, is held separately under the state key of the field.
+type MVRegister struct {
+	baseCRDT
+
+	// schemaVersionKey is the schema version datastore key at the time of commit.
+	//
+	// It can be used to identify the collection datastructure state at time of commit.
+	schemaVersionKey core.CollectionSchemaVersionKey
+
+	// isMerged returns true if the block of the given ID has already been merged.
+	isMerged BlockMergedFn
+}
+
+// BlockMergedFn returns true if the block of the given ID has already been merged into a CRDT.
+type BlockMergedFn func(ctx context.Context, id string) (bool, error)
+
+// MVRegValue is a value of an MVRegister, written by the block of the given ID.
+type MVRegValue struct {
+	ID       string
+	Priority uint64
+	Data     []byte
+}
+
+// mvRegState is the internal state of an MVRegister.
+type mvRegState struct {
+	// Values holds the values of the register, newest first.
+	Values []MVRegValue
+	// Replaced holds the IDs of the blocks of the replaced values that have not been merged
+	// yet, so that they are not kept if they are merged after the values replacing them.
+	//
+	// Each ID is dropped once its block is merged, and the IDs of blocks that have already
+	// been merged are never added, so that it only grows with the blocks still to be merged.
+	Replaced map[string]struct{}
+}
+
+// NewMVRegister returns a new instance of the MVRegister with the given ID.
+func NewMVRegister(
+	store datastore.DSReaderWriter,
+	schemaVersionKey core.CollectionSchemaVersionKey,
+	key core.DataStoreKey,
+	isMerged BlockMergedFn,
+) MVRegister {
+	return MVRegister{
+		baseCRDT:         newBaseCRDT(store, key),
+		schemaVersionKey: schemaVersionKey,
+		isMerged:         isMerged,
+	}
+}
+
+// Value gets the current value of the register, which is its newest value.
+func (reg MVRegister) Value(ctx context.Context) ([]byte, error) {
+	valueK := reg.key.WithValueFlag()
+	buf, err := reg.store.Get(ctx, valueK.ToDS())
+	if err != nil {
+		return nil, err
+	}
+	// ignore the first byte (CRDT Type marker) from the returned value
+	buf = buf[1:]
+	return buf, nil
+}
+
+// Values returns all of the values of the register, newest first.
+//
+// The register holds more than one value if they were written concurrently, and no write has
+// replaced them since.
+func (reg MVRegister) Values(ctx context.Context) ([]MVRegValue, error) {
+	state, err := reg.getState(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return state.Values, nil
+}
+
+// Set generates a new delta with the supplied value, replacing all of the current values of
+// the register.
+func (reg MVRegister) Set(ctx context.Context, value []byte) (*MVRegDelta, error) {
+	state, err := reg.getState(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	replaces := make([]string, len(state.Values))
+	for i, value := range state.Values {
+		replaces[i] = value.ID
+	}
+	return &MVRegDelta{
+		Data:            value,
+		DocKey:          []byte(reg.key.DocKey),
+		SchemaVersionID: reg.schemaVersionKey.SchemaVersionId,
+		Replaces:        replaces,
+	}, nil
+}
+
+func (reg MVRegister) ID() string {
+	return reg.key.ToString()
+}
+
+// Merge implements ReplicatedData interface.
+// Merge adds the value of the given delta to the register, identified by the given block ID,
+// unless it has been replaced already, and removes the values it replaces.
+func (reg MVRegister) Merge(ctx context.Context, delta core.Delta, id string) error {
+	d, ok := delta.(*MVRegDelta)
+	if !ok {
+		return ErrMismatchedMergeType
+	}
+
+	state, err := reg.getState(ctx)
+	if err != nil {
+		return err
+	}
+
+	replaces := make(map[string]struct{}, len(d.Replaces))
+	for _, replaced := range d.Replaces {
+		replaces[replaced] = struct{}{}
+	}
+
+	values := []MVRegValue{}
+	for _, value := range state.Values {
+		if _, isReplaced := replaces[value.ID]; isReplaced {
+			// The block of the value has been merged, so its ID need not be held.
+			delete(replaces, value.ID)
+			continue
+		}
+		values = append(values, value)
+	}
+	if _, isReplaced := state.Replaced[id]; isReplaced {
+		delete(state.Replaced, id)
+	} else {
+		values = append(values, MVRegValue{ID: id, Priority: d.GetPriority(), Data: d.Data})
+	}
+	for replaced := range replaces {
+		if _, isHeld := state.Replaced[replaced]; isHeld {
+			continue
+		}
+		isMerged, err := reg.isMerged(ctx, replaced)
+		if err != nil {
+			return err
+		}
+		if isMerged {
+			continue
+		}
+		if state.Replaced == nil {
+			state.Replaced = map[string]struct{}{}
+		}
+		state.Replaced[replaced] = struct{}{}
+	}
+	sort.SliceStable(values, func(i, j int) bool {
+		return values[i].isNewerThan(values[j])
+	})
+	state.Values = values
+
+	err = reg.setState(ctx, state)
+	if err != nil {
+		return err
+	}
+
+	curPrio, err := reg.getPriority(ctx, reg.key)
+	if err != nil {
+		return NewErrFailedToGetPriority(err)
+	}
+	if d.GetPriority() > curPrio {
+		return reg.setPriority(ctx, reg.key, d.GetPriority())
+	}
+	return nil
+}
+
+// getState returns the internal state of the register, which is empty if the register has
+// never been written to.
+func (reg MVRegister) getState(ctx context.Context) (mvRegState, error) {
+	buf, err := reg.store.Get(ctx, reg.key.WithStateFlag().ToDS())
+	if err != nil {
+		if errors.Is(err, ds.ErrNotFound) {
+			return mvRegState{}, nil
+		}
+		return mvRegState{}, err
+	}
+
+	var state mvRegState
+	err = cbor.Unmarshal(buf, &state)
+	return state, err
+}
+
+// setState stores the given internal state of the register, and its newest value as its
+// value.
+func (reg MVRegister) setState(ctx context.Context, state mvRegState) error {
+	stateBuf, err := cbor.Marshal(state)
+	if err != nil {
+		return err
+	}
+	err = reg.store.Put(ctx, reg.key.WithStateFlag().ToDS(), stateBuf)
+	if err != nil {
+		return NewErrFailedToStoreValue(err)
+	}
+
+	if len(state.Values) == 0 {
+		return nil
+	}
+	key, err := reg.valueKey(ctx)
+	if err != nil {
+		return err
+	}
+	// prepend the value byte array with a single byte indicator for the CRDT Type.
+	buf := append([]byte{byte(client.MV_REGISTER)}, state.Values[0].Data...)
+	err = reg.store.Put(ctx, key.ToDS(), buf)
+	if err != nil {
+		return NewErrFailedToStoreValue(err)
+	}
+	return nil
+}
+
+// DeltaDecode is a typed helper to extract
+// a MVRegDelta from a ipld.Node
+func (reg MVRegister) DeltaDecode(node ipld.Node) (core.Delta, error) {
+	delta := &MVRegDelta{}
+	pbNode, ok := node.(*dag.ProtoNode)
+	if !ok {
+		return nil, client.NewErrUnexpectedType[*dag.ProtoNode]("ipld.Node", node)
+	}
+	data := pbNode.Data()
+	h := &codec.CborHandle{}
+	dec := codec.NewDecoderBytes(data, h)
+	err := dec.Decode(delta)
+	if err != nil {
+		return nil, err
+	}
+	return delta, nil
+}
+
+// isNewerThan returns true if this value is newer than the given one, by priority and then by
+// value, as the value of an LWWRegister would be chosen.
+func (value MVRegValue) isNewerThan(other MVRegValue) bool {
+	if value.Priority != other.Priority {
+		return value.Priority > other.Priority
+	}
+	return bytes.Compare(value.Data, other.Data) > 0
+}
@@ -0,0 +1,145 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package crdt
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcenetwork/defradb/core"
+)
+
+func setupMVRegister() MVRegister {
+	store := newMockStore()
+	key := core.DataStoreKey{DocKey: "AAAA-BBBB"}
+	return NewMVRegister(store, core.CollectionSchemaVersionKey{}, key, isMergedIn())
+}
+
+// isMergedIn returns a BlockMergedFn for which only the blocks of the given IDs have been
+// merged.
+func isMergedIn(ids ...string) BlockMergedFn {
+	return func(_ context.Context, id string) (bool, error) {
+		return containsID(ids, id), nil
+	}
+}
+
+func mustSetMVRegister(ctx context.Context, t *testing.T, reg MVRegister, value string, priority uint64) *MVRegDelta {
+	delta, err := reg.Set(ctx, []byte(value))
+	require.NoError(t, err)
+	delta.SetPriority(priority)
+	return delta
+}
+
+func TestMVRegisterMergeOfConcurrentWritesKeepsBoth(t *testing.T) {
+	ctx := context.Background()
+	reg := setupMVRegister()
+
+	first := mustSetMVRegister(ctx, t, reg, "a", 1)
+	second := mustSetMVRegister(ctx, t, reg, "b", 1)
+	require.NoError(t, reg.Merge(ctx, first, "first"))
+	require.NoError(t, reg.Merge(ctx, second, "second"))
+
+	values, err := reg.Values(ctx)
+	require.NoError(t, err)
+	require.Equal(
+		t,
+		[]MVRegValue{{ID: "second", Priority: 1, Data: []byte("b")}, {ID: "first", Priority: 1, Data: []byte("a")}},
+		values,
+	)
+
+	value, err := reg.Value(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []byte("b"), value)
+}
+
+func TestMVRegisterMergeOfLaterWriteReplacesConcurrentValues(t *testing.T) {
+	ctx := context.Background()
+	reg := setupMVRegister()
+
+	first := mustSetMVRegister(ctx, t, reg, "a", 1)
+	second := mustSetMVRegister(ctx, t, reg, "b", 1)
+	require.NoError(t, reg.Merge(ctx, first, "first"))
+	require.NoError(t, reg.Merge(ctx, second, "second"))
+	third := mustSetMVRegister(ctx, t, reg, "c", 2)
+	require.Equal(t, []string{"second", "first"}, third.Replaces)
+	require.NoError(t, reg.Merge(ctx, third, "third"))
+
+	values, err := reg.Values(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []MVRegValue{{ID: "third", Priority: 2, Data: []byte("c")}}, values)
+}
+
+func TestMVRegisterMergeOfReplacedValueAfterReplacement(t *testing.T) {
+	ctx := context.Background()
+	source := setupMVRegister()
+
+	first := mustSetMVRegister(ctx, t, source, "a", 1)
+	require.NoError(t, source.Merge(ctx, first, "first"))
+	second := mustSetMVRegister(ctx, t, source, "b", 2)
+
+	target := setupMVRegister()
+	require.NoError(t, target.Merge(ctx, second, "second"))
+	require.NoError(t, target.Merge(ctx, first, "first"))
+
+	values, err := target.Values(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []MVRegValue{{ID: "second", Priority: 2, Data: []byte("b")}}, values)
+}
+
+func TestMVRegisterMergeDropsReplacedIDsOnceMerged(t *testing.T) {
+	ctx := context.Background()
+	source := setupMVRegister()
+
+	first := mustSetMVRegister(ctx, t, source, "a", 1)
+	require.NoError(t, source.Merge(ctx, first, "first"))
+	second := mustSetMVRegister(ctx, t, source, "b", 2)
+	third := mustSetMVRegister(ctx, t, source, "c", 2)
+
+	target := setupMVRegister()
+	require.NoError(t, target.Merge(ctx, second, "second"))
+	state, err := target.getState(ctx)
+	require.NoError(t, err)
+	require.Equal(t, map[string]struct{}{"first": {}}, state.Replaced)
+
+	require.NoError(t, target.Merge(ctx, first, "first"))
+	state, err = target.getState(ctx)
+	require.NoError(t, err)
+	require.Empty(t, state.Replaced)
+
+	// The concurrent replacement of the already merged value must not be held.
+	target.isMerged = isMergedIn("first", "second")
+	require.NoError(t, target.Merge(ctx, third, "third"))
+	state, err = target.getState(ctx)
+	require.NoError(t, err)
+	require.Empty(t, state.Replaced)
+	require.Equal(
+		t,
+		[]MVRegValue{{ID: "third", Priority: 2, Data: []byte("c")}, {ID: "second", Priority: 2, Data: []byte("b")}},
+		state.Values,
+	)
+}
+
+func TestMVRegisterDeltaDecode(t *testing.T) {
+	ctx := context.Background()
+	reg := setupMVRegister()
+	require.NoError(t, reg.Merge(ctx, mustSetMVRegister(ctx, t, reg, "a", 1), "first"))
+
+	delta := mustSetMVRegister(ctx, t, reg, "b", 2)
+
+	node, err := makeNode(delta, nil)
+	require.NoError(t, err)
+
+	decoded, err := reg.DeltaDecode(node)
+	require.NoError(t, err)
+	require.Equal(t, delta, decoded)
+}
@@ -0,0 +1,553 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package crdt
+
+import (
+	"bytes"
+	"context"
+	"sort"
+
+	"github.com/fxamacker/cbor/v2"
+	dag "github.com/ipfs/boxo/ipld/merkledag"
+	ds "github.com/ipfs/go-datastore"
+	ipld "github.com/ipfs/go-ipld-format"
+	"github.com/ugorji/go/codec"
+
+	"github.com/sourcenetwork/defradb/client"
+	"github.com/sourcenetwork/defradb/core"
+	"github.com/sourcenetwork/defradb/datastore"
+	"github.com/sourcenetwork/defradb/errors"
+)
+
+var (
+	// ensure types implements core interfaces
+	_ core.ReplicatedData = (*ORSet)(nil)
+	_ core.Delta          = (*ORSetDelta)(nil)
+)
+
+// ORSetDelta is a single delta operation for an ORSet, holding the CBOR encoded
+// ORSetOperation applied to the set.
+type ORSetDelta struct {
+	SchemaVersionID string
+	Priority        uint64
+	Data            []byte
+	DocKey          []byte
+	// Metadata is the metadata of the commit the delta is part of, if any was recorded.
+	Metadata *core.CommitMetadata
+}
+
+// ORSetOperation holds the elements added to, and removed from, an ORSet by a delta.
+type ORSetOperation struct {
+	// Added holds the CBOR encoded elements added to the set.
+	Added [][]byte
+	// Removed holds the CBOR encoded elements removed from the set, with the tags of
+	// their additions that had been observed at the time of the removal.
+	Removed []ORSetRemoval
+}
+
+// ORSetRemoval is the removal of an element from an ORSet.
+type ORSetRemoval struct {
+	Element []byte
+	Tags    []string
+}
+
+// GetPriority gets the current priority for this delta.
+func (delta *ORSetDelta) GetPriority() uint64 {
+	return delta.Priority
+}
+
+// SetPriority will set the priority for this delta.
+func (delta *ORSetDelta) SetPriority(prio uint64) {
+	delta.Priority = prio
+}
+
+// SetMetadata sets the metadata of the commit this delta is part of.
+func (delta *ORSetDelta) SetMetadata(metadata *core.CommitMetadata) {
+	delta.Metadata = metadata
+}
+
+// Marshal encodes the delta using CBOR.
+func (delta *ORSetDelta) Marshal() ([]byte, error) {
+	h := &codec.CborHandle{}
+	buf := bytes.NewBuffer(nil)
+	enc := codec.NewEncoder(buf, h)
+	err := enc.Encode(struct {
+		SchemaVersionID string
+		Priority        uint64
+		Data            []byte
+		DocKey          []byte
+		Metadata        *core.CommitMetadata `codec:",omitempty"`
+	}{delta.SchemaVersionID, delta.Priority, delta.Data, delta.DocKey, delta.Metadata})
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func (delta *ORSetDelta) Value() any {
+	return delta.Data
+}
+
+// ORSet, Observed-Remove Set, is a CRDT holding a set of elements that may be concurrently
+// added and removed.
+//
+// Each addition of an element is tagged with the ID of the block adding it, and a removal only
+// removes the additions it has observed, so an element added concurrently with its removal is
+// kept.  The tags of removed additions are kept as tombstones, so that they are not restored if
+// a removal is merged before the addition it observed.
+//
+// The value of the set is held as an array of its elements, ordered by value, with its internal
+// state held separately under the state key of the field.
+type ORSet struct {
+	baseCRDT
+
+	// schemaVersionKey is the schema version datastore key at the time of commit.
+	//
+	// It can be used to identify the collection datastructure state at time of commit.
+	schemaVersionKey core.CollectionSchemaVersionKey
+}
+
+// orSetState is the internal state of an ORSet.
+type orSetState struct {
+	Elements []orSetElement
+}
+
+// orSetElement is the state of a single element of an ORSet.
+type orSetElement struct {
+	Value []byte
+	// Tags holds the tags of the additions of the element that have not been removed.
+	Tags []string
+	// Removed holds the tags of the additions of the element that have been removed.
+	Removed []string
+}
+
+// NewORSet returns a new instance of the ORSet with the given ID.
+func NewORSet(
+	store datastore.DSReaderWriter,
+	schemaVersionKey core.CollectionSchemaVersionKey,
+	key core.DataStoreKey,
+) ORSet {
+	return ORSet{
+		baseCRDT:         newBaseCRDT(store, key),
+		schemaVersionKey: schemaVersionKey,
+	}
+}
+
+// Value gets the current elements of the set as a CBOR encoded array.
+func (s ORSet) Value(ctx context.Context) ([]byte, error) {
+	valueK := s.key.WithValueFlag()
+	buf, err := s.store.Get(ctx, valueK.ToDS())
+	if err != nil {
+		return nil, err
+	}
+	// ignore the first byte (CRDT Type marker) from the returned value
+	buf = buf[1:]
+	return buf, nil
+}
+
+// Update generates a new delta adding and removing the elements of the given CBOR encoded
+// arrays.  An element that is both added and removed will be held by the set.
+func (s ORSet) Update(ctx context.Context, added []byte, removed []byte) (*ORSetDelta, error) {
+	addedElements, err := decodeSetElements(added)
+	if err != nil {
+		return nil, err
+	}
+	removedElements, err := decodeSetElements(removed)
+	if err != nil {
+		return nil, err
+	}
+	state, err := s.getState(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.newDelta(state, addedElements, removedElements)
+}
+
+// Set generates a new delta updating the set such that it will hold the elements of the given
+// CBOR encoded array once merged.  Elements it already holds are not added again.
+func (s ORSet) Set(ctx context.Context, value []byte) (*ORSetDelta, error) {
+	elements, err := decodeSetElements(value)
+	if err != nil {
+		return nil, err
+	}
+	state, err := s.getState(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	given := make(map[string]struct{}, len(elements))
+	var added [][]byte
+	for _, element := range elements {
+		given[string(element)] = struct{}{}
+		if !state.holds(element) {
+			added = append(added, element)
+		}
+	}
+	var removed [][]byte
+	for _, element := range state.Elements {
+		if _, isGiven := given[string(element.Value)]; !isGiven && len(element.Tags) > 0 {
+			removed = append(removed, element.Value)
+		}
+	}
+	return s.newDelta(state, added, removed)
+}
+
+// newDelta returns a delta adding the given elements, and removing the observed additions of
+// the given elements from the given state.
+func (s ORSet) newDelta(state orSetState, added [][]byte, removed [][]byte) (*ORSetDelta, error) {
+	op := ORSetOperation{
+		Added: dedupeSetElements(added),
+	}
+	for _, element := range dedupeSetElements(removed) {
+		tags := state.tags(element)
+		if len(tags) > 0 {
+			op.Removed = append(op.Removed, ORSetRemoval{Element: element, Tags: tags})
+		}
+	}
+
+	buf, err := cbor.Marshal(op)
+	if err != nil {
+		return nil, err
+	}
+	return &ORSetDelta{
+		Data:            buf,
+		DocKey:          []byte(s.key.DocKey),
+		SchemaVersionID: s.schemaVersionKey.SchemaVersionId,
+	}, nil
+}
+
+func (s ORSet) ID() string {
+	return s.key.ToString()
+}
+
+// Merge implements ReplicatedData interface.
+// Merge tags the additions of the given delta with the given block ID, and removes the
+// additions observed by its removals.
+func (s ORSet) Merge(ctx context.Context, delta core.Delta, id string) error {
+	d, ok := delta.(*ORSetDelta)
+	if !ok {
+		return ErrMismatchedMergeType
+	}
+
+	var op ORSetOperation
+	err := cbor.Unmarshal(d.Data, &op)
+	if err != nil {
+		return err
+	}
+	state, err := s.getState(ctx)
+	if err != nil {
+		return err
+	}
+
+	elements := make(map[string]*orSetElement, len(state.Elements))
+	for i := range state.Elements {
+		elements[string(state.Elements[i].Value)] = &state.Elements[i]
+	}
+	getElement := func(value []byte) *orSetElement {
+		element, exists := elements[string(value)]
+		if !exists {
+			element = &orSetElement{Value: value}
+			elements[string(value)] = element
+		}
+		return element
+	}
+
+	for _, removal := range op.Removed {
+		element := getElement(removal.Element)
+		for _, tag := range removal.Tags {
+			element.Tags = removeTag(element.Tags, tag)
+			element.Removed = addTag(element.Removed, tag)
+		}
+	}
+	for _, value := range op.Added {
+		element := getElement(value)
+		if !containsID(element.Removed, id) {
+			element.Tags = addTag(element.Tags, id)
+		}
+	}
+
+	state.Elements = make([]orSetElement, 0, len(elements))
+	for _, element := range elements {
+		state.Elements = append(state.Elements, *element)
+	}
+	err = s.setState(ctx, state)
+	if err != nil {
+		return err
+	}
+
+	curPrio, err := s.getPriority(ctx, s.key)
+	if err != nil {
+		return NewErrFailedToGetPriority(err)
+	}
+	if d.GetPriority() > curPrio {
+		return s.setPriority(ctx, s.key, d.GetPriority())
+	}
+	return nil
+}
+
+// getState returns the internal state of the set, which is empty if the set has never been
+// written to.
+func (s ORSet) getState(ctx context.Context) (orSetState, error) {
+	buf, err := s.store.Get(ctx, s.key.WithStateFlag().ToDS())
+	if err != nil {
+		if errors.Is(err, ds.ErrNotFound) {
+			return orSetState{}, nil
+		}
+		return orSetState{}, err
+	}
+
+	var state orSetState
+	err = cbor.Unmarshal(buf, &state)
+	return state, err
+}
+
+// setState stores the given internal state of the set, and the array of the elements it holds
+// as the value of the set.
+func (s ORSet) setState(ctx context.Context, state orSetState) error {
+	type heldElement struct {
+		value   any
+		encoded cbor.RawMessage
+	}
+	held := []heldElement{}
+	for _, element := range state.Elements {
+		if len(element.Tags) == 0 {
+			continue
+		}
+		var value any
+		err := cbor.Unmarshal(element.Value, &value)
+		if err != nil {
+			return err
+		}
+		held = append(held, heldElement{value: value, encoded: element.Value})
+	}
+	sort.Slice(held, func(i, j int) bool {
+		c := compareSetElements(held[i].value, held[j].value)
+		if c == 0 {
+			return bytes.Compare(held[i].encoded, held[j].encoded) < 0
+		}
+		return c < 0
+	})
+	// The state is ordered so that it is encoded identically by every node.
+	sort.Slice(state.Elements, func(i, j int) bool {
+		return bytes.Compare(state.Elements[i].Value, state.Elements[j].Value) < 0
+	})
+
+	values := make([]cbor.RawMessage, len(held))
+	for i, element := range held {
+		values[i] = element.encoded
+	}
+	valueBuf, err := cbor.Marshal(values)
+	if err != nil {
+		return err
+	}
+	stateBuf, err := cbor.Marshal(state)
+	if err != nil {
+		return err
+	}
+
+	err = s.store.Put(ctx, s.key.WithStateFlag().ToDS(), stateBuf)
+	if err != nil {
+		return NewErrFailedToStoreValue(err)
+	}
+	key, err := s.valueKey(ctx)
+	if err != nil {
+		return err
+	}
+	// prepend the value byte array with a single byte indicator for the CRDT Type.
+	err = s.store.Put(ctx, key.ToDS(), append([]byte{byte(client.OR_SET)}, valueBuf...))
+	if err != nil {
+		return NewErrFailedToStoreValue(err)
+	}
+	return nil
+}
+
+// DeltaDecode is a typed helper to extract
+// a ORSetDelta from a ipld.Node
+func (s ORSet) DeltaDecode(node ipld.Node) (core.Delta, error) {
+	delta := &ORSetDelta{}
+	pbNode, ok := node.(*dag.ProtoNode)
+	if !ok {
+		return nil, client.NewErrUnexpectedType[*dag.ProtoNode]("ipld.Node", node)
+	}
+	data := pbNode.Data()
+	h := &codec.CborHandle{}
+	dec := codec.NewDecoderBytes(data, h)
+	err := dec.Decode(delta)
+	if err != nil {
+		return nil, err
+	}
+	return delta, nil
+}
+
+// holds returns true if the set holds the given element.
+func (state orSetState) holds(value []byte) bool {
+	return len(state.tags(value)) > 0
+}
+
+// tags returns the tags of the additions of the given element that have not been removed.
+func (state orSetState) tags(value []byte) []string {
+	for _, element := range state.Elements {
+		if bytes.Equal(element.Value, value) {
+			return element.Tags
+		}
+	}
+	return nil
+}
+
+// decodeSetElements returns the canonical CBOR encoding of each element of the given CBOR
+// encoded array.
+//
+// A nil value, as held by deleted fields, holds no elements.
+func decodeSetElements(buf []byte) ([][]byte, error) {
+	if len(buf) == 0 {
+		return nil, nil
+	}
+
+	var value any
+	err := cbor.Unmarshal(buf, &value)
+	if err != nil {
+		return nil, err
+	}
+	if value == nil {
+		return nil, nil
+	}
+	array, isArray := value.([]any)
+	if !isArray {
+		return nil, NewErrInvalidSetValue(value)
+	}
+
+	em, err := cbor.CanonicalEncOptions().EncMode()
+	if err != nil {
+		return nil, err
+	}
+	elements := make([][]byte, len(array))
+	for i, item := range array {
+		elements[i], err = em.Marshal(item)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return elements, nil
+}
+
+func dedupeSetElements(elements [][]byte) [][]byte {
+	seen := make(map[string]struct{}, len(elements))
+	result := [][]byte{}
+	for _, element := range elements {
+		if _, isSeen := seen[string(element)]; isSeen {
+			continue
+		}
+		seen[string(element)] = struct{}{}
+		result = append(result, element)
+	}
+	return result
+}
+
+func containsID(ids []string, id string) bool {
+	for _, i := range ids {
+		if i == id {
+			return true
+		}
+	}
+	return false
+}
+
+func addTag(tags []string, tag string) []string {
+	if containsID(tags, tag) {
+		return tags
+	}
+	tags = append(tags, tag)
+	sort.Strings(tags)
+	return tags
+}
+
+func removeTag(tags []string, tag string) []string {
+	result := tags[:0]
+	for _, t := range tags {
+		if t != tag {
+			result = append(result, t)
+		}
+	}
+	return result
+}
+
+// compareSetElements orders the decoded elements of a set, placing nil before booleans,
+// booleans before numbers and numbers before strings.
+func compareSetElements(a any, b any) int {
+	rankA, rankB := setElementRank(a), setElementRank(b)
+	if rankA != rankB {
+		return rankA - rankB
+	}
+
+	switch x := a.(type) {
+	case bool:
+		y := b.(bool)
+		switch {
+		case x == y:
+			return 0
+		case !x:
+			return -1
+		default:
+			return 1
+		}
+	case string:
+		y := b.(string)
+		switch {
+		case x < y:
+			return -1
+		case x > y:
+			return 1
+		default:
+			return 0
+		}
+	}
+
+	if rankA == 2 {
+		x, y := setElementNumber(a), setElementNumber(b)
+		switch {
+		case x < y:
+			return -1
+		case x > y:
+			return 1
+		}
+	}
+	return 0
+}
+
+func setElementRank(value any) int {
+	switch value.(type) {
+	case nil:
+		return 0
+	case bool:
+		return 1
+	case int64, uint64, float32, float64:
+		return 2
+	case string:
+		return 3
+	default:
+		return 4
+	}
+}
+
+func setElementNumber(value any) float64 {
+	switch number := value.(type) {
+	case int64:
+		return float64(number)
+	case uint64:
+		return float64(number)
+	case float32:
+		return float64(number)
+	case float64:
+		return number
+	default:
+		return 0
+	}
+}
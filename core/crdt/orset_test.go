@@ -0,0 +1,137 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package crdt
+
+import (
+	"context"
+	"testing"
+
+	"github.com/fxamacker/cbor/v2"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcenetwork/defradb/core"
+)
+
+func setupORSet() ORSet {
+	store := newMockStore()
+	key := core.DataStoreKey{DocKey: "AAAA-BBBB"}
+	return NewORSet(store, core.CollectionSchemaVersionKey{}, key)
+}
+
+func mustEncodeSetValue(t *testing.T, value any) []byte {
+	buf, err := cbor.Marshal(value)
+	require.NoError(t, err)
+	return buf
+}
+
+func requireSetValue(ctx context.Context, t *testing.T, set ORSet, expected []any) {
+	buf, err := set.Value(ctx)
+	require.NoError(t, err)
+	var value []any
+	require.NoError(t, cbor.Unmarshal(buf, &value))
+	require.Equal(t, expected, value)
+}
+
+func TestORSetMergeOfConcurrentAdditions(t *testing.T) {
+	ctx := context.Background()
+	set := setupORSet()
+
+	first, err := set.Update(ctx, mustEncodeSetValue(t, []string{"b"}), nil)
+	require.NoError(t, err)
+	second, err := set.Update(ctx, mustEncodeSetValue(t, []string{"a", "b"}), nil)
+	require.NoError(t, err)
+
+	require.NoError(t, set.Merge(ctx, first, "first"))
+	require.NoError(t, set.Merge(ctx, second, "second"))
+
+	requireSetValue(ctx, t, set, []any{"a", "b"})
+}
+
+func TestORSetMergeOfAdditionConcurrentWithRemoval(t *testing.T) {
+	ctx := context.Background()
+	set := setupORSet()
+
+	add, err := set.Update(ctx, mustEncodeSetValue(t, []string{"a", "b"}), nil)
+	require.NoError(t, err)
+	require.NoError(t, set.Merge(ctx, add, "add"))
+
+	// The removal has only observed the first addition, so the concurrent re-addition wins.
+	remove, err := set.Update(ctx, nil, mustEncodeSetValue(t, []string{"a"}))
+	require.NoError(t, err)
+	readd, err := set.Update(ctx, mustEncodeSetValue(t, []string{"a"}), nil)
+	require.NoError(t, err)
+
+	require.NoError(t, set.Merge(ctx, readd, "readd"))
+	require.NoError(t, set.Merge(ctx, remove, "remove"))
+
+	requireSetValue(ctx, t, set, []any{"a", "b"})
+}
+
+func TestORSetMergeOfRemovalBeforeObservedAddition(t *testing.T) {
+	ctx := context.Background()
+	source := setupORSet()
+	target := setupORSet()
+
+	add, err := source.Update(ctx, mustEncodeSetValue(t, []string{"a"}), nil)
+	require.NoError(t, err)
+	require.NoError(t, source.Merge(ctx, add, "add"))
+	remove, err := source.Update(ctx, nil, mustEncodeSetValue(t, []string{"a"}))
+	require.NoError(t, err)
+
+	// The removal is merged before the addition it observed, which must not restore the element.
+	require.NoError(t, target.Merge(ctx, remove, "remove"))
+	require.NoError(t, target.Merge(ctx, add, "add"))
+
+	requireSetValue(ctx, t, target, []any{})
+}
+
+func TestORSetSetAddsAndRemovesByDifference(t *testing.T) {
+	ctx := context.Background()
+	set := setupORSet()
+
+	add, err := set.Update(ctx, mustEncodeSetValue(t, []int64{3, 1}), nil)
+	require.NoError(t, err)
+	require.NoError(t, set.Merge(ctx, add, "add"))
+
+	delta, err := set.Set(ctx, mustEncodeSetValue(t, []int64{2, 3, 2}))
+	require.NoError(t, err)
+	var op ORSetOperation
+	require.NoError(t, cbor.Unmarshal(delta.Data, &op))
+	require.Equal(t, [][]byte{mustEncodeSetValue(t, 2)}, op.Added)
+	require.Equal(t, []ORSetRemoval{{Element: mustEncodeSetValue(t, 1), Tags: []string{"add"}}}, op.Removed)
+
+	require.NoError(t, set.Merge(ctx, delta, "set"))
+	requireSetValue(ctx, t, set, []any{uint64(2), uint64(3)})
+}
+
+func TestORSetSetWithNonArrayReturnsError(t *testing.T) {
+	ctx := context.Background()
+	set := setupORSet()
+
+	_, err := set.Set(ctx, mustEncodeSetValue(t, "a"))
+	require.ErrorIs(t, err, ErrInvalidSetValue)
+}
+
+func TestORSetDeltaDecode(t *testing.T) {
+	ctx := context.Background()
+	set := setupORSet()
+
+	delta, err := set.Update(ctx, mustEncodeSetValue(t, []string{"a"}), nil)
+	require.NoError(t, err)
+	delta.SetPriority(2)
+
+	node, err := makeNode(delta, nil)
+	require.NoError(t, err)
+
+	decoded, err := set.DeltaDecode(node)
+	require.NoError(t, err)
+	require.Equal(t, delta, decoded)
+}
@@ -0,0 +1,295 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package crdt
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/fxamacker/cbor/v2"
+	dag "github.com/ipfs/boxo/ipld/merkledag"
+	ds "github.com/ipfs/go-datastore"
+	ipld "github.com/ipfs/go-ipld-format"
+	"github.com/ugorji/go/codec"
+
+	"github.com/sourcenetwork/defradb/client"
+	"github.com/sourcenetwork/defradb/core"
+	"github.com/sourcenetwork/defradb/datastore"
+	"github.com/sourcenetwork/defradb/errors"
+)
+
+var (
+	// ensure types implements core interfaces
+	_ core.ReplicatedData = (*PNCounter)(nil)
+	_ core.Delta          = (*PNCounterDelta)(nil)
+)
+
+// PNCounterDelta is a single delta operation for a PNCounter, holding the CBOR encoded
+// amount, positive or negative, that the counter is incremented by.
+type PNCounterDelta struct {
+	SchemaVersionID string
+	Priority        uint64
+	Data            []byte
+	DocKey          []byte
+	// Metadata is the metadata of the commit the delta is part of, if any was recorded.
+	Metadata *core.CommitMetadata
+}
+
+// GetPriority gets the current priority for this delta.
+func (delta *PNCounterDelta) GetPriority() uint64 {
+	return delta.Priority
+}
+
+// SetPriority will set the priority for this delta.
+func (delta *PNCounterDelta) SetPriority(prio uint64) {
+	delta.Priority = prio
+}
+
+// SetMetadata sets the metadata of the commit this delta is part of.
+func (delta *PNCounterDelta) SetMetadata(metadata *core.CommitMetadata) {
+	delta.Metadata = metadata
+}
+
+// Marshal encodes the delta using CBOR.
+func (delta *PNCounterDelta) Marshal() ([]byte, error) {
+	h := &codec.CborHandle{}
+	buf := bytes.NewBuffer(nil)
+	enc := codec.NewEncoder(buf, h)
+	err := enc.Encode(struct {
+		SchemaVersionID string
+		Priority        uint64
+		Data            []byte
+		DocKey          []byte
+		Metadata        *core.CommitMetadata `codec:",omitempty"`
+	}{delta.SchemaVersionID, delta.Priority, delta.Data, delta.DocKey, delta.Metadata})
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func (delta *PNCounterDelta) Value() any {
+	return delta.Data
+}
+
+// PNCounter, Positive-Negative Counter, is a CRDT holding a number that may be concurrently
+// incremented and decremented.
+//
+// Each delta holds the amount the counter is incremented by, and the value of the counter is
+// the sum of the deltas merged into it.  As the Merkle clock merges each delta exactly once,
+// concurrent increments are never lost.  Integer counters remain integers unless incremented
+// by a float.
+type PNCounter struct {
+	baseCRDT
+
+	// schemaVersionKey is the schema version datastore key at the time of commit.
+	//
+	// It can be used to identify the collection datastructure state at time of commit.
+	schemaVersionKey core.CollectionSchemaVersionKey
+}
+
+// NewPNCounter returns a new instance of the PNCounter with the given ID.
+func NewPNCounter(
+	store datastore.DSReaderWriter,
+	schemaVersionKey core.CollectionSchemaVersionKey,
+	key core.DataStoreKey,
+) PNCounter {
+	return PNCounter{
+		baseCRDT:         newBaseCRDT(store, key),
+		schemaVersionKey: schemaVersionKey,
+	}
+}
+
+// Value gets the current counter value.
+func (c PNCounter) Value(ctx context.Context) ([]byte, error) {
+	valueK := c.key.WithValueFlag()
+	buf, err := c.store.Get(ctx, valueK.ToDS())
+	if err != nil {
+		return nil, err
+	}
+	// ignore the first byte (CRDT Type marker) from the returned value
+	buf = buf[1:]
+	return buf, nil
+}
+
+// Increment generates a new delta incrementing the counter by the given CBOR encoded amount.
+func (c PNCounter) Increment(value []byte) *PNCounterDelta {
+	return &PNCounterDelta{
+		Data:            value,
+		DocKey:          []byte(c.key.DocKey),
+		SchemaVersionID: c.schemaVersionKey.SchemaVersionId,
+	}
+}
+
+// Set generates a new delta incrementing the counter by the difference between the given CBOR
+// encoded value and its current value, such that it will hold the given value once merged.
+func (c PNCounter) Set(ctx context.Context, value []byte) (*PNCounterDelta, error) {
+	current, err := c.currentValue(ctx)
+	if err != nil {
+		return nil, err
+	}
+	target, err := decodeCounterValue(value)
+	if err != nil {
+		return nil, err
+	}
+
+	var difference any
+	if i, isInt := current.(int64); isInt {
+		if t, isInt := target.(int64); isInt {
+			difference = t - i
+		}
+	}
+	if difference == nil {
+		difference = counterFloat(target) - counterFloat(current)
+	}
+
+	buf, err := cbor.Marshal(difference)
+	if err != nil {
+		return nil, err
+	}
+	return c.Increment(buf), nil
+}
+
+func (c PNCounter) ID() string {
+	return c.key.ToString()
+}
+
+// Merge implements ReplicatedData interface.
+// Merge adds the amount held by the given delta to the counter value.
+func (c PNCounter) Merge(ctx context.Context, delta core.Delta, id string) error {
+	d, ok := delta.(*PNCounterDelta)
+	if !ok {
+		return ErrMismatchedMergeType
+	}
+
+	current, err := c.currentValue(ctx)
+	if err != nil {
+		return err
+	}
+	increment, err := decodeCounterValue(d.Data)
+	if err != nil {
+		return err
+	}
+
+	var sum any
+	if i, isInt := current.(int64); isInt {
+		if inc, isInt := increment.(int64); isInt {
+			sum = i + inc
+		}
+	}
+	if sum == nil {
+		sum = counterFloat(current) + counterFloat(increment)
+	}
+
+	buf, err := cbor.Marshal(sum)
+	if err != nil {
+		return err
+	}
+
+	key, err := c.valueKey(ctx)
+	if err != nil {
+		return err
+	}
+	// prepend the value byte array with a single byte indicator for the CRDT Type.
+	err = c.store.Put(ctx, key.ToDS(), append([]byte{byte(client.PN_COUNTER)}, buf...))
+	if err != nil {
+		return NewErrFailedToStoreValue(err)
+	}
+
+	curPrio, err := c.getPriority(ctx, c.key)
+	if err != nil {
+		return NewErrFailedToGetPriority(err)
+	}
+	if d.GetPriority() > curPrio {
+		return c.setPriority(ctx, c.key, d.GetPriority())
+	}
+	return nil
+}
+
+// currentValue returns the decoded current value of the counter, which is zero if it has no
+// value.
+func (c PNCounter) currentValue(ctx context.Context) (any, error) {
+	key, err := c.valueKey(ctx)
+	if err != nil {
+		return nil, err
+	}
+	buf, err := c.store.Get(ctx, key.ToDS())
+	if err != nil {
+		if errors.Is(err, ds.ErrNotFound) {
+			return int64(0), nil
+		}
+		return nil, err
+	}
+	if len(buf) <= 1 {
+		return int64(0), nil
+	}
+	// ignore the first byte (CRDT Type marker) of the stored value
+	return decodeCounterValue(buf[1:])
+}
+
+// DeltaDecode is a typed helper to extract
+// a PNCounterDelta from a ipld.Node
+func (c PNCounter) DeltaDecode(node ipld.Node) (core.Delta, error) {
+	delta := &PNCounterDelta{}
+	pbNode, ok := node.(*dag.ProtoNode)
+	if !ok {
+		return nil, client.NewErrUnexpectedType[*dag.ProtoNode]("ipld.Node", node)
+	}
+	data := pbNode.Data()
+	h := &codec.CborHandle{}
+	dec := codec.NewDecoderBytes(data, h)
+	err := dec.Decode(delta)
+	if err != nil {
+		return nil, err
+	}
+	return delta, nil
+}
+
+// decodeCounterValue returns the given CBOR encoded number as either an int64 or a float64.
+//
+// A nil value, as held by deleted fields, is zero.
+func decodeCounterValue(buf []byte) (any, error) {
+	if len(buf) == 0 {
+		return int64(0), nil
+	}
+
+	var value any
+	err := cbor.Unmarshal(buf, &value)
+	if err != nil {
+		return nil, err
+	}
+
+	switch number := value.(type) {
+	case nil:
+		return int64(0), nil
+	case uint64:
+		return int64(number), nil
+	case int64:
+		return number, nil
+	case float64:
+		return number, nil
+	case float32:
+		return float64(number), nil
+	default:
+		return nil, NewErrInvalidCounterValue(value)
+	}
+}
+
+func counterFloat(value any) float64 {
+	switch number := value.(type) {
+	case int64:
+		return float64(number)
+	case float64:
+		return number
+	default:
+		return 0
+	}
+}
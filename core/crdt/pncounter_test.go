@@ -0,0 +1,104 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package crdt
+
+import (
+	"context"
+	"testing"
+
+	"github.com/fxamacker/cbor/v2"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcenetwork/defradb/core"
+)
+
+func setupPNCounter() PNCounter {
+	store := newMockStore()
+	key := core.DataStoreKey{DocKey: "AAAA-BBBB"}
+	return NewPNCounter(store, core.CollectionSchemaVersionKey{}, key)
+}
+
+func mustEncodeCounterValue(t *testing.T, value any) []byte {
+	buf, err := cbor.Marshal(value)
+	require.NoError(t, err)
+	return buf
+}
+
+func requireCounterValue(ctx context.Context, t *testing.T, counter PNCounter, expected any) {
+	buf, err := counter.Value(ctx)
+	require.NoError(t, err)
+	value, err := decodeCounterValue(buf)
+	require.NoError(t, err)
+	require.Equal(t, expected, value)
+}
+
+func TestPNCounterMergeOfConcurrentIncrements(t *testing.T) {
+	ctx := context.Background()
+	counter := setupPNCounter()
+
+	// Deltas of the same priority are concurrent, and must both be counted.
+	first := counter.Increment(mustEncodeCounterValue(t, int64(5)))
+	first.SetPriority(1)
+	second := counter.Increment(mustEncodeCounterValue(t, int64(-2)))
+	second.SetPriority(1)
+
+	require.NoError(t, counter.Merge(ctx, first, "first"))
+	require.NoError(t, counter.Merge(ctx, second, "second"))
+
+	requireCounterValue(ctx, t, counter, int64(3))
+}
+
+func TestPNCounterMergeOfFloatIncrement(t *testing.T) {
+	ctx := context.Background()
+	counter := setupPNCounter()
+
+	require.NoError(t, counter.Merge(ctx, counter.Increment(mustEncodeCounterValue(t, int64(2))), "first"))
+	require.NoError(t, counter.Merge(ctx, counter.Increment(mustEncodeCounterValue(t, 0.5)), "second"))
+
+	requireCounterValue(ctx, t, counter, 2.5)
+}
+
+func TestPNCounterSetIncrementsByDifference(t *testing.T) {
+	ctx := context.Background()
+	counter := setupPNCounter()
+
+	require.NoError(t, counter.Merge(ctx, counter.Increment(mustEncodeCounterValue(t, int64(10))), "first"))
+
+	delta, err := counter.Set(ctx, mustEncodeCounterValue(t, int64(4)))
+	require.NoError(t, err)
+	increment, err := decodeCounterValue(delta.Data)
+	require.NoError(t, err)
+	require.Equal(t, int64(-6), increment)
+
+	require.NoError(t, counter.Merge(ctx, delta, "second"))
+	requireCounterValue(ctx, t, counter, int64(4))
+}
+
+func TestPNCounterMergeOfNonNumberErrors(t *testing.T) {
+	ctx := context.Background()
+	counter := setupPNCounter()
+
+	err := counter.Merge(ctx, counter.Increment(mustEncodeCounterValue(t, "one")), "first")
+	require.ErrorIs(t, err, ErrInvalidCounterValue)
+}
+
+func TestPNCounterDeltaDecode(t *testing.T) {
+	counter := setupPNCounter()
+	delta := counter.Increment(mustEncodeCounterValue(t, int64(7)))
+	delta.SetPriority(3)
+
+	node, err := makeNode(delta, nil)
+	require.NoError(t, err)
+
+	decoded, err := counter.DeltaDecode(node)
+	require.NoError(t, err)
+	require.Equal(t, delta, decoded)
+}
@@ -0,0 +1,656 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package crdt
+
+import (
+	"bytes"
+	"context"
+	"strconv"
+
+	"github.com/fxamacker/cbor/v2"
+	dag "github.com/ipfs/boxo/ipld/merkledag"
+	ds "github.com/ipfs/go-datastore"
+	ipld "github.com/ipfs/go-ipld-format"
+	"github.com/ugorji/go/codec"
+
+	"github.com/sourcenetwork/defradb/client"
+	"github.com/sourcenetwork/defradb/core"
+	"github.com/sourcenetwork/defradb/datastore"
+	"github.com/sourcenetwork/defradb/errors"
+)
+
+var (
+	// ensure types implements core interfaces
+	_ core.ReplicatedData = (*RGAText)(nil)
+	_ core.Delta          = (*RGATextDelta)(nil)
+)
+
+// RGATextDelta is a single delta operation for an RGAText, holding the CBOR encoded
+// TextOperation applied to the text.
+type RGATextDelta struct {
+	SchemaVersionID string
+	Priority        uint64
+	Data            []byte
+	DocKey          []byte
+	// Metadata is the metadata of the commit the delta is part of, if any was recorded.
+	Metadata *core.CommitMetadata
+}
+
+// TextOperation holds the characters inserted into, and deleted from, an RGAText by a delta.
+type TextOperation struct {
+	Inserts []TextInsert
+	Deletes []TextID
+}
+
+// TextInsert is the insertion of a run of characters after a character of an RGAText.
+type TextInsert struct {
+	// After identifies the character the run is inserted after, or is nil if the run is
+	// inserted at the start of the text.
+	After *TextID
+	Text  string
+}
+
+// TextID identifies a character of an RGAText.
+type TextID struct {
+	// Block is the ID of the block inserting the character, which is empty within the delta
+	// of that block.
+	Block string
+	// Index is the position of the character within the characters inserted by the block.
+	Index int
+}
+
+// TextEdit is an edit of the text at a position, counted in characters.
+//
+// The given number of characters are deleted from the position before the given text is
+// inserted at it.
+type TextEdit struct {
+	Position int
+	Insert   string
+	Delete   int
+}
+
+// GetPriority gets the current priority for this delta.
+func (delta *RGATextDelta) GetPriority() uint64 {
+	return delta.Priority
+}
+
+// SetPriority will set the priority for this delta.
+func (delta *RGATextDelta) SetPriority(prio uint64) {
+	delta.Priority = prio
+}
+
+// SetMetadata sets the metadata of the commit this delta is part of.
+func (delta *RGATextDelta) SetMetadata(metadata *core.CommitMetadata) {
+	delta.Metadata = metadata
+}
+
+// Marshal encodes the delta using CBOR.
+func (delta *RGATextDelta) Marshal() ([]byte, error) {
+	h := &codec.CborHandle{}
+	buf := bytes.NewBuffer(nil)
+	enc := codec.NewEncoder(buf, h)
+	err := enc.Encode(struct {
+		SchemaVersionID string
+		Priority        uint64
+		Data            []byte
+		DocKey          []byte
+		Metadata        *core.CommitMetadata `codec:",omitempty"`
+	}{delta.SchemaVersionID, delta.Priority, delta.Data, delta.DocKey, delta.Metadata})
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func (delta *RGATextDelta) Value() any {
+	return delta.Data
+}
+
+// RGAText, Replicated Growable Array, is a CRDT holding a text that may be concurrently
+// edited at the character level.
+//
+// Each character is identified by the block inserting it, and is inserted after the character
+// preceding it at the time of the edit.  Characters inserted after the same character are
+// ordered newest first, by the priority of their block, so that concurrent insertions at the
+// same position are never interleaved.  Deleted characters are kept as tombstones, and
+// insertions after characters that have not been merged yet are held until they are.
+//
+// The value of the text is held as a string, with its internal state held separately under
+// the state key of the field.  Each merged character is held under its own key beneath it,
+// linked to the character following it, so that a merge only reads and rewrites the
+// characters it inserts or deletes and the characters next to them.  The order of the
+// characters that have not been deleted is cached within the state, so that the text can be
+// edited without walking the tombstones of its history.
+type RGAText struct {
+	baseCRDT
+
+	// schemaVersionKey is the schema version datastore key at the time of commit.
+	//
+	// It can be used to identify the collection datastructure state at time of commit.
+	schemaVersionKey core.CollectionSchemaVersionKey
+}
+
+// rgaTextState is the internal state of an RGAText.
+//
+// Only the fields exported are held under the state key of the field, with the merged
+// characters held under keys of their own and read as they are needed.
+type rgaTextState struct {
+	// First is the ID of the first merged character, if any.
+	First *TextID
+	// Pending holds the characters inserted after characters that have not been merged yet.
+	Pending []rgaTextChar
+	// Deleted holds the IDs of the deleted characters that have not been merged yet.
+	Deleted []TextID
+	// Visible holds the IDs of the characters that have not been deleted, in order.
+	Visible []TextID
+
+	// text holds the characters that have not been deleted, in the order of Visible.
+	text []rune
+	// chars holds the merged characters read or changed since the state was read, by ID.
+	chars map[TextID]*rgaTextChar
+	// changed holds the IDs of the merged characters changed since the state was read.
+	changed map[TextID]struct{}
+}
+
+// rgaTextChar is a single character of an RGAText.
+type rgaTextChar struct {
+	ID       TextID
+	After    *TextID
+	Priority uint64
+	Value    string
+	// Deleted is true if the character has been deleted, in which case it is kept as a
+	// tombstone.
+	Deleted bool
+	// Next is the ID of the merged character following this one, if any.
+	Next *TextID
+}
+
+// NewRGAText returns a new instance of the RGAText with the given ID.
+func NewRGAText(
+	store datastore.DSReaderWriter,
+	schemaVersionKey core.CollectionSchemaVersionKey,
+	key core.DataStoreKey,
+) RGAText {
+	return RGAText{
+		baseCRDT:         newBaseCRDT(store, key),
+		schemaVersionKey: schemaVersionKey,
+	}
+}
+
+// Value gets the current text as a CBOR encoded string.
+func (t RGAText) Value(ctx context.Context) ([]byte, error) {
+	valueK := t.key.WithValueFlag()
+	buf, err := t.store.Get(ctx, valueK.ToDS())
+	if err != nil {
+		return nil, err
+	}
+	// ignore the first byte (CRDT Type marker) from the returned value
+	buf = buf[1:]
+	return buf, nil
+}
+
+// Edit generates a new delta applying the given edits to the text, in order, such that the
+// position of each edit is in the text produced by the edits before it.
+func (t RGAText) Edit(ctx context.Context, edits []TextEdit) (*RGATextDelta, error) {
+	state, err := t.getState(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	visible := append([]TextID{}, state.Visible...)
+	op := TextOperation{}
+	next := 0
+	for _, edit := range edits {
+		if edit.Position < 0 || edit.Position > len(visible) {
+			return nil, NewErrInvalidTextPosition(edit.Position, len(visible))
+		}
+		if edit.Delete > 0 {
+			end := edit.Position + edit.Delete
+			if end > len(visible) {
+				return nil, NewErrInvalidTextPosition(end, len(visible))
+			}
+			op.Deletes = append(op.Deletes, visible[edit.Position:end]...)
+			visible = append(visible[:edit.Position:edit.Position], visible[end:]...)
+		}
+		if edit.Insert != "" {
+			insert := TextInsert{Text: edit.Insert}
+			if edit.Position > 0 {
+				after := visible[edit.Position-1]
+				insert.After = &after
+			}
+			op.Inserts = append(op.Inserts, insert)
+
+			inserted := []TextID{}
+			for range []rune(edit.Insert) {
+				inserted = append(inserted, TextID{Index: next})
+				next++
+			}
+			rest := append(inserted, visible[edit.Position:]...)
+			visible = append(visible[:edit.Position:edit.Position], rest...)
+		}
+	}
+
+	buf, err := cbor.Marshal(op)
+	if err != nil {
+		return nil, err
+	}
+	return &RGATextDelta{
+		Data:            buf,
+		DocKey:          []byte(t.key.DocKey),
+		SchemaVersionID: t.schemaVersionKey.SchemaVersionId,
+	}, nil
+}
+
+// Set generates a new delta replacing the text with the given CBOR encoded string, by
+// replacing the characters between their common prefix and suffix.
+func (t RGAText) Set(ctx context.Context, value []byte) (*RGATextDelta, error) {
+	text, err := decodeTextValue(value)
+	if err != nil {
+		return nil, err
+	}
+	state, err := t.getState(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	current := state.text
+	target := []rune(text)
+	prefix := 0
+	for prefix < len(current) && prefix < len(target) && current[prefix] == target[prefix] {
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(current)-prefix && suffix < len(target)-prefix &&
+		current[len(current)-1-suffix] == target[len(target)-1-suffix] {
+		suffix++
+	}
+
+	return t.Edit(ctx, []TextEdit{
+		{
+			Position: prefix,
+			Delete:   len(current) - prefix - suffix,
+			Insert:   string(target[prefix : len(target)-suffix]),
+		},
+	})
+}
+
+func (t RGAText) ID() string {
+	return t.key.ToString()
+}
+
+// Merge implements ReplicatedData interface.
+// Merge identifies the characters inserted by the given delta by the given block ID, and
+// integrates them into the text, before deleting the characters deleted by it.
+func (t RGAText) Merge(ctx context.Context, delta core.Delta, id string) error {
+	d, ok := delta.(*RGATextDelta)
+	if !ok {
+		return ErrMismatchedMergeType
+	}
+
+	var op TextOperation
+	err := cbor.Unmarshal(d.Data, &op)
+	if err != nil {
+		return err
+	}
+	state, err := t.getState(ctx)
+	if err != nil {
+		return err
+	}
+
+	resolve := func(textID TextID) TextID {
+		if textID.Block == "" {
+			textID.Block = id
+		}
+		return textID
+	}
+
+	index := 0
+	for _, insert := range op.Inserts {
+		var after *TextID
+		if insert.After != nil {
+			resolved := resolve(*insert.After)
+			after = &resolved
+		}
+		for _, r := range insert.Text {
+			char := rgaTextChar{
+				ID:       TextID{Block: id, Index: index},
+				After:    after,
+				Priority: d.GetPriority(),
+				Value:    string(r),
+			}
+			state.Pending = append(state.Pending, char)
+			after = &char.ID
+			index++
+		}
+	}
+	err = t.integratePending(ctx, &state)
+	if err != nil {
+		return err
+	}
+	for _, deleted := range op.Deletes {
+		err = t.delete(ctx, &state, resolve(deleted))
+		if err != nil {
+			return err
+		}
+	}
+
+	err = t.setState(ctx, state)
+	if err != nil {
+		return err
+	}
+
+	curPrio, err := t.getPriority(ctx, t.key)
+	if err != nil {
+		return NewErrFailedToGetPriority(err)
+	}
+	if d.GetPriority() > curPrio {
+		return t.setPriority(ctx, t.key, d.GetPriority())
+	}
+	return nil
+}
+
+// getState returns the internal state of the text, which is empty if the text has never been
+// written to.
+//
+// The merged characters are not read, but are read by getChar as they are needed.
+func (t RGAText) getState(ctx context.Context) (rgaTextState, error) {
+	state := rgaTextState{
+		chars:   map[TextID]*rgaTextChar{},
+		changed: map[TextID]struct{}{},
+	}
+	buf, err := t.store.Get(ctx, t.key.WithStateFlag().ToDS())
+	if err != nil {
+		if errors.Is(err, ds.ErrNotFound) {
+			return state, nil
+		}
+		return state, err
+	}
+	err = cbor.Unmarshal(buf, &state)
+	if err != nil {
+		return state, err
+	}
+
+	key, err := t.valueKey(ctx)
+	if err != nil {
+		return state, err
+	}
+	valueBuf, err := t.store.Get(ctx, key.ToDS())
+	if err != nil && !errors.Is(err, ds.ErrNotFound) {
+		return state, err
+	}
+	if len(valueBuf) > 0 {
+		// ignore the first byte (CRDT Type marker) from the stored value
+		valueBuf = valueBuf[1:]
+	}
+	text, err := decodeTextValue(valueBuf)
+	if err != nil {
+		return state, err
+	}
+	state.text = []rune(text)
+	return state, nil
+}
+
+// getChar returns the merged character with the given ID, reading it from the store if it has
+// not been read yet, or nil if it has not been merged.
+func (t RGAText) getChar(ctx context.Context, state *rgaTextState, id TextID) (*rgaTextChar, error) {
+	if char, ok := state.chars[id]; ok {
+		return char, nil
+	}
+	buf, err := t.store.Get(ctx, t.charKey(id))
+	if err != nil {
+		if errors.Is(err, ds.ErrNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	var char rgaTextChar
+	err = cbor.Unmarshal(buf, &char)
+	if err != nil {
+		return nil, err
+	}
+	state.chars[id] = &char
+	return &char, nil
+}
+
+// charKey returns the key the character with the given ID is held under.
+func (t RGAText) charKey(id TextID) ds.Key {
+	return t.key.WithStateFlag().ToDS().ChildString(id.Block).ChildString(strconv.Itoa(id.Index))
+}
+
+// setState stores the changes to the given internal state of the text, and the text itself as
+// its value.
+func (t RGAText) setState(ctx context.Context, state rgaTextState) error {
+	valueBuf, err := cbor.Marshal(string(state.text))
+	if err != nil {
+		return err
+	}
+	stateBuf, err := cbor.Marshal(state)
+	if err != nil {
+		return err
+	}
+
+	err = t.store.Put(ctx, t.key.WithStateFlag().ToDS(), stateBuf)
+	if err != nil {
+		return NewErrFailedToStoreValue(err)
+	}
+	for id := range state.changed {
+		charBuf, err := cbor.Marshal(state.chars[id])
+		if err != nil {
+			return err
+		}
+		err = t.store.Put(ctx, t.charKey(id), charBuf)
+		if err != nil {
+			return NewErrFailedToStoreValue(err)
+		}
+	}
+	key, err := t.valueKey(ctx)
+	if err != nil {
+		return err
+	}
+	// prepend the value byte array with a single byte indicator for the CRDT Type.
+	err = t.store.Put(ctx, key.ToDS(), append([]byte{byte(client.RGA_TEXT)}, valueBuf...))
+	if err != nil {
+		return NewErrFailedToStoreValue(err)
+	}
+	return nil
+}
+
+// DeltaDecode is a typed helper to extract
+// a RGATextDelta from a ipld.Node
+func (t RGAText) DeltaDecode(node ipld.Node) (core.Delta, error) {
+	delta := &RGATextDelta{}
+	pbNode, ok := node.(*dag.ProtoNode)
+	if !ok {
+		return nil, client.NewErrUnexpectedType[*dag.ProtoNode]("ipld.Node", node)
+	}
+	data := pbNode.Data()
+	h := &codec.CborHandle{}
+	dec := codec.NewDecoderBytes(data, h)
+	err := dec.Decode(delta)
+	if err != nil {
+		return nil, err
+	}
+	return delta, nil
+}
+
+// integratePending inserts each pending character whose preceding character has been merged
+// into the text.
+func (t RGAText) integratePending(ctx context.Context, state *rgaTextState) error {
+	for integrated := true; integrated; {
+		integrated = false
+		remaining := []rgaTextChar{}
+		for _, char := range state.Pending {
+			// previous is the character the given one is linked after, if any, and next is its
+			// link to the character following it.
+			var previous *rgaTextChar
+			next := &state.First
+			if char.After != nil {
+				after, err := t.getChar(ctx, state, *char.After)
+				if err != nil {
+					return err
+				}
+				if after == nil {
+					remaining = append(remaining, char)
+					continue
+				}
+				previous = after
+				next = &after.Next
+			}
+
+			// Skip the newer characters inserted after the same character, and the characters
+			// inserted after them, all of which are newer than the given character.
+			for *next != nil {
+				following, err := t.getChar(ctx, state, **next)
+				if err != nil {
+					return err
+				}
+				if !following.isNewerThan(char) {
+					break
+				}
+				previous = following
+				next = &previous.Next
+			}
+			if previous != nil {
+				state.changed[previous.ID] = struct{}{}
+			}
+
+			inserted := char
+			inserted.Next = *next
+			for i, id := range state.Deleted {
+				if id == inserted.ID {
+					inserted.Deleted = true
+					state.Deleted = append(state.Deleted[:i], state.Deleted[i+1:]...)
+					break
+				}
+			}
+			*next = &inserted.ID
+			state.chars[inserted.ID] = &inserted
+			state.changed[inserted.ID] = struct{}{}
+			integrated = true
+
+			if !inserted.Deleted {
+				position, err := t.visiblePosition(ctx, state, previous, inserted.Next)
+				if err != nil {
+					return err
+				}
+				state.Visible = append(state.Visible, TextID{})
+				copy(state.Visible[position+1:], state.Visible[position:])
+				state.Visible[position] = inserted.ID
+				state.text = append(state.text, 0)
+				copy(state.text[position+1:], state.text[position:])
+				state.text[position] = []rune(inserted.Value)[0]
+			}
+		}
+		state.Pending = remaining
+	}
+	return nil
+}
+
+// visiblePosition returns the position, within the characters that have not been deleted, of a
+// character linked between the given previous character and the character with the given ID.
+func (t RGAText) visiblePosition(
+	ctx context.Context,
+	state *rgaTextState,
+	previous *rgaTextChar,
+	next *TextID,
+) (int, error) {
+	if previous == nil {
+		return 0, nil
+	}
+	if !previous.Deleted {
+		return state.visibleIndex(previous.ID) + 1, nil
+	}
+	// The previous character is a tombstone, so the character is placed before the first
+	// following character that has not been deleted.
+	for id := next; id != nil; {
+		char, err := t.getChar(ctx, state, *id)
+		if err != nil {
+			return 0, err
+		}
+		if !char.Deleted {
+			return state.visibleIndex(char.ID), nil
+		}
+		id = char.Next
+	}
+	return len(state.Visible), nil
+}
+
+// delete marks the character with the given ID as deleted, or holds its ID until it is merged
+// if it has not been merged yet.
+func (t RGAText) delete(ctx context.Context, state *rgaTextState, id TextID) error {
+	char, err := t.getChar(ctx, state, id)
+	if err != nil {
+		return err
+	}
+	if char != nil {
+		if !char.Deleted {
+			char.Deleted = true
+			state.changed[id] = struct{}{}
+			position := state.visibleIndex(id)
+			state.Visible = append(state.Visible[:position], state.Visible[position+1:]...)
+			state.text = append(state.text[:position], state.text[position+1:]...)
+		}
+		return nil
+	}
+	for _, deleted := range state.Deleted {
+		if deleted == id {
+			return nil
+		}
+	}
+	state.Deleted = append(state.Deleted, id)
+	return nil
+}
+
+// visibleIndex returns the position of the character with the given ID within the characters
+// that have not been deleted.
+func (state rgaTextState) visibleIndex(id TextID) int {
+	for i, visible := range state.Visible {
+		if visible == id {
+			return i
+		}
+	}
+	return -1
+}
+
+// isNewerThan returns true if this character was inserted after the given one, by priority,
+// then block and then index.
+func (char rgaTextChar) isNewerThan(other rgaTextChar) bool {
+	if char.Priority != other.Priority {
+		return char.Priority > other.Priority
+	}
+	if char.ID.Block != other.ID.Block {
+		return char.ID.Block > other.ID.Block
+	}
+	return char.ID.Index > other.ID.Index
+}
+
+// decodeTextValue returns the given CBOR encoded string.
+//
+// A nil value, as held by deleted fields, is an empty string.
+func decodeTextValue(buf []byte) (string, error) {
+	if len(buf) == 0 {
+		return "", nil
+	}
+
+	var value any
+	err := cbor.Unmarshal(buf, &value)
+	if err != nil {
+		return "", err
+	}
+	switch text := value.(type) {
+	case nil:
+		return "", nil
+	case string:
+		return text, nil
+	default:
+		return "", NewErrInvalidTextValue(value)
+	}
+}
@@ -0,0 +1,226 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package crdt
+
+import (
+	"context"
+	"testing"
+
+	"github.com/fxamacker/cbor/v2"
+	ds "github.com/ipfs/go-datastore"
+	"github.com/ipfs/go-datastore/query"
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcenetwork/defradb/core"
+	"github.com/sourcenetwork/defradb/datastore"
+)
+
+func setupRGAText() RGAText {
+	store := newMockStore()
+	key := core.DataStoreKey{DocKey: "AAAA-BBBB"}
+	return NewRGAText(store, core.CollectionSchemaVersionKey{}, key)
+}
+
+func requireTextValue(ctx context.Context, t *testing.T, text RGAText, expected string) {
+	buf, err := text.Value(ctx)
+	require.NoError(t, err)
+	var value string
+	require.NoError(t, cbor.Unmarshal(buf, &value))
+	require.Equal(t, expected, value)
+}
+
+func mustEditText(ctx context.Context, t *testing.T, text RGAText, id string, edits ...TextEdit) {
+	delta, err := text.Edit(ctx, edits)
+	require.NoError(t, err)
+	require.NoError(t, text.Merge(ctx, delta, id))
+}
+
+func TestRGATextEditInsertsAndDeletes(t *testing.T) {
+	ctx := context.Background()
+	text := setupRGAText()
+
+	mustEditText(ctx, t, text, "first", TextEdit{Position: 0, Insert: "Hello world"})
+	mustEditText(
+		ctx,
+		t,
+		text,
+		"second",
+		TextEdit{Position: 5, Delete: 6, Insert: ","},
+		TextEdit{Position: 6, Insert: " there"},
+		TextEdit{Position: 0, Insert: "¡"},
+	)
+
+	requireTextValue(ctx, t, text, "¡Hello, there")
+}
+
+func TestRGATextMergeOfConcurrentInsertionsDoesNotInterleave(t *testing.T) {
+	ctx := context.Background()
+	text := setupRGAText()
+	other := NewRGAText(newMockStore(), core.CollectionSchemaVersionKey{}, text.key)
+
+	base, err := text.Edit(ctx, []TextEdit{{Position: 0, Insert: "ac"}})
+	require.NoError(t, err)
+	require.NoError(t, text.Merge(ctx, base, "base"))
+	require.NoError(t, other.Merge(ctx, base, "base"))
+
+	first, err := text.Edit(ctx, []TextEdit{{Position: 1, Insert: "bb"}})
+	require.NoError(t, err)
+	first.SetPriority(2)
+	second, err := text.Edit(ctx, []TextEdit{{Position: 1, Insert: "BB"}, {Position: 3, Delete: 1}})
+	require.NoError(t, err)
+	second.SetPriority(2)
+
+	require.NoError(t, text.Merge(ctx, first, "first"))
+	require.NoError(t, text.Merge(ctx, second, "second"))
+	require.NoError(t, other.Merge(ctx, second, "second"))
+	require.NoError(t, other.Merge(ctx, first, "first"))
+
+	requireTextValue(ctx, t, text, "aBBbb")
+	requireTextValue(ctx, t, other, "aBBbb")
+}
+
+func TestRGATextMergeBeforeInsertedAfterCharacterIsMerged(t *testing.T) {
+	ctx := context.Background()
+	source := setupRGAText()
+
+	base, err := source.Edit(ctx, []TextEdit{{Position: 0, Insert: "ab"}})
+	require.NoError(t, err)
+	require.NoError(t, source.Merge(ctx, base, "base"))
+	edit, err := source.Edit(ctx, []TextEdit{{Position: 1, Insert: "x"}, {Position: 0, Delete: 1}})
+	require.NoError(t, err)
+
+	target := setupRGAText()
+	require.NoError(t, target.Merge(ctx, edit, "edit"))
+	requireTextValue(ctx, t, target, "")
+	require.NoError(t, target.Merge(ctx, base, "base"))
+
+	requireTextValue(ctx, t, target, "xb")
+}
+
+// putRecordingStore records the keys put into the store it wraps.
+type putRecordingStore struct {
+	datastore.DSReaderWriter
+	keys []string
+}
+
+func (s *putRecordingStore) Put(ctx context.Context, key ds.Key, value []byte) error {
+	s.keys = append(s.keys, key.String())
+	return s.DSReaderWriter.Put(ctx, key, value)
+}
+
+func TestRGATextMergeOnlyStoresChangedCharacters(t *testing.T) {
+	ctx := context.Background()
+	store := &putRecordingStore{DSReaderWriter: newMockStore()}
+	text := NewRGAText(store, core.CollectionSchemaVersionKey{}, core.DataStoreKey{DocKey: "AAAA-BBBB"})
+	mustEditText(ctx, t, text, "first", TextEdit{Position: 0, Insert: "hello"})
+
+	store.keys = nil
+	mustEditText(ctx, t, text, "second", TextEdit{Position: 4, Delete: 1}, TextEdit{Position: 2, Insert: "y"})
+
+	requireTextValue(ctx, t, text, "heyll")
+	require.ElementsMatch(
+		t,
+		[]string{
+			"/s/AAAA-BBBB",
+			"/s/AAAA-BBBB/first/1",
+			"/s/AAAA-BBBB/first/4",
+			"/s/AAAA-BBBB/second/0",
+			"/v/AAAA-BBBB",
+		},
+		store.keys,
+	)
+}
+
+func TestRGATextSetReplacesByDifference(t *testing.T) {
+	ctx := context.Background()
+	text := setupRGAText()
+	mustEditText(ctx, t, text, "first", TextEdit{Position: 0, Insert: "the cat sat"})
+
+	delta, err := text.Set(ctx, mustEncodeSetValue(t, "the dog sat"))
+	require.NoError(t, err)
+	var op TextOperation
+	require.NoError(t, cbor.Unmarshal(delta.Data, &op))
+	require.Equal(t, []TextID{{Block: "first", Index: 4}, {Block: "first", Index: 5}, {Block: "first", Index: 6}}, op.Deletes)
+	require.Equal(t, []TextInsert{{After: &TextID{Block: "first", Index: 3}, Text: "dog"}}, op.Inserts)
+
+	require.NoError(t, text.Merge(ctx, delta, "set"))
+	requireTextValue(ctx, t, text, "the dog sat")
+}
+
+func TestRGATextEditWithOutOfRangePositionReturnsError(t *testing.T) {
+	ctx := context.Background()
+	text := setupRGAText()
+	mustEditText(ctx, t, text, "first", TextEdit{Position: 0, Insert: "abc"})
+
+	_, err := text.Edit(ctx, []TextEdit{{Position: 2, Delete: 2}})
+	require.ErrorIs(t, err, ErrInvalidTextPosition)
+
+	_, err = text.Edit(ctx, []TextEdit{{Position: 4, Insert: "d"}})
+	require.ErrorIs(t, err, ErrInvalidTextPosition)
+}
+
+func TestRGATextSetWithNonStringReturnsError(t *testing.T) {
+	ctx := context.Background()
+	text := setupRGAText()
+
+	_, err := text.Set(ctx, mustEncodeSetValue(t, 1))
+	require.ErrorIs(t, err, ErrInvalidTextValue)
+}
+
+func TestRGATextDeltaDecode(t *testing.T) {
+	ctx := context.Background()
+	text := setupRGAText()
+
+	delta, err := text.Edit(ctx, []TextEdit{{Position: 0, Insert: "a"}})
+	require.NoError(t, err)
+	delta.SetPriority(2)
+
+	node, err := makeNode(delta, nil)
+	require.NoError(t, err)
+
+	decoded, err := text.DeltaDecode(node)
+	require.NoError(t, err)
+	require.Equal(t, delta, decoded)
+}
+
+// readRecordingStore records the keys read from the store it wraps.
+type readRecordingStore struct {
+	datastore.DSReaderWriter
+	keys []string
+}
+
+func (s *readRecordingStore) Get(ctx context.Context, key ds.Key) ([]byte, error) {
+	s.keys = append(s.keys, key.String())
+	return s.DSReaderWriter.Get(ctx, key)
+}
+
+func (s *readRecordingStore) Query(ctx context.Context, q query.Query) (query.Results, error) {
+	s.keys = append(s.keys, q.Prefix)
+	return s.DSReaderWriter.Query(ctx, q)
+}
+
+func TestRGATextMergeOnlyReadsCharactersNextToTheEdit(t *testing.T) {
+	ctx := context.Background()
+	store := &readRecordingStore{DSReaderWriter: newMockStore()}
+	text := NewRGAText(store, core.CollectionSchemaVersionKey{}, core.DataStoreKey{DocKey: "AAAA-BBBB"})
+	mustEditText(ctx, t, text, "first", TextEdit{Position: 0, Insert: "hello world"})
+	mustEditText(ctx, t, text, "second", TextEdit{Position: 0, Delete: 6})
+
+	edit, err := text.Edit(ctx, []TextEdit{{Position: 5, Insert: "!"}})
+	require.NoError(t, err)
+	store.keys = nil
+	require.NoError(t, text.Merge(ctx, edit, "third"))
+
+	requireTextValue(ctx, t, text, "world!")
+	require.NotContains(t, store.keys, "/s/AAAA-BBBB/first/0")
+	require.NotContains(t, store.keys, "/s/AAAA-BBBB/first/5")
+	require.Contains(t, store.keys, "/s/AAAA-BBBB/first/10")
+}
@@ -10,7 +10,11 @@
 
 package core
 
-import "strings"
+import (
+	"strings"
+
+	"github.com/sourcenetwork/immutable"
+)
 
 // Span is a range of keys from [Start, End).
 type Span interface {
@@ -156,6 +160,21 @@ func NewSpans(spans ...Span) Spans {
 	}
 }
 
+// IndexSpan is a range of entries within a secondary index.
+//
+// It covers the entries whose leading field values are equal to Prefix, and whose next
+// field value (if bounded) lies within the inclusive range [Lower, Upper].
+type IndexSpan struct {
+	// Prefix contains the encoded values of the leading indexed fields.
+	Prefix [][]byte
+
+	// Lower is the inclusive lower bound of the encoded value of the field following Prefix.
+	Lower immutable.Option[[]byte]
+
+	// Upper is the inclusive upper bound of the encoded value of the field following Prefix.
+	Upper immutable.Option[[]byte]
+}
+
 // KeyValue is a KV store response containing the resulting core.Key and byte array value.
 type KeyValue struct {
 	Key   DataStoreKey
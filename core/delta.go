@@ -29,6 +29,20 @@ type CompositeDelta interface {
 	Links() []DAGLink
 }
 
+// TimestampedDelta is a delta that may carry the hybrid logical clock time it was created at.
+//
+// The timestamp is zero if the delta was not timestamped.
+type TimestampedDelta interface {
+	Delta
+	GetTimestamp() HLCTimestamp
+}
+
+// MetadataDelta is a delta that may carry the metadata of the commit it is part of.
+type MetadataDelta interface {
+	Delta
+	SetMetadata(*CommitMetadata)
+}
+
 type NetDelta interface {
 	Delta
 	GetSchemaID() string
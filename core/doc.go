@@ -14,6 +14,8 @@ Package core provides commonly shared interfaces and building blocks.
 package core
 
 import (
+	"math/big"
+
 	"github.com/sourcenetwork/immutable"
 
 	"github.com/sourcenetwork/defradb/client"
@@ -122,6 +124,13 @@ type DocumentMapping struct {
 	// Indexes correspond exactly to field indexes, however entries may be default
 	// if the field is unmappable (e.g. integer fields).
 	ChildMappings []*DocumentMapping
+
+	// The render mappings of the embedded objects of this object, keyed by the
+	// index of the embedded object field.
+	//
+	// Embedded objects are stored inline, so their render keys index the fields of
+	// this object rather than those of a child document.
+	EmbeddedMappings map[int]*DocumentMapping
 }
 
 // NewDocumentMapping instantiates a new DocumentMapping instance.
@@ -215,9 +224,19 @@ func (mapping *DocumentMapping) ToMap(doc Doc) map[string]any {
 		case Doc:
 			innerMapping := mapping.ChildMappings[renderKey.Index]
 			renderValue = innerMapping.ToMap(innerV)
+		case *big.Rat:
+			// Decimals are rendered as strings so that no precision is lost.
+			renderValue = client.FormatDecimal(innerV)
+		case *big.Int:
+			renderValue = innerV.String()
 		default:
 			if mapping.typeInfo.HasValue() && renderKey.Index == mapping.typeInfo.Value().Index {
 				renderValue = mapping.typeInfo.Value().Name
+			} else if embeddedMapping, isEmbedded := mapping.EmbeddedMappings[renderKey.Index]; isEmbedded {
+				// The embedded object field holds a marker that is only set if the object is.
+				if innerV != nil {
+					renderValue = embeddedMapping.ToMap(doc)
+				}
 			} else {
 				renderValue = innerV
 			}
@@ -265,6 +284,14 @@ func (m *DocumentMapping) SetChildAt(index int, childMapping *DocumentMapping) {
 	m.ChildMappings = newMappings
 }
 
+// SetEmbeddedAt sets the given embedded object render mapping at the given index.
+func (m *DocumentMapping) SetEmbeddedAt(index int, embeddedMapping *DocumentMapping) {
+	if m.EmbeddedMappings == nil {
+		m.EmbeddedMappings = map[int]*DocumentMapping{}
+	}
+	m.EmbeddedMappings[index] = embeddedMapping
+}
+
 // TryToFindNameFromIndex returns the corresponding name of the given index.
 //
 // Additionally, will also return true if the index was found, and false otherwise.
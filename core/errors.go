@@ -11,15 +11,19 @@
 package core
 
 import (
+	"time"
+
 	"github.com/sourcenetwork/defradb/errors"
 )
 
 const (
 	errFailedToGetFieldIdOfKey string = "failed to get FieldID of Key"
+	errHLCMaxOffsetExceeded    string = "timestamp is ahead of the physical time by more than the maximum offset"
 )
 
 var (
 	ErrFailedToGetFieldIdOfKey = errors.New(errFailedToGetFieldIdOfKey)
+	ErrHLCMaxOffsetExceeded    = errors.New(errHLCMaxOffsetExceeded)
 	ErrEmptyKey                = errors.New("received empty key string")
 	ErrInvalidKey              = errors.New("invalid key string")
 )
@@ -28,3 +32,14 @@ var (
 func NewErrFailedToGetFieldIdOfKey(inner error) error {
 	return errors.Wrap(errFailedToGetFieldIdOfKey, inner)
 }
+
+// NewErrHLCMaxOffsetExceeded returns an error indicating that the given remote timestamp is
+// ahead of the given physical time by more than the given maximum offset.
+func NewErrHLCMaxOffsetExceeded(timestamp HLCTimestamp, wall time.Time, maxOffset time.Duration) error {
+	return errors.New(
+		errHLCMaxOffsetExceeded,
+		errors.NewKV("Timestamp", timestamp.Time()),
+		errors.NewKV("PhysicalTime", wall.UTC()),
+		errors.NewKV("MaxOffset", maxOffset),
+	)
+}
@@ -0,0 +1,115 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package core
+
+import (
+	"sync"
+	"time"
+)
+
+// hlcLogicalBits is the number of low bits of an HLCTimestamp holding its logical counter.
+const hlcLogicalBits = 16
+
+// DefaultHLCMaxOffset is the default maximum offset from the physical time of the remote
+// timestamps observed by a hybrid logical clock.
+const DefaultHLCMaxOffset = 5 * time.Minute
+
+// HLCTimestamp is a hybrid logical clock timestamp.
+//
+// It holds the physical time of the timestamp, in milliseconds since the Unix epoch, in its
+// upper 48 bits, and a logical counter ordering timestamps of the same physical time in its
+// lower 16 bits, such that timestamps are ordered as integers.  The zero timestamp is not a
+// valid time, and is held by deltas that were not timestamped.
+type HLCTimestamp uint64
+
+// NewHLCTimestamp returns the timestamp of the given physical time and logical counter.
+func NewHLCTimestamp(wall time.Time, logical uint16) HLCTimestamp {
+	return HLCTimestamp(uint64(wall.UnixMilli())<<hlcLogicalBits | uint64(logical))
+}
+
+// Time returns the physical time of the timestamp.
+func (t HLCTimestamp) Time() time.Time {
+	return time.UnixMilli(int64(t >> hlcLogicalBits)).UTC()
+}
+
+// Logical returns the logical counter of the timestamp.
+func (t HLCTimestamp) Logical() uint16 {
+	return uint16(t)
+}
+
+// HLC is a hybrid logical clock, issuing timestamps that follow the physical time, but that are
+// always greater than every timestamp it issued or observed before, so that they respect the
+// causal order of events regardless of clock skew between nodes.
+type HLC struct {
+	mu        sync.Mutex
+	now       func() time.Time
+	maxOffset time.Duration
+	last      HLCTimestamp
+}
+
+// NewHLC returns a new hybrid logical clock reading the physical time from the given function,
+// and rejecting remote timestamps ahead of it by more than the given offset.
+//
+// A non-positive offset places no bound on the remote timestamps observed.
+func NewHLC(now func() time.Time, maxOffset time.Duration) *HLC {
+	return &HLC{now: now, maxOffset: maxOffset}
+}
+
+// SetMaxOffset sets the maximum offset from the physical time of the remote timestamps
+// observed by the clock.
+//
+// A non-positive offset places no bound on the remote timestamps observed.
+func (c *HLC) SetMaxOffset(maxOffset time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.maxOffset = maxOffset
+}
+
+// Now returns the timestamp of a new local event.
+//
+// It is the current physical time if that is ahead of the last timestamp, or otherwise the
+// last timestamp with its logical counter incremented.
+func (c *HLC) Now() HLCTimestamp {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	wall := NewHLCTimestamp(c.now(), 0)
+	if wall > c.last {
+		c.last = wall
+	} else {
+		// An overflowing logical counter carries into the physical time.
+		c.last++
+	}
+	return c.last
+}
+
+// Observe updates the clock with the given timestamp of a remote event, such that the
+// timestamps of subsequent local events are greater than it.
+//
+// A timestamp ahead of the physical time by more than the maximum offset of the clock is
+// rejected, leaving the clock unchanged, so that a remote clock running far ahead cannot drag
+// this one along with it.
+func (c *HLC) Observe(remote HLCTimestamp) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.maxOffset > 0 {
+		wall := c.now()
+		if remote.Time().Sub(wall) > c.maxOffset {
+			return NewErrHLCMaxOffsetExceeded(remote, wall, c.maxOffset)
+		}
+	}
+	if remote > c.last {
+		c.last = remote
+	}
+	return nil
+}
@@ -0,0 +1,95 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package core
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHLCNowFollowsPhysicalTime(t *testing.T) {
+	now := time.UnixMilli(1000)
+	clock := NewHLC(func() time.Time { return now }, DefaultHLCMaxOffset)
+
+	first := clock.Now()
+	assert.Equal(t, now.UTC(), first.Time())
+	assert.Equal(t, uint16(0), first.Logical())
+
+	now = now.Add(time.Millisecond)
+	second := clock.Now()
+	assert.Equal(t, now.UTC(), second.Time())
+	assert.Equal(t, uint16(0), second.Logical())
+}
+
+func TestHLCNowIncrementsLogicalCounterWithinSamePhysicalTime(t *testing.T) {
+	now := time.UnixMilli(1000)
+	clock := NewHLC(func() time.Time { return now }, DefaultHLCMaxOffset)
+
+	first := clock.Now()
+	second := clock.Now()
+	assert.Greater(t, second, first)
+	assert.Equal(t, first.Time(), second.Time())
+	assert.Equal(t, uint16(1), second.Logical())
+}
+
+func TestHLCNowAfterObservingRemoteTimestampAhead(t *testing.T) {
+	clock := NewHLC(func() time.Time { return time.UnixMilli(1000) }, DefaultHLCMaxOffset)
+
+	remote := NewHLCTimestamp(time.UnixMilli(5000), 3)
+	require.NoError(t, clock.Observe(remote))
+
+	local := clock.Now()
+	assert.Greater(t, local, remote)
+	assert.Equal(t, remote.Time(), local.Time())
+	assert.Equal(t, uint16(4), local.Logical())
+}
+
+func TestHLCObserveOfRemoteTimestampBehindIsIgnored(t *testing.T) {
+	clock := NewHLC(func() time.Time { return time.UnixMilli(5000) }, DefaultHLCMaxOffset)
+	first := clock.Now()
+
+	require.NoError(t, clock.Observe(NewHLCTimestamp(time.UnixMilli(1000), 0)))
+
+	second := clock.Now()
+	assert.Equal(t, first+1, second)
+}
+
+func TestHLCObserveOfRemoteTimestampBeyondMaxOffsetIsRejected(t *testing.T) {
+	clock := NewHLC(func() time.Time { return time.UnixMilli(1000) }, time.Second)
+	first := clock.Now()
+
+	err := clock.Observe(NewHLCTimestamp(time.UnixMilli(2001), 0))
+	require.ErrorIs(t, err, ErrHLCMaxOffsetExceeded)
+
+	second := clock.Now()
+	assert.Equal(t, first+1, second)
+}
+
+func TestHLCObserveOfRemoteTimestampWithinMaxOffset(t *testing.T) {
+	clock := NewHLC(func() time.Time { return time.UnixMilli(1000) }, time.Second)
+
+	remote := NewHLCTimestamp(time.UnixMilli(2000), 0)
+	require.NoError(t, clock.Observe(remote))
+
+	assert.Equal(t, remote+1, clock.Now())
+}
+
+func TestHLCObserveWithoutMaxOffset(t *testing.T) {
+	clock := NewHLC(func() time.Time { return time.UnixMilli(1000) }, 0)
+
+	remote := NewHLCTimestamp(time.UnixMilli(1000).Add(time.Hour), 0)
+	require.NoError(t, clock.Observe(remote))
+
+	assert.Equal(t, remote+1, clock.Now())
+}
@@ -11,6 +11,7 @@
 package core
 
 import (
+	"encoding/hex"
 	"strconv"
 	"strings"
 
@@ -38,6 +39,14 @@ const (
 	PriorityKey = InstanceType("p")
 	// DeletedKey is a type that represents a deleted document.
 	DeletedKey = InstanceType("d")
+	// IndexKey is a type that represents a secondary index entry.
+	IndexKey = InstanceType("i")
+	// StateKey is a type that represents the internal state of a CRDT, held alongside
+	// its value.
+	StateKey = InstanceType("s")
+	// UniqueConflictInstance is a type that represents a conflict between the entries of
+	// a unique index.
+	UniqueConflictInstance = InstanceType("c")
 )
 
 const (
@@ -74,6 +83,46 @@ type PrimaryDataStoreKey struct {
 
 var _ Key = (*PrimaryDataStoreKey)(nil)
 
+// IndexDataStoreKey is the key of a secondary index entry.
+//
+// It is stored in the data store alongside the documents of the collection, and has
+// the following format:
+//
+// /[CollectionID]/i/[IndexID]/[FieldValue]/.../[DocKey]
+//
+// Field values are expected to be order-preserving encodings of the indexed values,
+// they are hex encoded within the key so that the lexicographic ordering of the keys
+// matches the ordering of the encoded values.
+//
+// The entries of unique indexes may omit the DocKey, in which case it is held in the
+// value of the entry.
+type IndexDataStoreKey struct {
+	CollectionID string
+	IndexID      string
+	FieldValues  [][]byte
+	DocKey       string
+}
+
+var _ Key = (*IndexDataStoreKey)(nil)
+
+// UniqueConflictKey is the key of a conflict between two documents holding the same values for
+// the fields of a unique index.
+//
+// It is stored in the data store alongside the documents of the collection, and has the
+// following format:
+//
+// /[CollectionID]/c/[IndexID]/[DocKey]/[ConflictingDocKey]
+//
+// Each conflict is stored under the keys of both of its documents.
+type UniqueConflictKey struct {
+	CollectionID      string
+	IndexID           string
+	DocKey            string
+	ConflictingDocKey string
+}
+
+var _ Key = (*UniqueConflictKey)(nil)
+
 type HeadStoreKey struct {
 	DocKey  string
 	FieldId string //can be 'C'
@@ -170,6 +219,62 @@ func DataStoreKeyFromDocKey(dockey client.DocKey) DataStoreKey {
 	}
 }
 
+// NewIndexDataStoreKey creates a new IndexDataStoreKey from a string, splitting the
+// input using '/' as a field deliminator.  It assumes that the input string is
+// in the following format:
+//
+// /[CollectionID]/i/[IndexID]/[FieldValue]/.../[DocKey]
+//
+// The number of field values must be provided, as it cannot be inferred from the key.
+// The DocKey is optional, and will be empty if it is not present.
+func NewIndexDataStoreKey(key string, fieldCount int) (IndexDataStoreKey, error) {
+	elements := strings.Split(strings.TrimPrefix(key, "/"), "/")
+	hasDocKey := len(elements) == fieldCount+4
+	if (!hasDocKey && len(elements) != fieldCount+3) || InstanceType(elements[1]) != IndexKey {
+		return IndexDataStoreKey{}, ErrInvalidKey
+	}
+
+	fieldValues := make([][]byte, fieldCount)
+	for i := 0; i < fieldCount; i++ {
+		value, err := hex.DecodeString(elements[i+3])
+		if err != nil {
+			return IndexDataStoreKey{}, ErrInvalidKey
+		}
+		fieldValues[i] = value
+	}
+
+	var docKey string
+	if hasDocKey {
+		docKey = elements[len(elements)-1]
+	}
+
+	return IndexDataStoreKey{
+		CollectionID: elements[0],
+		IndexID:      elements[2],
+		FieldValues:  fieldValues,
+		DocKey:       docKey,
+	}, nil
+}
+
+// NewUniqueConflictKey creates a new UniqueConflictKey from a string, splitting the
+// input using '/' as a field deliminator.  It assumes that the input string is
+// in the following format:
+//
+// /[CollectionID]/c/[IndexID]/[DocKey]/[ConflictingDocKey]
+func NewUniqueConflictKey(key string) (UniqueConflictKey, error) {
+	elements := strings.Split(strings.TrimPrefix(key, "/"), "/")
+	if len(elements) != 5 || InstanceType(elements[1]) != UniqueConflictInstance {
+		return UniqueConflictKey{}, ErrInvalidKey
+	}
+
+	return UniqueConflictKey{
+		CollectionID:      elements[0],
+		IndexID:           elements[2],
+		DocKey:            elements[3],
+		ConflictingDocKey: elements[4],
+	}, nil
+}
+
 // Creates a new HeadStoreKey from a string as best as it can,
 // splitting the input using '/' as a field deliminator.  It assumes
 // that the input string is in the following format:
@@ -226,6 +331,12 @@ func (k DataStoreKey) WithPriorityFlag() DataStoreKey {
 	return newKey
 }
 
+func (k DataStoreKey) WithStateFlag() DataStoreKey {
+	newKey := k
+	newKey.InstanceType = StateKey
+	return newKey
+}
+
 func (k DataStoreKey) WithDeletedFlag() DataStoreKey {
 	newKey := k
 	newKey.InstanceType = DeletedKey
@@ -488,6 +599,60 @@ func (k HeadStoreKey) ToString() string {
 	return result
 }
 
+func (k IndexDataStoreKey) ToString() string {
+	var result string
+
+	if k.CollectionID != "" {
+		result = result + "/" + k.CollectionID + "/" + string(IndexKey)
+	}
+	if k.IndexID != "" {
+		result = result + "/" + k.IndexID
+	}
+	for _, value := range k.FieldValues {
+		result = result + "/" + hex.EncodeToString(value)
+	}
+	if k.DocKey != "" {
+		result = result + "/" + k.DocKey
+	}
+
+	return result
+}
+
+func (k IndexDataStoreKey) Bytes() []byte {
+	return []byte(k.ToString())
+}
+
+func (k IndexDataStoreKey) ToDS() ds.Key {
+	return ds.NewKey(k.ToString())
+}
+
+func (k UniqueConflictKey) ToString() string {
+	var result string
+
+	if k.CollectionID != "" {
+		result = result + "/" + k.CollectionID + "/" + string(UniqueConflictInstance)
+	}
+	if k.IndexID != "" {
+		result = result + "/" + k.IndexID
+	}
+	if k.DocKey != "" {
+		result = result + "/" + k.DocKey
+	}
+	if k.ConflictingDocKey != "" {
+		result = result + "/" + k.ConflictingDocKey
+	}
+
+	return result
+}
+
+func (k UniqueConflictKey) Bytes() []byte {
+	return []byte(k.ToString())
+}
+
+func (k UniqueConflictKey) ToDS() ds.Key {
+	return ds.NewKey(k.ToString())
+}
+
 func (k HeadStoreKey) Bytes() []byte {
 	return []byte(k.ToString())
 }
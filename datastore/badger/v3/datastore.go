@@ -653,8 +653,14 @@ func (t *txn) query(q dsq.Query) (dsq.Results, error) {
 
 		defer it.Close()
 
-		// All iterators must be started by rewinding.
-		it.Rewind()
+		// All iterators must be started by rewinding. Rewinding a reversed iterator over a
+		// prefix seeks to the prefix itself, which sorts before all of its keys, so it must
+		// instead seek past the end of the prefix.
+		if opt.Reverse && len(opt.Prefix) > 0 {
+			it.Seek(append(append([]byte{}, opt.Prefix...), 0xff))
+		} else {
+			it.Rewind()
+		}
 
 		// skip to the offset
 		for skipped := 0; skipped < q.Offset && it.Valid(); it.Next() {
@@ -0,0 +1,99 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package datastore
+
+import (
+	"context"
+	"io"
+
+	blockstore "github.com/ipfs/boxo/blockstore"
+	dag "github.com/ipfs/boxo/ipld/merkledag"
+	"github.com/ipfs/go-cid"
+	ipld "github.com/ipfs/go-ipld-format"
+	mh "github.com/multiformats/go-multihash"
+)
+
+// BlobChunkSize is the maximum number of bytes of a blob held by a single block.
+const BlobChunkSize = 256 * 1024
+
+// PutBlob stores the given content as a blob in the given store, returning the CID of its
+// root block.
+//
+// The content is split into raw blocks of at most [BlobChunkSize] bytes, linked in order
+// from the root block.
+func PutBlob(ctx context.Context, store blockstore.Blockstore, content []byte) (cid.Cid, error) {
+	root := dag.NodeWithData(nil)
+	err := root.SetCidBuilder(cid.V1Builder{
+		Codec:    cid.DagProtobuf,
+		MhType:   mh.SHA2_256,
+		MhLength: -1,
+	})
+	if err != nil {
+		return cid.Undef, err
+	}
+
+	for start := 0; start < len(content); start += BlobChunkSize {
+		end := start + BlobChunkSize
+		if end > len(content) {
+			end = len(content)
+		}
+
+		chunk := dag.NewRawNode(content[start:end])
+		if err := store.Put(ctx, chunk); err != nil {
+			return cid.Undef, err
+		}
+		err = root.AddRawLink("", &ipld.Link{
+			Cid:  chunk.Cid(),
+			Size: uint64(end - start),
+		})
+		if err != nil {
+			return cid.Undef, err
+		}
+	}
+
+	if err := store.Put(ctx, root); err != nil {
+		return cid.Undef, err
+	}
+	return root.Cid(), nil
+}
+
+// GetBlobRoot returns the root block of the blob with the given CID from the given store.
+func GetBlobRoot(ctx context.Context, store blockstore.Blockstore, c cid.Cid) (*dag.ProtoNode, error) {
+	block, err := store.Get(ctx, c)
+	if err != nil {
+		return nil, err
+	}
+	return dag.DecodeProtobuf(block.RawData())
+}
+
+// BlobSize returns the number of bytes of content of the blob with the given root block.
+func BlobSize(root *dag.ProtoNode) uint64 {
+	var size uint64
+	for _, link := range root.Links() {
+		size += link.Size
+	}
+	return size
+}
+
+// WriteBlob writes the content of the blob with the given root block to the given writer,
+// reading its chunks from the given store one at a time.
+func WriteBlob(ctx context.Context, store blockstore.Blockstore, root *dag.ProtoNode, w io.Writer) error {
+	for _, link := range root.Links() {
+		chunk, err := store.Get(ctx, link.Cid)
+		if err != nil {
+			return err
+		}
+		if _, err := w.Write(chunk.RawData()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,56 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package datastore
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/sourcenetwork/defradb/datastore/memory"
+)
+
+func TestPutBlobAndWriteBlob(t *testing.T) {
+	ctx := context.Background()
+	rootstore := memory.NewDatastore(ctx)
+	bs := NewBlockstore(AsDSReaderWriter(rootstore))
+
+	content := bytes.Repeat([]byte("Source Inc"), BlobChunkSize/4)
+
+	blobCID, err := PutBlob(ctx, bs, content)
+	require.NoError(t, err)
+
+	root, err := GetBlobRoot(ctx, bs, blobCID)
+	require.NoError(t, err)
+	require.Len(t, root.Links(), 3)
+	require.Equal(t, uint64(len(content)), BlobSize(root))
+
+	var buf bytes.Buffer
+	err = WriteBlob(ctx, bs, root, &buf)
+	require.NoError(t, err)
+	require.Equal(t, content, buf.Bytes())
+}
+
+func TestPutBlobWithEmptyContent(t *testing.T) {
+	ctx := context.Background()
+	rootstore := memory.NewDatastore(ctx)
+	bs := NewBlockstore(AsDSReaderWriter(rootstore))
+
+	blobCID, err := PutBlob(ctx, bs, nil)
+	require.NoError(t, err)
+
+	root, err := GetBlobRoot(ctx, bs, blobCID)
+	require.NoError(t, err)
+	require.Len(t, root.Links(), 0)
+	require.Equal(t, uint64(0), BlobSize(root))
+}
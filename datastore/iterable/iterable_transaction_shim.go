@@ -12,6 +12,7 @@ package iterable
 
 import (
 	"context"
+	"strings"
 
 	ds "github.com/ipfs/go-datastore"
 	dsq "github.com/ipfs/go-datastore/query"
@@ -76,10 +77,18 @@ func (shim *iteratorShim) IteratePrefix(
 			}
 			lastSharedIndex += 1
 		}
-		query.Prefix = string(startBytes[:lastSharedIndex])
+		// The prefix of a query matches whole key segments only, so the shared bytes are
+		// trimmed back to the last complete segment.
+		sharedPrefix := string(startBytes[:lastSharedIndex])
+		query.Prefix = sharedPrefix[:strings.LastIndex(sharedPrefix, "/")+1]
+		start, end := startPrefix.String(), endPrefix.String()
+		if start > end {
+			// Descending iterations start from the upper bound of the range.
+			start, end = end, start
+		}
 		query.Filters = append(query.Filters, betweenFilter{
-			start: startPrefix.String(),
-			end:   endPrefix.String(),
+			start: start,
+			end:   end,
 		})
 		results, err := shim.readable.Query(ctx, query)
 		if err != nil {
@@ -120,3 +120,75 @@ func TestIteratePrefixWithStoreClosed(t *testing.T) {
 	_, err = iter.IteratePrefix(ctx, ds.NewKey("key1"), ds.NewKey("key1"))
 	require.ErrorIs(t, err, badgerds.ErrClosed)
 }
+
+func TestIteratePrefixWithRangeWithinKeySegment(t *testing.T) {
+	ctx := context.Background()
+	rootstore := memory.NewDatastore(ctx)
+
+	dsRW := AsDSReaderWriter(rootstore)
+	dsRW = prefix(dsRW, prefixKey)
+
+	for _, key := range []string{"/a/aa/1", "/a/ab/1", "/a/ab/2", "/a/ac/1", "/a/ad/1"} {
+		err := dsRW.Put(ctx, ds.NewKey(key), []byte{})
+		require.NoError(t, err)
+	}
+
+	iter, err := dsRW.GetIterator(query.Query{})
+	require.NoError(t, err)
+
+	results, err := iter.IteratePrefix(ctx, ds.NewKey("/a/ab"), ds.NewKey("/a/ac/2"))
+	require.NoError(t, err)
+
+	keys := []string{}
+	for res, hasNext := results.NextSync(); hasNext; res, hasNext = results.NextSync() {
+		require.NoError(t, res.Error)
+		keys = append(keys, res.Key)
+	}
+	require.Equal(t, []string{"/a/ab/1", "/a/ab/2", "/a/ac/1"}, keys)
+
+	err = iter.Close()
+	require.NoError(t, err)
+}
+
+func TestIteratePrefixWithDescendingRange(t *testing.T) {
+	ctx := context.Background()
+	opts := badgerds.Options{Options: badger.DefaultOptions("").WithInMemory(true)}
+	badgerstore, err := badgerds.NewDatastore("", &opts)
+	require.NoError(t, err)
+
+	badgertxn, err := badgerstore.NewIterableTransaction(ctx, false)
+	require.NoError(t, err)
+
+	for _, rootstore := range []DSReaderWriter{
+		AsDSReaderWriter(memory.NewDatastore(ctx)),
+		AsDSReaderWriter(badgerstore),
+		badgertxn,
+	} {
+		dsRW := prefix(rootstore, prefixKey)
+
+		for _, key := range []string{"/a/aa/1", "/a/ab/1", "/a/ab/2", "/a/ac/1", "/a/ad/1"} {
+			err := dsRW.Put(ctx, ds.NewKey(key), []byte{})
+			require.NoError(t, err)
+		}
+
+		iter, err := dsRW.GetIterator(query.Query{
+			Orders: []query.Order{query.OrderByKeyDescending{}},
+		})
+		require.NoError(t, err)
+
+		results, err := iter.IteratePrefix(ctx, ds.NewKey("/a/ac/2"), ds.NewKey("/a/ab"))
+		require.NoError(t, err)
+
+		keys := []string{}
+		for res, hasNext := results.NextSync(); hasNext; res, hasNext = results.NextSync() {
+			require.NoError(t, res.Error)
+			keys = append(keys, res.Key)
+		}
+		require.Equal(t, []string{"/a/ac/1", "/a/ab/2", "/a/ab/1"}, keys)
+
+		err = results.Close()
+		require.NoError(t, err)
+		err = iter.Close()
+		require.NoError(t, err)
+	}
+}
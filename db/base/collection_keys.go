@@ -38,10 +38,10 @@ func MakePrimaryIndexKeyForCRDT(
 	key core.DataStoreKey,
 	fieldName string,
 ) (core.DataStoreKey, error) {
-	switch ctype {
-	case client.COMPOSITE:
+	switch {
+	case ctype == client.COMPOSITE:
 		return MakeCollectionKey(c).WithInstanceInfo(key).WithFieldId(core.COMPOSITE_NAMESPACE), nil
-	case client.LWW_REGISTER:
+	case ctype != client.NONE_CRDT && ctype.IsSupportedFieldCType():
 		fieldKey := getFieldKey(c, key, fieldName)
 		return MakeCollectionKey(c).WithInstanceInfo(fieldKey), nil
 	}
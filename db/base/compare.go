@@ -12,6 +12,7 @@ package base
 
 import (
 	"bytes"
+	"math/big"
 	"strings"
 	"time"
 )
@@ -47,6 +48,10 @@ func Compare(a, b any) int {
 		return compareString(v, b.(string))
 	case []byte:
 		return compareBytes(v, b.([]byte))
+	case *big.Int:
+		return v.Cmp(b.(*big.Int))
+	case *big.Rat:
+		return v.Cmp(b.(*big.Rat))
 	default:
 		return 0
 	}
@@ -11,9 +11,19 @@
 package base
 
 import (
+	"github.com/sourcenetwork/defradb/client"
 	"github.com/sourcenetwork/defradb/errors"
 )
 
+const (
+	errUnsupportedIndexFieldKind string = "secondary indexes are not supported for the given field kind"
+)
+
 var (
-	ErrInvalidCrdtType = errors.New("invalid CRDT type")
+	ErrInvalidCrdtType           = errors.New("invalid CRDT type")
+	ErrUnsupportedIndexFieldKind = errors.New(errUnsupportedIndexFieldKind)
 )
+
+func NewErrUnsupportedIndexFieldKind(kind client.FieldKind) error {
+	return errors.New(errUnsupportedIndexFieldKind, errors.NewKV("Kind", kind))
+}
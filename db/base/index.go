@@ -0,0 +1,669 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package base
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"math"
+	"time"
+
+	"github.com/fxamacker/cbor/v2"
+	ds "github.com/ipfs/go-datastore"
+	dsq "github.com/ipfs/go-datastore/query"
+
+	"github.com/sourcenetwork/defradb/client"
+	"github.com/sourcenetwork/defradb/connor/fulltext"
+	"github.com/sourcenetwork/defradb/core"
+	"github.com/sourcenetwork/defradb/datastore"
+	"github.com/sourcenetwork/defradb/errors"
+)
+
+// The type tags prefixing each encoded index value.
+//
+// Nil values are tagged such that they sort before all other values.
+const (
+	indexNilTag    = byte(0x00)
+	indexBoolTag   = byte(0x01)
+	indexNumberTag = byte(0x02)
+	indexTimeTag   = byte(0x03)
+	indexStringTag = byte(0x04)
+)
+
+// IsIndexableKind returns true if fields of the given kind may be included in a secondary index.
+func IsIndexableKind(kind client.FieldKind) bool {
+	switch kind {
+	case client.FieldKind_DocKey,
+		client.FieldKind_BOOL,
+		client.FieldKind_INT,
+		client.FieldKind_FLOAT,
+		client.FieldKind_DATETIME,
+		client.FieldKind_STRING,
+		client.FieldKind_ENUM:
+		return true
+	default:
+		return false
+	}
+}
+
+// EncodeIndexValue encodes the given value of a field of the given kind such that the
+// byte-wise ordering of the encoded values matches the ordering of the original values.
+//
+// Values are converted to the given kind before being encoded, integers are truncated, so that
+// a value within a filter may be encoded consistently with the values stored against the field.
+func EncodeIndexValue(kind client.FieldKind, value any) ([]byte, error) {
+	if value == nil {
+		return []byte{indexNilTag}, nil
+	}
+
+	switch kind {
+	case client.FieldKind_BOOL:
+		v, ok := value.(bool)
+		if !ok {
+			return nil, client.NewErrUnexpectedType[bool]("index value", value)
+		}
+		if v {
+			return []byte{indexBoolTag, 1}, nil
+		}
+		return []byte{indexBoolTag, 0}, nil
+
+	case client.FieldKind_INT:
+		v, ok := toFloat(value)
+		if !ok {
+			return nil, client.NewErrUnexpectedType[int64]("index value", value)
+		}
+		return encodeIndexInt(toInt(value, v)), nil
+
+	case client.FieldKind_FLOAT:
+		v, ok := toFloat(value)
+		if !ok {
+			return nil, client.NewErrUnexpectedType[float64]("index value", value)
+		}
+		return encodeIndexFloat(v), nil
+
+	case client.FieldKind_DATETIME:
+		var t time.Time
+		switch v := value.(type) {
+		case time.Time:
+			t = v
+		case string:
+			var err error
+			t, err = time.Parse(time.RFC3339, v)
+			if err != nil {
+				return nil, err
+			}
+		default:
+			return nil, client.NewErrUnexpectedType[time.Time]("index value", value)
+		}
+		// Seconds and nanoseconds are encoded separately, as nanoseconds since the epoch
+		// overflow an int64 for times before 1678 or after 2262.
+		buf := make([]byte, 13)
+		buf[0] = indexTimeTag
+		binary.BigEndian.PutUint64(buf[1:], uint64(t.Unix())^(1<<63))
+		binary.BigEndian.PutUint32(buf[9:], uint32(t.Nanosecond()))
+		return buf, nil
+
+	case client.FieldKind_DocKey, client.FieldKind_STRING, client.FieldKind_ENUM:
+		v, ok := value.(string)
+		if !ok {
+			return nil, client.NewErrUnexpectedType[string]("index value", value)
+		}
+		return append([]byte{indexStringTag}, v...), nil
+
+	default:
+		return nil, NewErrUnsupportedIndexFieldKind(kind)
+	}
+}
+
+func encodeIndexInt(v int64) []byte {
+	buf := make([]byte, 9)
+	buf[0] = indexNumberTag
+	binary.BigEndian.PutUint64(buf[1:], uint64(v)^(1<<63))
+	return buf
+}
+
+func encodeIndexFloat(v float64) []byte {
+	bits := math.Float64bits(v)
+	if v < 0 || (v == 0 && math.Signbit(v)) {
+		bits = ^bits
+	} else {
+		bits ^= 1 << 63
+	}
+	buf := make([]byte, 9)
+	buf[0] = indexNumberTag
+	binary.BigEndian.PutUint64(buf[1:], bits)
+	return buf
+}
+
+func toFloat(value any) (float64, bool) {
+	switch v := value.(type) {
+	case int:
+		return float64(v), true
+	case int32:
+		return float64(v), true
+	case int64:
+		return float64(v), true
+	case uint64:
+		return float64(v), true
+	case float32:
+		return float64(v), true
+	case float64:
+		return v, true
+	default:
+		return 0, false
+	}
+}
+
+func toInt(value any, f float64) int64 {
+	switch v := value.(type) {
+	case int:
+		return int64(v)
+	case int32:
+		return int64(v)
+	case int64:
+		return v
+	case uint64:
+		return int64(v)
+	default:
+		return int64(f)
+	}
+}
+
+// GetIndexEntries returns the secondary index entries of the given document, built from
+// the values currently held in the given store.
+//
+// Full-text indexes hold an entry for each distinct term of the indexed field, and vector
+// indexes an entry for the vector of the indexed field, if any.
+//
+// No entries will be returned if the document does not exist or has been deleted.
+func GetIndexEntries(
+	ctx context.Context,
+	store datastore.DSReaderWriter,
+	col client.CollectionDescription,
+	docKey string,
+) ([]core.IndexDataStoreKey, error) {
+	if len(col.Indexes) == 0 {
+		return nil, nil
+	}
+
+	primaryKey := core.PrimaryDataStoreKey{
+		CollectionId: col.IDString(),
+		DocKey:       docKey,
+	}
+	marker, err := store.Get(ctx, primaryKey.ToDS())
+	if err != nil {
+		if errors.Is(err, ds.ErrNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	if bytes.Equal(marker, []byte{DeletedObjectMarker}) {
+		return nil, nil
+	}
+
+	valueKey := MakeDocKey(col, docKey).WithValueFlag()
+	entries := make([]core.IndexDataStoreKey, 0, len(col.Indexes))
+	for _, index := range col.Indexes {
+		if index.FullText {
+			fullTextEntries, err := getFullTextIndexEntries(ctx, store, col, index, valueKey)
+			if err != nil {
+				return nil, err
+			}
+			entries = append(entries, fullTextEntries...)
+			continue
+		}
+		if index.Vector {
+			vectorEntries, err := getVectorIndexEntry(ctx, store, col, index, valueKey)
+			if err != nil {
+				return nil, err
+			}
+			entries = append(entries, vectorEntries...)
+			continue
+		}
+
+		entry := core.IndexDataStoreKey{
+			CollectionID: col.IDString(),
+			IndexID:      index.IDString(),
+			FieldValues:  make([][]byte, len(index.Fields)),
+			DocKey:       docKey,
+		}
+		for j, indexedField := range index.Fields {
+			field, ok := col.GetField(indexedField.Name)
+			if !ok {
+				return nil, client.NewErrFieldNotExist(indexedField.Name)
+			}
+
+			value, err := getStoredValue(ctx, store, valueKey, field)
+			if err != nil {
+				return nil, err
+			}
+
+			entry.FieldValues[j], err = EncodeIndexValue(field.Kind, value)
+			if err != nil {
+				return nil, err
+			}
+		}
+		entries = append(entries, entry)
+	}
+
+	return entries, nil
+}
+
+// getFullTextIndexEntries returns the entries of the given full-text index for the document
+// at the given key, one for each distinct term of the indexed field.
+func getFullTextIndexEntries(
+	ctx context.Context,
+	store datastore.DSReaderWriter,
+	col client.CollectionDescription,
+	index client.IndexDescription,
+	valueKey core.DataStoreKey,
+) ([]core.IndexDataStoreKey, error) {
+	field, ok := col.GetField(index.Fields[0].Name)
+	if !ok {
+		return nil, client.NewErrFieldNotExist(index.Fields[0].Name)
+	}
+
+	value, err := getStoredValue(ctx, store, valueKey, field)
+	if err != nil {
+		return nil, err
+	}
+	text, ok := value.(string)
+	if !ok {
+		return nil, nil
+	}
+
+	terms := fulltext.Terms(text)
+	entries := make([]core.IndexDataStoreKey, len(terms))
+	for i, term := range terms {
+		encodedTerm, err := EncodeIndexValue(client.FieldKind_STRING, term)
+		if err != nil {
+			return nil, err
+		}
+		entries[i] = core.IndexDataStoreKey{
+			CollectionID: col.IDString(),
+			IndexID:      index.IDString(),
+			FieldValues:  [][]byte{encodedTerm},
+			DocKey:       valueKey.DocKey,
+		}
+	}
+	return entries, nil
+}
+
+// getStoredValue returns the decoded value of the given field of the document at the given key.
+//
+// Nil will be returned if no value is stored.
+func getStoredValue(
+	ctx context.Context,
+	store datastore.DSReaderWriter,
+	key core.DataStoreKey,
+	field client.FieldDescription,
+) (any, error) {
+	buf, err := store.Get(ctx, key.WithFieldId(field.ID.String()).ToDS())
+	if err != nil {
+		if errors.Is(err, ds.ErrNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	// The first byte is the CRDT type, it is followed by the CBOR encoded value.
+	if len(buf) <= 1 {
+		return nil, nil
+	}
+
+	var value any
+	err = cbor.Unmarshal(buf[1:], &value)
+	if err != nil {
+		return nil, err
+	}
+	return value, nil
+}
+
+// IndexConflict describes an entry of a unique index that conflicts with the entry of
+// another document.
+type IndexConflict struct {
+	// Index is the unique index that the conflicting entries belong to.
+	Index client.IndexDescription
+
+	// Entry is the entry that could not be stored as a unique entry.
+	Entry core.IndexDataStoreKey
+
+	// DocKey is the key of the other document holding the same indexed values.
+	DocKey string
+}
+
+// UpdateIndexEntries replaces the given existing index entries with the given new entries,
+// leaving those present in both untouched.
+//
+// Entries of unique indexes are keyed by their values alone, with the DocKey held in the
+// value of the entry, so that concurrent transactions writing the same values conflict with
+// each other.  If the values of a new entry are already held by another document, the entry
+// is stored keyed by its DocKey as any other entry, and a conflict is returned for each of the
+// other documents.  It is the responsibility of the caller to decide whether the conflicting
+// entries are acceptable, see [FlagIndexConflicts].
+//
+// Any flagged conflicts of a removed entry are cleared.
+//
+// Entries with a nil value are exempt from the unique constraint.
+func UpdateIndexEntries(
+	ctx context.Context,
+	store datastore.DSReaderWriter,
+	col client.CollectionDescription,
+	existingEntries []core.IndexDataStoreKey,
+	newEntries []core.IndexDataStoreKey,
+) ([]IndexConflict, error) {
+	newKeys := make(map[string]struct{}, len(newEntries))
+	for _, entry := range newEntries {
+		newKeys[entry.ToString()] = struct{}{}
+	}
+
+	existingKeys := make(map[string]struct{}, len(existingEntries))
+	for _, entry := range existingEntries {
+		key := entry.ToString()
+		existingKeys[key] = struct{}{}
+		if _, stillExists := newKeys[key]; stillExists {
+			continue
+		}
+		err := deleteIndexEntry(ctx, store, col, entry)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var conflicts []IndexConflict
+	for _, entry := range newEntries {
+		if _, alreadyExists := existingKeys[entry.ToString()]; alreadyExists {
+			continue
+		}
+		entryConflicts, err := putIndexEntry(ctx, store, col, entry)
+		if err != nil {
+			return nil, err
+		}
+		conflicts = append(conflicts, entryConflicts...)
+	}
+
+	return conflicts, nil
+}
+
+// deleteIndexEntry deletes the given index entry, in whichever form it is stored.
+//
+// The entries of vector indexes are deleted from the graph of the index.
+func deleteIndexEntry(
+	ctx context.Context,
+	store datastore.DSReaderWriter,
+	col client.CollectionDescription,
+	entry core.IndexDataStoreKey,
+) error {
+	index, ok := getIndex(col, entry.IndexID)
+	if ok && index.Vector {
+		return deleteVectorIndexNode(ctx, store, col, index, entry)
+	}
+
+	err := store.Delete(ctx, entry.ToDS())
+	if err != nil {
+		return err
+	}
+
+	if !ok || !isUniqueEntry(index, entry) {
+		return nil
+	}
+
+	err = clearIndexConflicts(ctx, store, entry)
+	if err != nil {
+		return err
+	}
+
+	uniqueKey := entry
+	uniqueKey.DocKey = ""
+	holder, err := store.Get(ctx, uniqueKey.ToDS())
+	if err != nil {
+		if errors.Is(err, ds.ErrNotFound) {
+			return nil
+		}
+		return err
+	}
+	if string(holder) != entry.DocKey {
+		// The unique entry is held by another document, this document's entry was stored
+		// keyed by its DocKey.
+		return nil
+	}
+	return store.Delete(ctx, uniqueKey.ToDS())
+}
+
+// putIndexEntry stores the given index entry.
+//
+// If the entry belongs to a unique index and its values are already held by other documents,
+// the entry is stored keyed by its DocKey and a conflict is returned for each of them.
+//
+// The entries of vector indexes are inserted into the graph of the index.
+func putIndexEntry(
+	ctx context.Context,
+	store datastore.DSReaderWriter,
+	col client.CollectionDescription,
+	entry core.IndexDataStoreKey,
+) ([]IndexConflict, error) {
+	index, ok := getIndex(col, entry.IndexID)
+	if ok && index.Vector {
+		return nil, insertVectorIndexNode(ctx, store, col, index, entry)
+	}
+	if !ok || !isUniqueEntry(index, entry) {
+		return nil, store.Put(ctx, entry.ToDS(), []byte{})
+	}
+
+	uniqueKey := entry
+	uniqueKey.DocKey = ""
+	holders, err := getUniqueEntryHolders(ctx, store, uniqueKey, entry.DocKey)
+	if err != nil {
+		return nil, err
+	}
+	if len(holders) == 0 {
+		return nil, store.Put(ctx, uniqueKey.ToDS(), []byte(entry.DocKey))
+	}
+
+	err = store.Put(ctx, entry.ToDS(), []byte{})
+	if err != nil {
+		return nil, err
+	}
+	conflicts := make([]IndexConflict, len(holders))
+	for i, holder := range holders {
+		conflicts[i] = IndexConflict{
+			Index:  index,
+			Entry:  entry,
+			DocKey: holder,
+		}
+	}
+	return conflicts, nil
+}
+
+// getUniqueEntryHolders returns the keys of the documents other than the given one holding the
+// values of the given unique entry, either as the unique entry itself or as previously
+// conflicting entries keyed by their DocKey.
+func getUniqueEntryHolders(
+	ctx context.Context,
+	store datastore.DSReaderWriter,
+	uniqueKey core.IndexDataStoreKey,
+	docKey string,
+) ([]string, error) {
+	var holders []string
+
+	holder, err := store.Get(ctx, uniqueKey.ToDS())
+	if err == nil {
+		if string(holder) != docKey {
+			holders = append(holders, string(holder))
+		}
+	} else if !errors.Is(err, ds.ErrNotFound) {
+		return nil, err
+	}
+
+	results, err := store.Query(ctx, dsq.Query{
+		Prefix:   uniqueKey.ToString(),
+		KeysOnly: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		// The results are only read from, closing them cannot affect the outcome.
+		_ = results.Close()
+	}()
+
+	for res := range results.Next() {
+		if res.Error != nil {
+			return nil, res.Error
+		}
+		key, err := core.NewIndexDataStoreKey(res.Key, len(uniqueKey.FieldValues))
+		if err != nil {
+			return nil, err
+		}
+		if key.DocKey != docKey {
+			holders = append(holders, key.DocKey)
+		}
+	}
+
+	return holders, nil
+}
+
+// FlagIndexConflicts persists the given conflicts, so that they may be resolved later on.
+//
+// Values violating a unique constraint may be written concurrently on different peers, in
+// which case the conflicting entries are accepted and flagged instead of leaving the peers
+// permanently diverged.  The conflicts remain flagged until either document no longer holds
+// the conflicting values.
+func FlagIndexConflicts(
+	ctx context.Context,
+	store datastore.DSReaderWriter,
+	col client.CollectionDescription,
+	conflicts []IndexConflict,
+) error {
+	for _, conflict := range conflicts {
+		key := core.UniqueConflictKey{
+			CollectionID:      col.IDString(),
+			IndexID:           conflict.Index.IDString(),
+			DocKey:            conflict.Entry.DocKey,
+			ConflictingDocKey: conflict.DocKey,
+		}
+		err := store.Put(ctx, key.ToDS(), []byte{})
+		if err != nil {
+			return err
+		}
+
+		key.DocKey, key.ConflictingDocKey = key.ConflictingDocKey, key.DocKey
+		err = store.Put(ctx, key.ToDS(), []byte{})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetIndexConflicts returns the flagged conflicts of the given collection.
+//
+// Each conflict is returned once, under the lowest DocKey of its documents.
+func GetIndexConflicts(
+	ctx context.Context,
+	store datastore.DSReaderWriter,
+	col client.CollectionDescription,
+) ([]core.UniqueConflictKey, error) {
+	prefix := core.UniqueConflictKey{CollectionID: col.IDString()}
+	results, err := store.Query(ctx, dsq.Query{
+		Prefix:   prefix.ToString(),
+		KeysOnly: true,
+		Orders:   []dsq.Order{dsq.OrderByKey{}},
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		// The results are only read from, closing them cannot affect the outcome.
+		_ = results.Close()
+	}()
+
+	var conflicts []core.UniqueConflictKey
+	for res := range results.Next() {
+		if res.Error != nil {
+			return nil, res.Error
+		}
+		key, err := core.NewUniqueConflictKey(res.Key)
+		if err != nil {
+			return nil, err
+		}
+		if key.DocKey < key.ConflictingDocKey {
+			conflicts = append(conflicts, key)
+		}
+	}
+	return conflicts, nil
+}
+
+// clearIndexConflicts deletes the flagged conflicts of the document of the given entry.
+func clearIndexConflicts(
+	ctx context.Context,
+	store datastore.DSReaderWriter,
+	entry core.IndexDataStoreKey,
+) error {
+	prefix := core.UniqueConflictKey{
+		CollectionID: entry.CollectionID,
+		IndexID:      entry.IndexID,
+		DocKey:       entry.DocKey,
+	}
+	results, err := store.Query(ctx, dsq.Query{
+		Prefix:   prefix.ToString(),
+		KeysOnly: true,
+	})
+	if err != nil {
+		return err
+	}
+	entries, err := results.Rest()
+	if err != nil {
+		return err
+	}
+
+	for _, res := range entries {
+		key, err := core.NewUniqueConflictKey(res.Key)
+		if err != nil {
+			return err
+		}
+		err = store.Delete(ctx, key.ToDS())
+		if err != nil {
+			return err
+		}
+
+		key.DocKey, key.ConflictingDocKey = key.ConflictingDocKey, key.DocKey
+		err = store.Delete(ctx, key.ToDS())
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// isUniqueEntry returns true if the given entry of the given index is subject to the
+// unique constraint.
+func isUniqueEntry(index client.IndexDescription, entry core.IndexDataStoreKey) bool {
+	if !index.Unique {
+		return false
+	}
+	for _, value := range entry.FieldValues {
+		if bytes.Equal(value, []byte{indexNilTag}) {
+			return false
+		}
+	}
+	return true
+}
+
+func getIndex(col client.CollectionDescription, indexID string) (client.IndexDescription, bool) {
+	for _, index := range col.Indexes {
+		if index.IDString() == indexID {
+			return index, true
+		}
+	}
+	return client.IndexDescription{}, false
+}
@@ -0,0 +1,696 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package base
+
+import (
+	"container/heap"
+	"context"
+	"encoding/binary"
+	"hash/fnv"
+	"math"
+	"sort"
+
+	"github.com/fxamacker/cbor/v2"
+	ds "github.com/ipfs/go-datastore"
+	dsq "github.com/ipfs/go-datastore/query"
+
+	"github.com/sourcenetwork/defradb/client"
+	"github.com/sourcenetwork/defradb/client/request"
+	"github.com/sourcenetwork/defradb/core"
+	"github.com/sourcenetwork/defradb/datastore"
+	"github.com/sourcenetwork/defradb/errors"
+)
+
+// Vector indexes are hierarchical navigable small world (HNSW) graphs, each document
+// holding a vector being a node of the graph linked to the nodes of its nearest neighbours.
+//
+// The nodes are stored keyed by the DocKey of their document below the key of the index:
+//
+// /[CollectionID]/i/[IndexID]/[DocKey]
+//
+// The key of the index itself holds the DocKey of the entry point of the graph, the node
+// from which all searches start.
+const (
+	// vectorIndexM is the maximum number of neighbours of a node on the upper layers of the
+	// graph, the base layer allows twice as many.
+	vectorIndexM = 16
+
+	// vectorIndexEfConstruction is the number of candidates from which the neighbours of a
+	// new node are chosen.
+	vectorIndexEfConstruction = 64
+
+	// vectorIndexMaxLevel caps the layer of the graph that a node may be inserted up to.
+	vectorIndexMaxLevel = 16
+)
+
+// vectorIndexNode is a node of the graph of a vector index.
+type vectorIndexNode struct {
+	// Vector is the value of the indexed field of the document.
+	Vector []float64
+
+	// Neighbours holds the DocKeys of the neighbours of the node on each layer that the node
+	// belongs to, from the base layer up.
+	Neighbours [][]string
+}
+
+// vectorIndexHeader is the value held by the key of a vector index.
+type vectorIndexHeader struct {
+	// EntryPoint is the DocKey of the node from which searches start, it belongs to the
+	// topmost layer of the graph.
+	EntryPoint string
+
+	// Level is the topmost layer of the graph.
+	Level int
+}
+
+// vectorCandidate is a node found while searching the graph, and its distance from the
+// vector searched for.
+type vectorCandidate struct {
+	docKey   string
+	distance float64
+}
+
+// VectorDistance returns the distance between the given vectors, of equal length, as
+// measured by the given metric.
+//
+// The cosine distance from a zero vector is one, as if the vectors were orthogonal.
+func VectorDistance(metric request.SimilarityMetric, a []float64, b []float64) float64 {
+	switch metric {
+	case request.L2Metric:
+		var sum float64
+		for i := range a {
+			diff := a[i] - b[i]
+			sum += diff * diff
+		}
+		return math.Sqrt(sum)
+
+	default:
+		var dot, normA, normB float64
+		for i := range a {
+			dot += a[i] * b[i]
+			normA += a[i] * a[i]
+			normB += b[i] * b[i]
+		}
+		if normA == 0 || normB == 0 {
+			return 1
+		}
+		return 1 - dot/(math.Sqrt(normA)*math.Sqrt(normB))
+	}
+}
+
+// SearchVectorIndex returns the DocKeys of the documents of the given vector index with the
+// vectors nearest to the given vector, from the nearest to the furthest.
+//
+// The search is approximate, up to ef documents are returned.  Fewer documents are returned
+// only if no more may be reached through the graph of the index.
+func SearchVectorIndex(
+	ctx context.Context,
+	store datastore.DSReaderWriter,
+	col client.CollectionDescription,
+	index client.IndexDescription,
+	vector []float64,
+	ef int,
+) ([]string, error) {
+	g := newVectorGraph(ctx, store, col, index)
+	header, err := g.getHeader()
+	if err != nil || header == nil {
+		return nil, err
+	}
+	entryPoints, err := g.getEntryPoints(header, vector, 0)
+	if err != nil || len(entryPoints) == 0 {
+		return nil, err
+	}
+
+	nearest, err := g.searchLayer(vector, entryPoints, ef, 0)
+	if err != nil {
+		return nil, err
+	}
+	docKeys := make([]string, len(nearest))
+	for i, candidate := range nearest {
+		docKeys[i] = candidate.docKey
+	}
+	return docKeys, nil
+}
+
+// getVectorIndexEntry returns the entry of the given vector index for the document at the
+// given key, if it holds a vector of the dimensions of the indexed field.
+//
+// The entry holds the encoded vector, so that the node of the document is replaced if the
+// vector changes, but is not stored as such.
+func getVectorIndexEntry(
+	ctx context.Context,
+	store datastore.DSReaderWriter,
+	col client.CollectionDescription,
+	index client.IndexDescription,
+	valueKey core.DataStoreKey,
+) ([]core.IndexDataStoreKey, error) {
+	field, ok := col.GetField(index.Fields[0].Name)
+	if !ok {
+		return nil, client.NewErrFieldNotExist(index.Fields[0].Name)
+	}
+
+	value, err := getStoredValue(ctx, store, valueKey, field)
+	if err != nil {
+		return nil, err
+	}
+	values, ok := value.([]any)
+	if !ok || len(values) != field.Dimensions {
+		return nil, nil
+	}
+
+	encodedVector := make([]byte, 8*len(values))
+	for i, value := range values {
+		v, ok := toFloat(value)
+		if !ok {
+			return nil, nil
+		}
+		binary.BigEndian.PutUint64(encodedVector[8*i:], math.Float64bits(v))
+	}
+
+	return []core.IndexDataStoreKey{
+		{
+			CollectionID: col.IDString(),
+			IndexID:      index.IDString(),
+			FieldValues:  [][]byte{encodedVector},
+			DocKey:       valueKey.DocKey,
+		},
+	}, nil
+}
+
+// insertVectorIndexNode inserts the node of the document of the given entry into the graph
+// of the given vector index.
+//
+// The node is linked to its nearest neighbours on each layer that it belongs to, and they
+// are linked back to it, dropping their furthest neighbours if they have too many.
+func insertVectorIndexNode(
+	ctx context.Context,
+	store datastore.DSReaderWriter,
+	col client.CollectionDescription,
+	index client.IndexDescription,
+	entry core.IndexDataStoreKey,
+) error {
+	encodedVector := entry.FieldValues[0]
+	vector := make([]float64, len(encodedVector)/8)
+	for i := range vector {
+		vector[i] = math.Float64frombits(binary.BigEndian.Uint64(encodedVector[8*i:]))
+	}
+
+	g := newVectorGraph(ctx, store, col, index)
+	level := vectorIndexNodeLevel(entry.DocKey)
+	node := &vectorIndexNode{
+		Vector:     vector,
+		Neighbours: make([][]string, level+1),
+	}
+
+	header, err := g.getHeader()
+	if err != nil {
+		return err
+	}
+	entryPoints, err := g.getEntryPoints(header, vector, level)
+	if err != nil {
+		return err
+	}
+
+	for l := level; l >= 0 && len(entryPoints) > 0; l-- {
+		if l > header.Level {
+			continue
+		}
+		nearest, err := g.searchLayer(vector, entryPoints, vectorIndexEfConstruction, l)
+		if err != nil {
+			return err
+		}
+		for _, candidate := range nearest {
+			if len(node.Neighbours[l]) == maxVectorIndexNeighbours(l) {
+				break
+			}
+			if candidate.docKey != entry.DocKey {
+				node.Neighbours[l] = append(node.Neighbours[l], candidate.docKey)
+			}
+		}
+		entryPoints = nearest
+	}
+
+	err = g.putNode(entry.DocKey, node)
+	if err != nil {
+		return err
+	}
+	for l, neighbours := range node.Neighbours {
+		for _, neighbour := range neighbours {
+			err := g.link(neighbour, entry.DocKey, l)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	if header == nil || level > header.Level {
+		return g.putHeader(vectorIndexHeader{EntryPoint: entry.DocKey, Level: level})
+	}
+	return nil
+}
+
+// deleteVectorIndexNode deletes the node of the document of the given entry from the graph
+// of the given vector index.
+//
+// The neighbours of the node are linked to its other neighbours in its place.  Nodes that the
+// deleted node was not linked back to keep a dangling link to it, which is skipped over by
+// searches and dropped once they are relinked.
+func deleteVectorIndexNode(
+	ctx context.Context,
+	store datastore.DSReaderWriter,
+	col client.CollectionDescription,
+	index client.IndexDescription,
+	entry core.IndexDataStoreKey,
+) error {
+	g := newVectorGraph(ctx, store, col, index)
+	node, err := g.getNode(entry.DocKey)
+	if err != nil || node == nil {
+		return err
+	}
+
+	err = g.deleteNode(entry.DocKey)
+	if err != nil {
+		return err
+	}
+	for l, neighbours := range node.Neighbours {
+		for _, neighbour := range neighbours {
+			err := g.unlink(neighbour, entry.DocKey, l, neighbours)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	header, err := g.getHeader()
+	if err != nil {
+		return err
+	}
+	if header == nil || header.EntryPoint != entry.DocKey {
+		return nil
+	}
+	return g.replaceEntryPoint(node)
+}
+
+// vectorIndexNodeLevel returns the topmost layer of the graph that the node of the given
+// document belongs to.
+//
+// The level is drawn from an exponentially decaying distribution, seeded by the DocKey so
+// that the same document is inserted up to the same layer on every peer.
+func vectorIndexNodeLevel(docKey string) int {
+	hash := fnv.New64a()
+	_, _ = hash.Write([]byte(docKey))
+	uniform := (float64(hash.Sum64()>>11) + 1) / (1 << 53)
+	level := int(-math.Log(uniform) / math.Log(vectorIndexM))
+	if level > vectorIndexMaxLevel {
+		return vectorIndexMaxLevel
+	}
+	return level
+}
+
+// maxVectorIndexNeighbours returns the maximum number of neighbours of a node on the
+// given layer.
+func maxVectorIndexNeighbours(level int) int {
+	if level == 0 {
+		return 2 * vectorIndexM
+	}
+	return vectorIndexM
+}
+
+// vectorGraph reads and writes the graph of a vector index, caching the nodes read.
+type vectorGraph struct {
+	ctx    context.Context
+	store  datastore.DSReaderWriter
+	key    core.IndexDataStoreKey
+	metric request.SimilarityMetric
+	nodes  map[string]*vectorIndexNode
+}
+
+func newVectorGraph(
+	ctx context.Context,
+	store datastore.DSReaderWriter,
+	col client.CollectionDescription,
+	index client.IndexDescription,
+) *vectorGraph {
+	return &vectorGraph{
+		ctx:   ctx,
+		store: store,
+		key: core.IndexDataStoreKey{
+			CollectionID: col.IDString(),
+			IndexID:      index.IDString(),
+		},
+		metric: request.SimilarityMetric(index.Metric),
+		nodes:  map[string]*vectorIndexNode{},
+	}
+}
+
+// getHeader returns the header of the graph, or nil if the graph is empty.
+func (g *vectorGraph) getHeader() (*vectorIndexHeader, error) {
+	buf, err := g.store.Get(g.ctx, g.key.ToDS())
+	if err != nil {
+		if errors.Is(err, ds.ErrNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	header := &vectorIndexHeader{}
+	err = cbor.Unmarshal(buf, header)
+	if err != nil {
+		return nil, err
+	}
+	return header, nil
+}
+
+func (g *vectorGraph) putHeader(header vectorIndexHeader) error {
+	buf, err := cbor.Marshal(header)
+	if err != nil {
+		return err
+	}
+	return g.store.Put(g.ctx, g.key.ToDS(), buf)
+}
+
+// getNode returns the node of the given document, or nil if it does not exist.
+func (g *vectorGraph) getNode(docKey string) (*vectorIndexNode, error) {
+	if node, isCached := g.nodes[docKey]; isCached {
+		return node, nil
+	}
+
+	key := g.key
+	key.DocKey = docKey
+	buf, err := g.store.Get(g.ctx, key.ToDS())
+	if err != nil {
+		if errors.Is(err, ds.ErrNotFound) {
+			g.nodes[docKey] = nil
+			return nil, nil
+		}
+		return nil, err
+	}
+	node := &vectorIndexNode{}
+	err = cbor.Unmarshal(buf, node)
+	if err != nil {
+		return nil, err
+	}
+	g.nodes[docKey] = node
+	return node, nil
+}
+
+func (g *vectorGraph) putNode(docKey string, node *vectorIndexNode) error {
+	buf, err := cbor.Marshal(node)
+	if err != nil {
+		return err
+	}
+	key := g.key
+	key.DocKey = docKey
+	g.nodes[docKey] = node
+	return g.store.Put(g.ctx, key.ToDS(), buf)
+}
+
+func (g *vectorGraph) deleteNode(docKey string) error {
+	key := g.key
+	key.DocKey = docKey
+	g.nodes[docKey] = nil
+	return g.store.Delete(g.ctx, key.ToDS())
+}
+
+// getEntryPoints descends the graph from its entry point down to the given layer, returning
+// the node nearest to the given vector found on the layer above it.
+func (g *vectorGraph) getEntryPoints(
+	header *vectorIndexHeader,
+	vector []float64,
+	level int,
+) ([]vectorCandidate, error) {
+	if header == nil {
+		return nil, nil
+	}
+	node, err := g.getNode(header.EntryPoint)
+	if err != nil || node == nil {
+		return nil, err
+	}
+
+	entryPoints := []vectorCandidate{{
+		docKey:   header.EntryPoint,
+		distance: VectorDistance(g.metric, vector, node.Vector),
+	}}
+	for l := header.Level; l > level; l-- {
+		entryPoints, err = g.searchLayer(vector, entryPoints, 1, l)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return entryPoints, nil
+}
+
+// searchLayer returns the ef nodes nearest to the given vector found on the given layer of
+// the graph, starting from the given entry points, from the nearest to the furthest.
+func (g *vectorGraph) searchLayer(
+	vector []float64,
+	entryPoints []vectorCandidate,
+	ef int,
+	level int,
+) ([]vectorCandidate, error) {
+	visited := make(map[string]struct{}, len(entryPoints))
+	candidates := &vectorCandidateHeap{}
+	nearest := &vectorCandidateHeap{furthestFirst: true}
+	for _, entryPoint := range entryPoints {
+		visited[entryPoint.docKey] = struct{}{}
+		heap.Push(candidates, entryPoint)
+		heap.Push(nearest, entryPoint)
+		if nearest.Len() > ef {
+			heap.Pop(nearest)
+		}
+	}
+
+	for candidates.Len() > 0 {
+		candidate := heap.Pop(candidates).(vectorCandidate)
+		if nearest.Len() >= ef && candidate.distance > nearest.candidates[0].distance {
+			break
+		}
+
+		node, err := g.getNode(candidate.docKey)
+		if err != nil {
+			return nil, err
+		}
+		if node == nil || level >= len(node.Neighbours) {
+			continue
+		}
+
+		for _, neighbour := range node.Neighbours[level] {
+			if _, isVisited := visited[neighbour]; isVisited {
+				continue
+			}
+			visited[neighbour] = struct{}{}
+
+			neighbourNode, err := g.getNode(neighbour)
+			if err != nil {
+				return nil, err
+			}
+			if neighbourNode == nil {
+				continue
+			}
+
+			distance := VectorDistance(g.metric, vector, neighbourNode.Vector)
+			if nearest.Len() < ef || distance < nearest.candidates[0].distance {
+				heap.Push(candidates, vectorCandidate{docKey: neighbour, distance: distance})
+				heap.Push(nearest, vectorCandidate{docKey: neighbour, distance: distance})
+				if nearest.Len() > ef {
+					heap.Pop(nearest)
+				}
+			}
+		}
+	}
+
+	results := nearest.candidates
+	sort.Slice(results, func(i, j int) bool {
+		return results[i].distance < results[j].distance
+	})
+	return results, nil
+}
+
+// link links the given node to the given neighbour on the given layer, dropping its
+// furthest neighbours if it then has too many.
+func (g *vectorGraph) link(docKey string, neighbour string, level int) error {
+	node, err := g.getNode(docKey)
+	if err != nil || node == nil || level >= len(node.Neighbours) {
+		return err
+	}
+	for _, existing := range node.Neighbours[level] {
+		if existing == neighbour {
+			return nil
+		}
+	}
+
+	neighbours, err := g.selectNeighbours(node, append(node.Neighbours[level], neighbour), level)
+	if err != nil {
+		return err
+	}
+	node.Neighbours[level] = neighbours
+	return g.putNode(docKey, node)
+}
+
+// unlink removes the given deleted neighbour from the neighbours of the given node on the
+// given layer, linking it to the nearest of the given replacements in its place.
+func (g *vectorGraph) unlink(docKey string, deleted string, level int, replacements []string) error {
+	node, err := g.getNode(docKey)
+	if err != nil || node == nil || level >= len(node.Neighbours) {
+		return err
+	}
+
+	candidates := make([]string, 0, len(node.Neighbours[level])+len(replacements))
+	seen := map[string]struct{}{docKey: {}, deleted: {}}
+	isLinked := false
+	for _, neighbour := range node.Neighbours[level] {
+		if neighbour == deleted {
+			isLinked = true
+		}
+		if _, isSeen := seen[neighbour]; !isSeen {
+			seen[neighbour] = struct{}{}
+			candidates = append(candidates, neighbour)
+		}
+	}
+	if !isLinked {
+		return nil
+	}
+	for _, replacement := range replacements {
+		if _, isSeen := seen[replacement]; !isSeen {
+			seen[replacement] = struct{}{}
+			candidates = append(candidates, replacement)
+		}
+	}
+
+	neighbours, err := g.selectNeighbours(node, candidates, level)
+	if err != nil {
+		return err
+	}
+	node.Neighbours[level] = neighbours
+	return g.putNode(docKey, node)
+}
+
+// selectNeighbours returns the candidates nearest to the given node, as many as a node may
+// be linked to on the given layer.  Candidates that no longer exist are dropped.
+func (g *vectorGraph) selectNeighbours(
+	node *vectorIndexNode,
+	candidates []string,
+	level int,
+) ([]string, error) {
+	nearest := make([]vectorCandidate, 0, len(candidates))
+	for _, candidate := range candidates {
+		candidateNode, err := g.getNode(candidate)
+		if err != nil {
+			return nil, err
+		}
+		if candidateNode == nil {
+			continue
+		}
+		nearest = append(nearest, vectorCandidate{
+			docKey:   candidate,
+			distance: VectorDistance(g.metric, node.Vector, candidateNode.Vector),
+		})
+	}
+	sort.SliceStable(nearest, func(i, j int) bool {
+		return nearest[i].distance < nearest[j].distance
+	})
+
+	neighbours := make([]string, 0, maxVectorIndexNeighbours(level))
+	for _, candidate := range nearest {
+		if len(neighbours) == maxVectorIndexNeighbours(level) {
+			break
+		}
+		neighbours = append(neighbours, candidate.docKey)
+	}
+	return neighbours, nil
+}
+
+// replaceEntryPoint replaces the given deleted entry point of the graph with its neighbour
+// belonging to the topmost layer, as its neighbours on its topmost layer are the nodes
+// nearest to it that share that layer.
+//
+// Any remaining node is made the entry point if the deleted node had no neighbours left, and
+// the graph is emptied if no nodes remain.
+func (g *vectorGraph) replaceEntryPoint(deleted *vectorIndexNode) error {
+	var header *vectorIndexHeader
+	for l := len(deleted.Neighbours) - 1; l >= 0 && header == nil; l-- {
+		for _, neighbour := range deleted.Neighbours[l] {
+			node, err := g.getNode(neighbour)
+			if err != nil {
+				return err
+			}
+			if node == nil {
+				continue
+			}
+			if header == nil || len(node.Neighbours)-1 > header.Level {
+				header = &vectorIndexHeader{EntryPoint: neighbour, Level: len(node.Neighbours) - 1}
+			}
+		}
+	}
+	if header != nil {
+		return g.putHeader(*header)
+	}
+
+	results, err := g.store.Query(g.ctx, dsq.Query{Prefix: g.key.ToString(), Limit: 1})
+	if err != nil {
+		return err
+	}
+	defer func() {
+		// The results are only read from, closing them cannot affect the outcome.
+		_ = results.Close()
+	}()
+
+	for res := range results.Next() {
+		if res.Error != nil {
+			return res.Error
+		}
+		key, err := core.NewIndexDataStoreKey(res.Key, 0)
+		if err != nil {
+			return err
+		}
+		node := &vectorIndexNode{}
+		err = cbor.Unmarshal(res.Value, node)
+		if err != nil {
+			return err
+		}
+		header = &vectorIndexHeader{EntryPoint: key.DocKey, Level: len(node.Neighbours) - 1}
+	}
+
+	if header == nil {
+		return g.store.Delete(g.ctx, g.key.ToDS())
+	}
+	return g.putHeader(*header)
+}
+
+// vectorCandidateHeap is a heap of candidates by distance, either the nearest or the
+// furthest first.
+type vectorCandidateHeap struct {
+	candidates    []vectorCandidate
+	furthestFirst bool
+}
+
+func (h *vectorCandidateHeap) Len() int { return len(h.candidates) }
+
+func (h *vectorCandidateHeap) Less(i, j int) bool {
+	if h.furthestFirst {
+		return h.candidates[i].distance > h.candidates[j].distance
+	}
+	return h.candidates[i].distance < h.candidates[j].distance
+}
+
+func (h *vectorCandidateHeap) Swap(i, j int) {
+	h.candidates[i], h.candidates[j] = h.candidates[j], h.candidates[i]
+}
+
+func (h *vectorCandidateHeap) Push(x any) {
+	h.candidates = append(h.candidates, x.(vectorCandidate))
+}
+
+func (h *vectorCandidateHeap) Pop() any {
+	n := len(h.candidates)
+	x := h.candidates[n-1]
+	h.candidates = h.candidates[:n-1]
+	return x
+}
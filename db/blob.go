@@ -0,0 +1,44 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package db
+
+import (
+	"context"
+	"encoding/base64"
+
+	"github.com/sourcenetwork/defradb/client"
+	"github.com/sourcenetwork/defradb/datastore"
+)
+
+// saveBlob stores the base64 encoded content of the given value of a blob field in the DAG
+// store, returning the CID of the blob by which the document references it.
+func (c *collection) saveBlob(
+	ctx context.Context,
+	txn datastore.Txn,
+	field client.FieldDescription,
+	value any,
+) (string, error) {
+	encoded, ok := value.(string)
+	if !ok {
+		return "", client.NewErrUnexpectedType[string](field.Name, value)
+	}
+
+	content, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return "", NewErrInvalidBlobValue(field.Name, err)
+	}
+
+	blobCID, err := datastore.PutBlob(ctx, txn.DAGstore(), content)
+	if err != nil {
+		return "", err
+	}
+	return blobCID.String(), nil
+}
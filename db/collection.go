@@ -15,6 +15,8 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"reflect"
+	"regexp"
 	"strconv"
 
 	"github.com/fxamacker/cbor/v2"
@@ -56,6 +58,10 @@ type collection struct {
 	schemaID string
 
 	desc client.CollectionDescription
+
+	// constraintPatterns holds the compiled patterns of the constraints of the schema, keyed by
+	// the name of their field.
+	constraintPatterns map[string]*regexp.Regexp
 }
 
 // @todo: Move the base Descriptions to an internal API within the db/ package.
@@ -93,10 +99,16 @@ func (db *db) newCollection(desc client.CollectionDescription) (*collection, err
 		desc.Schema.Fields[i].ID = client.FieldID(i)
 	}
 
+	constraintPatterns, err := db.getConstraintPatterns(desc.Schema)
+	if err != nil {
+		return nil, err
+	}
+
 	return &collection{
-		db:    db,
-		desc:  desc,
-		colID: desc.ID,
+		db:                 db,
+		desc:               desc,
+		colID:              desc.ID,
+		constraintPatterns: constraintPatterns,
 	}, nil
 }
 
@@ -117,6 +129,36 @@ func (db *db) createCollection(
 		return nil, ErrCollectionAlreadyExists
 	}
 
+	_, err = validateEnums(client.SchemaDescription{}, desc.Schema)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = validateConstraints(client.SchemaDescription{}, desc.Schema)
+	if err != nil {
+		return nil, err
+	}
+
+	err = validateEmbeddedFields(desc.Schema)
+	if err != nil {
+		return nil, err
+	}
+
+	err = validateDefaultValues(desc.Schema)
+	if err != nil {
+		return nil, err
+	}
+
+	err = validateRequiredFieldKinds(desc.Schema)
+	if err != nil {
+		return nil, err
+	}
+
+	err = validateCRDTTypes(desc.Schema)
+	if err != nil {
+		return nil, err
+	}
+
 	colSeq, err := db.getSequence(ctx, txn, core.COLLECTION)
 	if err != nil {
 		return nil, err
@@ -131,6 +173,11 @@ func (db *db) createCollection(
 		return nil, err
 	}
 
+	err = initIndexes(&col.desc)
+	if err != nil {
+		return nil, err
+	}
+
 	// Local elements such as secondary indexes should be excluded
 	// from the (global) schemaId.
 	globalSchemaBuf, err := json.Marshal(struct {
@@ -310,6 +357,11 @@ func (db *db) validateUpdateCollection(
 		return false, ErrCannotSetVersionID
 	}
 
+	if !reflect.DeepEqual(proposedDesc.Indexes, existingDesc.Indexes) {
+		// Existing documents would need to be indexed, this is not yet supported.
+		return false, NewErrCannotModifyIndexes(proposedDesc.Name)
+	}
+
 	existingFieldsByID := map[client.FieldID]client.FieldDescription{}
 	existingFieldIndexesByName := map[string]int{}
 	for i, field := range existingDesc.Schema.Fields {
@@ -352,8 +404,19 @@ func (db *db) validateUpdateCollection(
 			return false, NewErrCannotMoveField(proposedField.Name, proposedIndex, existingIndex)
 		}
 
-		if proposedField.Typ != client.NONE_CRDT && proposedField.Typ != client.LWW_REGISTER {
-			return false, NewErrInvalidCRDTType(proposedField.Name, proposedField.Typ)
+		if !fieldAlreadyExists && proposedField.Kind == client.FieldKind_FLOAT_VECTOR &&
+			proposedField.Dimensions < 1 {
+			return false, NewErrInvalidVectorDimensions(proposedField.Name, proposedField.Dimensions)
+		}
+
+		if !fieldAlreadyExists && proposedField.IsRequired && !proposedField.DefaultValue.HasValue() {
+			// Existing documents would have no value for the field.
+			return false, NewErrRequiredFieldWithoutDefault(proposedField.Name)
+		}
+
+		err := validateFieldCRDTType(proposedField)
+		if err != nil {
+			return false, err
 		}
 
 		newFieldNames[proposedField.Name] = struct{}{}
@@ -366,7 +429,32 @@ func (db *db) validateUpdateCollection(
 		}
 	}
 
-	return hasChanged, nil
+	enumsHaveChanged, err := validateEnums(existingDesc.Schema, proposedDesc.Schema)
+	if err != nil {
+		return false, err
+	}
+
+	constraintsHaveChanged, err := validateConstraints(existingDesc.Schema, proposedDesc.Schema)
+	if err != nil {
+		return false, err
+	}
+
+	err = validateEmbeddedFields(proposedDesc.Schema)
+	if err != nil {
+		return false, err
+	}
+
+	err = validateDefaultValues(proposedDesc.Schema)
+	if err != nil {
+		return false, err
+	}
+
+	err = validateRequiredFieldKinds(proposedDesc.Schema)
+	if err != nil {
+		return false, err
+	}
+
+	return hasChanged || enumsHaveChanged || constraintsHaveChanged, nil
 }
 
 // getCollectionByVersionId returns the [*collection] at the given [schemaVersionId] version.
@@ -393,11 +481,17 @@ func (db *db) getCollectionByVersionID(
 		return nil, err
 	}
 
+	constraintPatterns, err := db.getConstraintPatterns(desc.Schema)
+	if err != nil {
+		return nil, err
+	}
+
 	return &collection{
-		db:       db,
-		desc:     desc,
-		colID:    desc.ID,
-		schemaID: desc.Schema.SchemaID,
+		db:                 db,
+		desc:               desc,
+		colID:              desc.ID,
+		schemaID:           desc.Schema.SchemaID,
+		constraintPatterns: constraintPatterns,
 	}, nil
 }
 
@@ -569,11 +663,12 @@ func (c *collection) SchemaID() string {
 // handle instead of a raw DB handle.
 func (c *collection) WithTxn(txn datastore.Txn) client.Collection {
 	return &collection{
-		db:       c.db,
-		txn:      immutable.Some(txn),
-		desc:     c.desc,
-		colID:    c.colID,
-		schemaID: c.schemaID,
+		db:                 c.db,
+		txn:                immutable.Some(txn),
+		desc:               c.desc,
+		colID:              c.colID,
+		schemaID:           c.schemaID,
+		constraintPatterns: c.constraintPatterns,
 	}
 }
 
@@ -659,6 +754,23 @@ func (c *collection) create(ctx context.Context, txn datastore.Txn, doc *client.
 		return ErrDocumentDeleted
 	}
 
+	// Default values are applied after the dockey has been verified, as the key is
+	// generated from the values given by the user.
+	err = c.applyDefaultValues(doc)
+	if err != nil {
+		return err
+	}
+
+	err = c.validateRequiredFields(doc, true)
+	if err != nil {
+		return err
+	}
+
+	err = c.validateDocConstraints(doc)
+	if err != nil {
+		return err
+	}
+
 	// write value object marker if we have an empty doc
 	if len(doc.Values()) == 0 {
 		valueKey := c.getDSKeyFromDockey(dockey)
@@ -713,7 +825,17 @@ func (c *collection) Update(ctx context.Context, doc *client.Document) error {
 // Should probably be smart about the update due to the MerkleCRDT overhead, shouldn't
 // add to the bloat.
 func (c *collection) update(ctx context.Context, txn datastore.Txn, doc *client.Document) error {
-	_, err := c.save(ctx, txn, doc, false)
+	err := c.validateRequiredFields(doc, false)
+	if err != nil {
+		return err
+	}
+
+	err = c.validateDocConstraints(doc)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.save(ctx, txn, doc, false)
 	if err != nil {
 		return err
 	}
@@ -770,6 +892,12 @@ func (c *collection) save(
 	//	=> 		instantiate MerkleCRDT objects
 	//	=> 		Set/Publish new CRDT values
 	primaryKey := c.getPrimaryKeyFromDocKey(doc.Key())
+
+	existingIndexEntries, err := c.getIndexEntries(ctx, txn, primaryKey.DocKey)
+	if err != nil {
+		return cid.Undef, err
+	}
+
 	links := make([]core.DAGLink, 0)
 	docProperties := make(map[string]any)
 	for k, v := range doc.Fields() {
@@ -778,7 +906,16 @@ func (c *collection) save(
 			return cid.Undef, err
 		}
 
-		if val.IsDirty() {
+		if !val.IsDirty() {
+			continue
+		}
+
+		fieldValues, err := c.embeddedFieldValues(k, val)
+		if err != nil {
+			return cid.Undef, err
+		}
+
+		for k, val := range fieldValues {
 			fieldKey, fieldExists := c.tryGetFieldKey(primaryKey, k)
 			if !fieldExists {
 				return cid.Undef, client.NewErrFieldNotExist(k)
@@ -789,6 +926,49 @@ func (c *collection) save(
 				return cid.Undef, client.NewErrFieldNotExist(k)
 			}
 
+			if fieldDescription.Kind == client.FieldKind_JSON && val.IsDocument() {
+				val, err = client.NewJSONValue(fieldDescription.Typ, val)
+				if err != nil {
+					return cid.Undef, err
+				}
+			}
+
+			if fieldDescription.Kind == client.FieldKind_DECIMAL && !val.IsDelete() {
+				val, err = client.NewDecimalValue(fieldDescription.Typ, val)
+				if err != nil {
+					return cid.Undef, err
+				}
+			}
+
+			if fieldDescription.Kind == client.FieldKind_BIG_INT && !val.IsDelete() {
+				val, err = client.NewBigIntValue(fieldDescription.Typ, val)
+				if err != nil {
+					return cid.Undef, err
+				}
+			}
+
+			if fieldDescription.Kind == client.FieldKind_ENUM && !val.IsDelete() {
+				err = c.validateEnumValue(fieldDescription, val.Value())
+				if err != nil {
+					return cid.Undef, err
+				}
+			}
+
+			if fieldDescription.Kind == client.FieldKind_BLOB && !val.IsDelete() {
+				blobCID, err := c.saveBlob(ctx, txn, fieldDescription, val.Value())
+				if err != nil {
+					return cid.Undef, err
+				}
+				val = client.NewCBORValue(fieldDescription.Typ, blobCID)
+			}
+
+			if fieldDescription.Kind == client.FieldKind_FLOAT_VECTOR && !val.IsDelete() {
+				err = validateVectorValue(fieldDescription, val.Value())
+				if err != nil {
+					return cid.Undef, err
+				}
+			}
+
 			relationFieldDescription, isSecondaryRelationID := c.isSecondaryIDField(fieldDescription)
 			if isSecondaryRelationID {
 				primaryId := val.Value().(string)
@@ -803,6 +983,18 @@ func (c *collection) save(
 				continue
 			}
 
+			if fieldDescription.Typ == client.OR_SET && !val.IsDelete() {
+				elements, err := normalizeSetValue(fieldDescription, val.Value())
+				if err != nil {
+					return cid.Undef, err
+				}
+				val = client.NewCBORValue(fieldDescription.Typ, elements)
+			}
+
+			if val.Type() != fieldDescription.Typ {
+				val = client.NewCBORValue(fieldDescription.Typ, val.Value())
+			}
+
 			node, _, err := c.saveDocValue(ctx, txn, fieldKey, val)
 			if err != nil {
 				return cid.Undef, err
@@ -843,6 +1035,11 @@ func (c *collection) save(
 		return cid.Undef, err
 	}
 
+	err = c.updateIndexEntries(ctx, txn, primaryKey.DocKey, existingIndexEntries)
+	if err != nil {
+		return cid.Undef, err
+	}
+
 	if c.db.events.Updates.HasValue() {
 		txn.OnSuccess(
 			func() {
@@ -866,6 +1063,32 @@ func (c *collection) save(
 	return headNode.Cid(), nil
 }
 
+// validateVectorValue returns an error if the given value of a vector field is not an array
+// of numbers with the number of dimensions of the field.
+func validateVectorValue(field client.FieldDescription, value any) error {
+	var dimensions int
+	switch typedValue := value.(type) {
+	case nil:
+		return nil
+	case []float64:
+		dimensions = len(typedValue)
+	case []any:
+		for _, element := range typedValue {
+			if _, isNumber := element.(float64); !isNumber {
+				return client.NewErrUnexpectedType[float64](field.Name, element)
+			}
+		}
+		dimensions = len(typedValue)
+	default:
+		return client.NewErrUnexpectedType[[]float64](field.Name, value)
+	}
+
+	if dimensions != field.Dimensions {
+		return NewErrVectorDimensionsMismatch(field.Name, field.Dimensions, dimensions)
+	}
+	return nil
+}
+
 // Delete will attempt to delete a document by key will return true if a deletion is successful,
 // and return false, along with an error, if it cannot.
 // If the document doesn't exist, then it will return false, and a ErrDocumentNotFound error.
@@ -937,26 +1160,25 @@ func (c *collection) saveDocValue(
 	key core.DataStoreKey,
 	val client.Value,
 ) (ipld.Node, uint64, error) {
-	switch val.Type() {
-	case client.LWW_REGISTER:
-		wval, ok := val.(client.WriteableValue)
-		if !ok {
-			return nil, 0, client.ErrValueTypeMismatch
-		}
-		var bytes []byte
-		var err error
-		if val.IsDelete() { // empty byte array
-			bytes = []byte{}
-		} else {
-			bytes, err = wval.Bytes()
-			if err != nil {
-				return nil, 0, err
-			}
-		}
-		return c.saveValueToMerkleCRDT(ctx, txn, key, client.LWW_REGISTER, bytes)
-	default:
+	if val.Type() == client.NONE_CRDT || !val.Type().IsSupportedFieldCType() {
 		return nil, 0, ErrUnknownCRDT
 	}
+
+	wval, ok := val.(client.WriteableValue)
+	if !ok {
+		return nil, 0, client.ErrValueTypeMismatch
+	}
+	var bytes []byte
+	var err error
+	if val.IsDelete() { // empty byte array
+		bytes = []byte{}
+	} else {
+		bytes, err = wval.Bytes()
+		if err != nil {
+			return nil, 0, err
+		}
+	}
+	return c.saveValueToMerkleCRDT(ctx, txn, key, val.Type(), bytes)
 }
 
 func (c *collection) saveValueToMerkleCRDT(
@@ -965,8 +1187,10 @@ func (c *collection) saveValueToMerkleCRDT(
 	key core.DataStoreKey,
 	ctype client.CType,
 	args ...any) (ipld.Node, uint64, error) {
+	ctx = c.db.commitContext(ctx)
 	switch ctype {
-	case client.LWW_REGISTER:
+	case client.COMPOSITE:
+		key = key.WithFieldId(core.COMPOSITE_NAMESPACE)
 		merkleCRDT, err := c.db.crdtFactory.InstanceWithStores(
 			txn,
 			core.NewCollectionSchemaVersionKey(c.Schema().VersionID),
@@ -978,20 +1202,37 @@ func (c *collection) saveValueToMerkleCRDT(
 			return nil, 0, err
 		}
 
-		var bytes []byte
-		var ok bool
 		// parse args
-		if len(args) != 1 {
+		if len(args) < 2 {
 			return nil, 0, ErrUnknownCRDTArgument
 		}
-		bytes, ok = args[0].([]byte)
+		bytes, ok := args[0].([]byte)
 		if !ok {
 			return nil, 0, ErrUnknownCRDTArgument
 		}
-		lwwreg := merkleCRDT.(*crdt.MerkleLWWRegister)
-		return lwwreg.Set(ctx, bytes)
-	case client.COMPOSITE:
-		key = key.WithFieldId(core.COMPOSITE_NAMESPACE)
+		links, ok := args[1].([]core.DAGLink)
+		if !ok {
+			return nil, 0, ErrUnknownCRDTArgument
+		}
+		comp := merkleCRDT.(*crdt.MerkleCompositeDAG)
+		if c.desc.HasHLCField() {
+			// the commits of documents with fields ordered by time record the time they were made at
+			comp = comp.WithTimestamps()
+		}
+		if len(args) > 2 {
+			status, ok := args[2].(client.DocumentStatus)
+			if !ok {
+				return nil, 0, ErrUnknownCRDTArgument
+			}
+			if status.IsDeleted() {
+				return comp.Delete(ctx, links)
+			}
+		}
+		return comp.Set(ctx, bytes, links)
+	default:
+		if !ctype.IsSupportedFieldCType() {
+			return nil, 0, ErrUnknownCRDT
+		}
 		merkleCRDT, err := c.db.crdtFactory.InstanceWithStores(
 			txn,
 			core.NewCollectionSchemaVersionKey(c.Schema().VersionID),
@@ -1004,30 +1245,19 @@ func (c *collection) saveValueToMerkleCRDT(
 		}
 
 		// parse args
-		if len(args) < 2 {
+		if len(args) != 1 {
 			return nil, 0, ErrUnknownCRDTArgument
 		}
 		bytes, ok := args[0].([]byte)
 		if !ok {
 			return nil, 0, ErrUnknownCRDTArgument
 		}
-		links, ok := args[1].([]core.DAGLink)
+		field, ok := merkleCRDT.(crdt.FieldMerkleCRDT)
 		if !ok {
-			return nil, 0, ErrUnknownCRDTArgument
-		}
-		comp := merkleCRDT.(*crdt.MerkleCompositeDAG)
-		if len(args) > 2 {
-			status, ok := args[2].(client.DocumentStatus)
-			if !ok {
-				return nil, 0, ErrUnknownCRDTArgument
-			}
-			if status.IsDeleted() {
-				return comp.Delete(ctx, links)
-			}
+			return nil, 0, ErrUnknownCRDT
 		}
-		return comp.Set(ctx, bytes, links)
+		return field.Set(ctx, bytes)
 	}
-	return nil, 0, ErrUnknownCRDT
 }
 
 // getTxn gets or creates a new transaction from the underlying db.
@@ -236,6 +236,11 @@ func (c *collection) applyDelete(
 		return ErrDocumentDeleted
 	}
 
+	existingIndexEntries, err := c.getIndexEntries(ctx, txn, key.DocKey)
+	if err != nil {
+		return err
+	}
+
 	dsKey := key.ToDataStoreKey()
 
 	headset := clock.NewHeadSet(
@@ -268,6 +273,11 @@ func (c *collection) applyDelete(
 		return err
 	}
 
+	err = c.updateIndexEntries(ctx, txn, key.DocKey, existingIndexEntries)
+	if err != nil {
+		return err
+	}
+
 	if c.db.events.Updates.HasValue() {
 		txn.OnSuccess(
 			func() {
@@ -13,6 +13,7 @@ package db
 import (
 	"context"
 	"fmt"
+	"math/big"
 	"strings"
 
 	cbor "github.com/fxamacker/cbor/v2"
@@ -285,6 +286,12 @@ func (c *collection) applyMerge(
 		return ErrDocMissingKey
 	}
 	key := c.getPrimaryKey(keyStr)
+
+	existingIndexEntries, err := c.getIndexEntries(ctx, txn, keyStr)
+	if err != nil {
+		return err
+	}
+
 	links := make([]core.DAGLink, 0)
 
 	mergeMap := make(map[string]*fastjson.Value)
@@ -292,18 +299,48 @@ func (c *collection) applyMerge(
 		mergeMap[string(k)] = v
 	})
 
+	mergeMap, err = c.flattenEmbeddedMerge(mergeMap)
+	if err != nil {
+		return err
+	}
+
+	constraintValues, err := c.getMergeConstraintValues(mergeMap)
+	if err != nil {
+		return err
+	}
+
 	mergeCBOR := make(map[string]any)
 
 	for mfield, mval := range mergeMap {
-		if mval.Type() == fastjson.TypeObject {
-			return ErrInvalidMergeValueType
-		}
-
 		fd, valid := c.desc.GetField(mfield)
 		if !valid {
 			return client.NewErrFieldNotExist(mfield)
 		}
 
+		if isFieldOperation(fd, mval) {
+			fieldKey, fieldExists := c.tryGetFieldKey(key, mfield)
+			if !fieldExists {
+				return client.NewErrFieldNotExist(mfield)
+			}
+
+			node, value, err := c.applyFieldOperation(ctx, txn, fieldKey, fd, mval)
+			if err != nil {
+				return err
+			}
+			constraintValues[mfield] = value
+			mergeCBOR[mfield] = value
+
+			links = append(links, core.DAGLink{
+				Name: mfield,
+				Cid:  node.Cid(),
+			})
+			continue
+		}
+
+		if mval.Type() == fastjson.TypeObject && fd.Kind != client.FieldKind_JSON {
+			return ErrInvalidMergeValueType
+		}
+
 		relationFieldDescription, isSecondaryRelationID := c.isSecondaryIDField(fd)
 		if isSecondaryRelationID {
 			primaryId, err := getString(mval)
@@ -321,11 +358,37 @@ func (c *collection) applyMerge(
 			continue
 		}
 
+		if fd.IsRequired && mval.Type() == fastjson.TypeNull {
+			return NewErrRequiredFieldMissing(fd.Name)
+		}
+
 		cborVal, err := validateFieldSchema(mval, fd)
 		if err != nil {
 			return err
 		}
-		mergeCBOR[mfield] = cborVal
+		if fd.Kind == client.FieldKind_ENUM {
+			err = c.validateEnumValue(fd, cborVal)
+			if err != nil {
+				return err
+			}
+		}
+		if fd.Kind == client.FieldKind_BLOB {
+			cborVal, err = c.saveBlob(ctx, txn, fd, cborVal)
+			if err != nil {
+				return err
+			}
+		}
+		if fd.Typ == client.OR_SET {
+			cborVal, err = normalizeSetValue(fd, cborVal)
+			if err != nil {
+				return err
+			}
+		}
+		if r, ok := cborVal.(*big.Rat); ok {
+			mergeCBOR[mfield] = client.EncodeDecimal(r)
+		} else {
+			mergeCBOR[mfield] = cborVal
+		}
 
 		val := client.NewCBORValue(fd.Typ, cborVal)
 		fieldKey, fieldExists := c.tryGetFieldKey(key, mfield)
@@ -344,12 +407,34 @@ func (c *collection) applyMerge(
 		})
 	}
 
+	// The values of fields updated by operations are only known once they have been applied,
+	// all the values are then checked together so that every violation is reported at once.
+	err = c.checkConstraints(constraintValues)
+	if err != nil {
+		return err
+	}
+
+	return c.saveUpdatedComposite(ctx, txn, key, mergeCBOR, links, existingIndexEntries)
+}
+
+// saveUpdatedComposite saves the composite block of an update to the document of the given key,
+// linking to the given field blocks, before updating its index entries and publishing the update.
+func (c *collection) saveUpdatedComposite(
+	ctx context.Context,
+	txn datastore.Txn,
+	key core.PrimaryDataStoreKey,
+	values map[string]any,
+	links []core.DAGLink,
+	existingIndexEntries []core.IndexDataStoreKey,
+) error {
+	keyStr := key.DocKey
+
 	// Update CompositeDAG
 	em, err := cbor.CanonicalEncOptions().EncMode()
 	if err != nil {
 		return err
 	}
-	buf, err := em.Marshal(mergeCBOR)
+	buf, err := em.Marshal(values)
 	if err != nil {
 		return err
 	}
@@ -367,6 +452,11 @@ func (c *collection) applyMerge(
 		return err
 	}
 
+	err = c.updateIndexEntries(ctx, txn, keyStr, existingIndexEntries)
+	if err != nil {
+		return err
+	}
+
 	if c.db.events.Updates.HasValue() {
 		txn.OnSuccess(
 			func() {
@@ -440,7 +530,7 @@ func (c *collection) patchPrimaryDoc(
 // the typed value again as an interface.
 func validateFieldSchema(val *fastjson.Value, field client.FieldDescription) (any, error) {
 	switch field.Kind {
-	case client.FieldKind_DocKey, client.FieldKind_STRING:
+	case client.FieldKind_DocKey, client.FieldKind_STRING, client.FieldKind_ENUM:
 		return getString(val)
 
 	case client.FieldKind_STRING_ARRAY:
@@ -449,7 +539,7 @@ func validateFieldSchema(val *fastjson.Value, field client.FieldDescription) (an
 	case client.FieldKind_NILLABLE_STRING_ARRAY:
 		return getNillableArray(val, getString)
 
-	case client.FieldKind_BOOL:
+	case client.FieldKind_BOOL, client.FieldKind_EMBEDDED_OBJECT:
 		return getBool(val)
 
 	case client.FieldKind_BOOL_ARRAY:
@@ -461,7 +551,7 @@ func validateFieldSchema(val *fastjson.Value, field client.FieldDescription) (an
 	case client.FieldKind_FLOAT:
 		return getFloat64(val)
 
-	case client.FieldKind_FLOAT_ARRAY:
+	case client.FieldKind_FLOAT_ARRAY, client.FieldKind_FLOAT_VECTOR:
 		return getArray(val, getFloat64)
 
 	case client.FieldKind_NILLABLE_FLOAT_ARRAY:
@@ -484,6 +574,20 @@ func validateFieldSchema(val *fastjson.Value, field client.FieldDescription) (an
 	case client.FieldKind_NILLABLE_INT_ARRAY:
 		return getNillableArray(val, getInt64)
 
+	case client.FieldKind_JSON:
+		return getJSON(val)
+
+	case client.FieldKind_BLOB:
+		// The base64 encoded content of the blob, which is replaced by the CID
+		// of the stored blob when saved.
+		return getString(val)
+
+	case client.FieldKind_DECIMAL:
+		return getDecimal(val)
+
+	case client.FieldKind_BIG_INT:
+		return getBigInt(val)
+
 	case client.FieldKind_FOREIGN_OBJECT, client.FieldKind_FOREIGN_OBJECT_ARRAY:
 		return nil, ErrMergeSubTypeNotSupported
 	}
@@ -508,6 +612,80 @@ func getInt64(v *fastjson.Value) (int64, error) {
 	return v.Int64()
 }
 
+// getDecimal returns the exact value of the given decimal string or number.
+func getDecimal(v *fastjson.Value) (*big.Rat, error) {
+	switch v.Type() {
+	case fastjson.TypeString:
+		s, err := getString(v)
+		if err != nil {
+			return nil, err
+		}
+		return client.ParseDecimal(s)
+	default:
+		// The text of numbers is used so that no precision is lost.
+		return client.ParseDecimal(v.String())
+	}
+}
+
+// getBigInt returns the exact value of the given integer string or number.
+func getBigInt(v *fastjson.Value) (*big.Int, error) {
+	switch v.Type() {
+	case fastjson.TypeString:
+		s, err := getString(v)
+		if err != nil {
+			return nil, err
+		}
+		return client.ParseBigInt(s)
+	default:
+		// The text of numbers is used so that no precision is lost.
+		return client.ParseBigInt(v.String())
+	}
+}
+
+// getJSON returns the Go value of the given JSON value.
+//
+// Numbers are returned as int64 if they are integers, and as float64 otherwise, like the
+// numbers of created documents.
+func getJSON(v *fastjson.Value) (any, error) {
+	switch v.Type() {
+	case fastjson.TypeObject:
+		valObject, err := v.Object()
+		if err != nil {
+			return nil, err
+		}
+		obj := make(map[string]any, valObject.Len())
+		valObject.Visit(func(key []byte, item *fastjson.Value) {
+			if err != nil {
+				return
+			}
+			obj[string(key)], err = getJSON(item)
+		})
+		return obj, err
+
+	case fastjson.TypeArray:
+		return getArray(v, getJSON)
+
+	case fastjson.TypeString:
+		return getString(v)
+
+	case fastjson.TypeNumber:
+		f, err := v.Float64()
+		if err != nil {
+			return nil, err
+		}
+		if float64(int64(f)) == f {
+			return int64(f), nil
+		}
+		return f, nil
+
+	case fastjson.TypeTrue, fastjson.TypeFalse:
+		return getBool(v)
+
+	default:
+		return nil, nil
+	}
+}
+
 func getArray[T any](
 	val *fastjson.Value,
 	typeGetter func(*fastjson.Value) (T, error),
@@ -0,0 +1,330 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package db
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"strconv"
+	"unicode/utf8"
+
+	"github.com/valyala/fastjson"
+
+	"github.com/sourcenetwork/defradb/client"
+	"github.com/sourcenetwork/defradb/connor/numbers"
+)
+
+// validateConstraints returns an error if the constraints of the given proposed schema are not
+// valid for the fields they apply to.
+//
+// It returns true if the proposed constraints differ from the existing ones.
+func validateConstraints(existing client.SchemaDescription, proposed client.SchemaDescription) (bool, error) {
+	fieldNames := map[string]struct{}{}
+	for _, constraint := range proposed.Constraints {
+		if _, isDuplicate := fieldNames[constraint.Field]; isDuplicate {
+			return false, NewErrInvalidConstraint(constraint.Field)
+		}
+		fieldNames[constraint.Field] = struct{}{}
+
+		field, exists := getSchemaField(proposed, constraint.Field)
+		if !exists {
+			return false, client.NewErrFieldNotExist(constraint.Field)
+		}
+
+		if (constraint.Min != nil || constraint.Max != nil) && !isNumericKind(field.Kind) {
+			return false, NewErrInvalidConstraint(constraint.Field)
+		}
+		if constraint.Min != nil && constraint.Max != nil && *constraint.Min > *constraint.Max {
+			return false, NewErrInvalidConstraint(constraint.Field)
+		}
+
+		if (constraint.MinLength != nil || constraint.MaxLength != nil) && !hasLengthKind(field.Kind) {
+			return false, NewErrInvalidConstraint(constraint.Field)
+		}
+		if (constraint.MinLength != nil && *constraint.MinLength < 0) ||
+			(constraint.MaxLength != nil && *constraint.MaxLength < 0) ||
+			(constraint.MinLength != nil && constraint.MaxLength != nil &&
+				*constraint.MinLength > *constraint.MaxLength) {
+			return false, NewErrInvalidConstraint(constraint.Field)
+		}
+
+		if constraint.Pattern != "" {
+			if field.Kind != client.FieldKind_STRING {
+				return false, NewErrInvalidConstraint(constraint.Field)
+			}
+			if _, err := regexp.Compile(constraint.Pattern); err != nil {
+				return false, NewErrInvalidConstraint(constraint.Field)
+			}
+		}
+	}
+
+	if len(existing.Constraints) == 0 && len(proposed.Constraints) == 0 {
+		return false, nil
+	}
+	return !reflect.DeepEqual(existing.Constraints, proposed.Constraints), nil
+}
+
+// getConstraintPatterns returns the compiled patterns of the constraints of the given schema,
+// keyed by the name of their field.
+//
+// The patterns of each schema version are compiled once, when the version is first loaded,
+// and cached on the database.
+func (db *db) getConstraintPatterns(schema client.SchemaDescription) (map[string]*regexp.Regexp, error) {
+	if schema.VersionID != "" {
+		if patterns, isCached := db.constraintPatterns.Load(schema.VersionID); isCached {
+			return patterns.(map[string]*regexp.Regexp), nil
+		}
+	}
+
+	patterns := map[string]*regexp.Regexp{}
+	for _, constraint := range schema.Constraints {
+		if constraint.Pattern == "" {
+			continue
+		}
+		pattern, err := regexp.Compile(constraint.Pattern)
+		if err != nil {
+			return nil, err
+		}
+		patterns[constraint.Field] = pattern
+	}
+
+	if schema.VersionID != "" {
+		db.constraintPatterns.Store(schema.VersionID, patterns)
+	}
+	return patterns, nil
+}
+
+func getSchemaField(schema client.SchemaDescription, name string) (client.FieldDescription, bool) {
+	for _, field := range schema.Fields {
+		if field.Name == name {
+			return field, true
+		}
+	}
+	return client.FieldDescription{}, false
+}
+
+func isNumericKind(kind client.FieldKind) bool {
+	switch kind {
+	case client.FieldKind_INT, client.FieldKind_FLOAT, client.FieldKind_DECIMAL, client.FieldKind_BIG_INT:
+		return true
+	default:
+		return false
+	}
+}
+
+func hasLengthKind(kind client.FieldKind) bool {
+	switch kind {
+	case client.FieldKind_STRING,
+		client.FieldKind_BOOL_ARRAY,
+		client.FieldKind_INT_ARRAY,
+		client.FieldKind_FLOAT_ARRAY,
+		client.FieldKind_FLOAT_VECTOR,
+		client.FieldKind_STRING_ARRAY,
+		client.FieldKind_NILLABLE_BOOL_ARRAY,
+		client.FieldKind_NILLABLE_INT_ARRAY,
+		client.FieldKind_NILLABLE_FLOAT_ARRAY,
+		client.FieldKind_NILLABLE_STRING_ARRAY:
+		return true
+	default:
+		return false
+	}
+}
+
+// validateDocConstraints returns an error listing every constraint violated by the values
+// set on the given document.
+func (c *collection) validateDocConstraints(doc *client.Document) error {
+	if len(c.desc.Schema.Constraints) == 0 {
+		return nil
+	}
+
+	values := map[string]any{}
+	for name, field := range doc.Fields() {
+		val, err := doc.GetValueWithField(field)
+		if err != nil {
+			return err
+		}
+		if !val.IsDirty() {
+			continue
+		}
+
+		fieldValues, err := c.embeddedFieldValues(name, val)
+		if err != nil {
+			return err
+		}
+		for fieldName, fieldValue := range fieldValues {
+			if !fieldValue.IsDelete() {
+				values[fieldName] = fieldValue.Value()
+			}
+		}
+	}
+	return c.checkConstraints(values)
+}
+
+// getMergeConstraintValues returns the values of the given merge that are subject to a
+// constraint, keyed by the name of their field.
+//
+// The values of fields updated by operations are excluded, they are only known once the
+// operations have been applied.
+func (c *collection) getMergeConstraintValues(merge map[string]*fastjson.Value) (map[string]any, error) {
+	values := map[string]any{}
+	if len(c.desc.Schema.Constraints) == 0 {
+		return values, nil
+	}
+
+	for name, mval := range merge {
+		if _, hasConstraint := c.desc.Schema.GetConstraint(name); !hasConstraint {
+			continue
+		}
+		field, exists := c.desc.GetField(name)
+		if !exists || mval.Type() == fastjson.TypeNull || isFieldOperation(field, mval) {
+			continue
+		}
+
+		value, err := validateFieldSchema(mval, field)
+		if err != nil {
+			return nil, err
+		}
+		values[name] = value
+	}
+	return values, nil
+}
+
+// checkConstraints returns an error listing every constraint violated by the given values,
+// keyed by the name of their field.  Null values satisfy all constraints.
+func (c *collection) checkConstraints(values map[string]any) error {
+	var violations []string
+	for _, constraint := range c.desc.Schema.Constraints {
+		value, hasValue := values[constraint.Field]
+		if !hasValue || value == nil {
+			continue
+		}
+		field, exists := c.desc.GetField(constraint.Field)
+		if !exists {
+			continue
+		}
+
+		fieldViolations, err := checkConstraint(field, constraint, c.constraintPatterns[field.Name], value)
+		if err != nil {
+			return err
+		}
+		violations = append(violations, fieldViolations...)
+	}
+
+	if len(violations) > 0 {
+		return NewErrConstraintsViolated(violations)
+	}
+	return nil
+}
+
+// checkConstraint returns a description of each part of the given constraint that the given
+// value of the given field violates.
+//
+// The pattern of the constraint is expected to be given compiled.
+func checkConstraint(
+	field client.FieldDescription,
+	constraint client.ConstraintDescription,
+	pattern *regexp.Regexp,
+	value any,
+) ([]string, error) {
+	var violations []string
+
+	if constraint.Min != nil || constraint.Max != nil {
+		number, err := constraintNumber(field, value)
+		if err != nil {
+			return nil, err
+		}
+		if constraint.Min != nil && compareConstraintBound(number, *constraint.Min) < 0 {
+			violations = append(
+				violations,
+				fmt.Sprintf("%s must be at least %s", field.Name, formatBound(*constraint.Min)),
+			)
+		}
+		if constraint.Max != nil && compareConstraintBound(number, *constraint.Max) > 0 {
+			violations = append(
+				violations,
+				fmt.Sprintf("%s must be at most %s", field.Name, formatBound(*constraint.Max)),
+			)
+		}
+	}
+
+	if constraint.MinLength != nil || constraint.MaxLength != nil {
+		length := constraintLength(value)
+		if constraint.MinLength != nil && length < *constraint.MinLength {
+			violations = append(
+				violations,
+				fmt.Sprintf("%s must have a length of at least %v", field.Name, *constraint.MinLength),
+			)
+		}
+		if constraint.MaxLength != nil && length > *constraint.MaxLength {
+			violations = append(
+				violations,
+				fmt.Sprintf("%s must have a length of at most %v", field.Name, *constraint.MaxLength),
+			)
+		}
+	}
+
+	if pattern != nil {
+		str, isString := value.(string)
+		if !isString || !pattern.MatchString(str) {
+			violations = append(
+				violations,
+				fmt.Sprintf("%s must match the pattern %s", field.Name, constraint.Pattern),
+			)
+		}
+	}
+
+	return violations, nil
+}
+
+// constraintNumber returns the given value of the given numeric field, as a number that may be
+// compared exactly to the bounds of a constraint.
+//
+// Decimal and BigInt values are returned as *big.Rat and *big.Int values respectively.
+func constraintNumber(field client.FieldDescription, value any) (any, error) {
+	switch field.Kind {
+	case client.FieldKind_DECIMAL:
+		return client.ParseDecimal(value)
+
+	case client.FieldKind_BIG_INT:
+		return client.ParseBigInt(value)
+	}
+
+	switch number := value.(type) {
+	case int64, int, float64:
+		return number, nil
+	default:
+		return nil, client.NewErrUnexpectedType[float64](field.Name, value)
+	}
+}
+
+// compareConstraintBound compares the given number to the given bound exactly, returning -1 if
+// the number is below the bound, 0 if it is equal and 1 if it is above.
+func compareConstraintBound(number any, bound float64) int {
+	result, _ := numbers.Compare(number, bound)
+	return result
+}
+
+// constraintLength returns the number of characters of the given string, or the number of
+// items of the given array.
+func constraintLength(value any) int {
+	if str, isString := value.(string); isString {
+		return utf8.RuneCountInString(str)
+	}
+	if v := reflect.ValueOf(value); v.Kind() == reflect.Slice {
+		return v.Len()
+	}
+	return 0
+}
+
+func formatBound(bound float64) string {
+	return strconv.FormatFloat(bound, 'f', -1, 64)
+}
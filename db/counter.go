@@ -0,0 +1,197 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package db
+
+import (
+	"context"
+
+	cbor "github.com/fxamacker/cbor/v2"
+	ipld "github.com/ipfs/go-ipld-format"
+	"github.com/valyala/fastjson"
+
+	"github.com/sourcenetwork/defradb/client"
+	"github.com/sourcenetwork/defradb/core"
+	"github.com/sourcenetwork/defradb/datastore"
+	"github.com/sourcenetwork/defradb/merkle/crdt"
+)
+
+// IncrementWithKey increments the counter fields of the document matching the given DocKey by
+// the amounts given in the increments JSON object.
+func (c *collection) IncrementWithKey(
+	ctx context.Context,
+	key client.DocKey,
+	increments string,
+) (*client.UpdateResult, error) {
+	txn, err := c.getTxn(ctx, false)
+	if err != nil {
+		return nil, err
+	}
+	defer c.discardImplicitTxn(ctx, txn)
+	res, err := c.incrementWithKey(ctx, txn, key, increments)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, c.commitImplicitTxn(ctx, txn)
+}
+
+func (c *collection) incrementWithKey(
+	ctx context.Context,
+	txn datastore.Txn,
+	key client.DocKey,
+	increments string,
+) (*client.UpdateResult, error) {
+	parsedIncrements, err := fastjson.Parse(increments)
+	if err != nil {
+		return nil, err
+	}
+	if parsedIncrements.Type() != fastjson.TypeObject {
+		return nil, client.ErrInvalidUpdater
+	}
+
+	primaryKey := c.getPrimaryKeyFromDocKey(key)
+	exists, isDeleted, err := c.exists(ctx, txn, primaryKey)
+	if err != nil {
+		return nil, err
+	}
+	if !exists || isDeleted {
+		return nil, client.ErrDocumentNotFound
+	}
+
+	err = c.applyIncrements(ctx, txn, primaryKey, parsedIncrements.GetObject())
+	if err != nil {
+		return nil, err
+	}
+
+	return &client.UpdateResult{
+		Count:   1,
+		DocKeys: []string{key.String()},
+	}, nil
+}
+
+// applyIncrements increments the counter fields of the document of the given key by the given
+// amounts.
+//
+// As the resulting values are only known once the increments have been applied, any constraint
+// they violate is reported after they have been written, in which case the transaction must not
+// be committed.
+func (c *collection) applyIncrements(
+	ctx context.Context,
+	txn datastore.Txn,
+	key core.PrimaryDataStoreKey,
+	increments *fastjson.Object,
+) error {
+	existingIndexEntries, err := c.getIndexEntries(ctx, txn, key.DocKey)
+	if err != nil {
+		return err
+	}
+
+	incrementMap := make(map[string]*fastjson.Value)
+	increments.Visit(func(k []byte, v *fastjson.Value) {
+		incrementMap[string(k)] = v
+	})
+
+	incrementMap, err = c.flattenEmbeddedMerge(incrementMap)
+	if err != nil {
+		return err
+	}
+
+	links := make([]core.DAGLink, 0, len(incrementMap))
+	values := make(map[string]any, len(incrementMap))
+	for name, ival := range incrementMap {
+		field, exists := c.desc.GetField(name)
+		if !exists {
+			return client.NewErrFieldNotExist(name)
+		}
+		if field.IsEmbedded() {
+			// The host fields of embedded counters are left untouched.
+			continue
+		}
+		if field.Typ != client.PN_COUNTER {
+			return NewErrFieldNotCounter(name)
+		}
+		if ival.Type() != fastjson.TypeNumber {
+			return NewErrInvalidIncrement(name, ival.String())
+		}
+
+		amount, err := validateFieldSchema(ival, field)
+		if err != nil {
+			return NewErrInvalidIncrement(name, ival.String())
+		}
+
+		fieldKey, fieldExists := c.tryGetFieldKey(key, name)
+		if !fieldExists {
+			return client.NewErrFieldNotExist(name)
+		}
+
+		node, value, err := c.incrementCounter(ctx, txn, fieldKey, amount)
+		if err != nil {
+			return err
+		}
+		values[name] = value
+
+		links = append(links, core.DAGLink{
+			Name: name,
+			Cid:  node.Cid(),
+		})
+	}
+
+	err = c.checkConstraints(values)
+	if err != nil {
+		return err
+	}
+
+	return c.saveUpdatedComposite(ctx, txn, key, values, links, existingIndexEntries)
+}
+
+// incrementCounter increments the counter of the given field key by the given amount, returning
+// the block of the increment and the resulting value of the counter.
+func (c *collection) incrementCounter(
+	ctx context.Context,
+	txn datastore.Txn,
+	key core.DataStoreKey,
+	amount any,
+) (ipld.Node, any, error) {
+	merkleCRDT, err := c.db.crdtFactory.InstanceWithStores(
+		txn,
+		core.NewCollectionSchemaVersionKey(c.Schema().VersionID),
+		c.db.events.Updates,
+		client.PN_COUNTER,
+		key,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+	counter := merkleCRDT.(*crdt.MerklePNCounter)
+
+	buf, err := cbor.Marshal(amount)
+	if err != nil {
+		return nil, nil, err
+	}
+	node, _, err := counter.Increment(c.db.commitContext(ctx), buf)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	buf, err = counter.Value(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	var value any
+	err = cbor.Unmarshal(buf, &value)
+	if err != nil {
+		return nil, nil, err
+	}
+	if i, isUint := value.(uint64); isUint {
+		value = int64(i)
+	}
+	return node, value, nil
+}
@@ -0,0 +1,101 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package db
+
+import (
+	"context"
+
+	ipld "github.com/ipfs/go-ipld-format"
+	"github.com/valyala/fastjson"
+
+	"github.com/sourcenetwork/defradb/client"
+	"github.com/sourcenetwork/defradb/core"
+	"github.com/sourcenetwork/defradb/datastore"
+)
+
+// validateFieldCRDTType returns an error if the CRDT type of the given field is not supported,
+// or may not be used by fields of its kind.
+func validateFieldCRDTType(field client.FieldDescription) error {
+	if !field.Typ.IsSupportedFieldCType() {
+		return NewErrInvalidCRDTType(field.Name, field.Typ)
+	}
+
+	switch field.Typ {
+	case client.PN_COUNTER:
+		if field.Kind != client.FieldKind_INT && field.Kind != client.FieldKind_FLOAT {
+			return NewErrInvalidCounterField(field.Name, field.Kind)
+		}
+		return nil
+
+	case client.OR_SET:
+		if !isSetKind(field.Kind) {
+			return NewErrInvalidSetField(field.Name, field.Kind)
+		}
+		return nil
+
+	case client.MV_REGISTER:
+		if field.IsObject() {
+			return NewErrInvalidMVRegisterField(field.Name, field.Kind)
+		}
+		return nil
+
+	case client.RGA_TEXT:
+		if field.Kind != client.FieldKind_STRING {
+			return NewErrInvalidTextField(field.Name, field.Kind)
+		}
+		return nil
+
+	default:
+		return nil
+	}
+}
+
+// validateCRDTTypes returns an error if any field of the given schema has an invalid CRDT type.
+func validateCRDTTypes(schema client.SchemaDescription) error {
+	for _, field := range schema.Fields {
+		err := validateFieldCRDTType(field)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// isFieldOperation returns true if the given merge value of the given field is an operation
+// applied by the CRDT of the field, such as adding to an OR set or editing a text, rather than
+// a value replacing that of the field.
+func isFieldOperation(field client.FieldDescription, val *fastjson.Value) bool {
+	switch field.Typ {
+	case client.OR_SET, client.RGA_TEXT:
+		return val.Type() == fastjson.TypeObject
+	default:
+		return false
+	}
+}
+
+// applyFieldOperation applies the given operation to the CRDT of the given field key,
+// returning the block of the operation and the resulting value of the field.
+func (c *collection) applyFieldOperation(
+	ctx context.Context,
+	txn datastore.Txn,
+	key core.DataStoreKey,
+	field client.FieldDescription,
+	operation *fastjson.Value,
+) (ipld.Node, any, error) {
+	switch field.Typ {
+	case client.OR_SET:
+		return c.updateSet(ctx, txn, key, field, operation)
+	case client.RGA_TEXT:
+		return c.editText(ctx, txn, key, field, operation)
+	default:
+		return nil, nil, ErrInvalidMergeValueType
+	}
+}
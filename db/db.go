@@ -17,6 +17,8 @@ package db
 import (
 	"context"
 	"sync"
+	"sync/atomic"
+	"time"
 
 	blockstore "github.com/ipfs/boxo/blockstore"
 	ds "github.com/ipfs/go-datastore"
@@ -56,6 +58,10 @@ type db struct {
 
 	crdtFactory *crdt.Factory
 
+	// hlc is the hybrid logical clock timestamping the deltas created by this instance, and
+	// observing the timestamps of the deltas it merges.
+	hlc *core.HLC
+
 	events events.Events
 
 	parser core.Parser
@@ -63,8 +69,15 @@ type db struct {
 	// The maximum number of retries per transaction.
 	maxTxnRetries immutable.Option[int]
 
+	// peerID is the ID of the peer this instance is running as, if any, authoring its commits.
+	peerID atomic.Value
+
 	// The options used to init the database
 	options any
+
+	// constraintPatterns caches the compiled patterns of the constraints of each schema
+	// version, keyed by the schema version ID.
+	constraintPatterns sync.Map
 }
 
 // Functional option type.
@@ -88,6 +101,16 @@ func WithMaxRetries(num int) Option {
 	}
 }
 
+// WithMaxClockOffset sets the maximum time the timestamps of the blocks received from peers may
+// be ahead of the local clock, beyond which the blocks are rejected.
+//
+// A non-positive offset places no bound on the timestamps of the blocks received.
+func WithMaxClockOffset(maxOffset time.Duration) Option {
+	return func(db *db) {
+		db.hlc.SetMaxOffset(maxOffset)
+	}
+}
+
 // NewDB creates a new instance of the DB using the given options.
 func NewDB(ctx context.Context, rootstore datastore.RootStore, options ...Option) (client.DB, error) {
 	return newDB(ctx, rootstore, options...)
@@ -97,7 +120,8 @@ func newDB(ctx context.Context, rootstore datastore.RootStore, options ...Option
 	log.Debug(ctx, "Loading: internal datastores")
 	root := datastore.AsDSReaderWriter(rootstore)
 	multistore := datastore.MultiStoreFrom(root)
-	crdtFactory := crdt.DefaultFactory.WithStores(multistore)
+	hlc := core.NewHLC(time.Now, core.DefaultHLCMaxOffset)
+	crdtFactory := crdt.DefaultFactory.WithStores(multistore).WithHLC(hlc)
 
 	parser, err := graphql.NewParser()
 	if err != nil {
@@ -109,6 +133,7 @@ func newDB(ctx context.Context, rootstore datastore.RootStore, options ...Option
 		multistore: multistore,
 
 		crdtFactory: &crdtFactory,
+		hlc:         hlc,
 
 		parser:  parser,
 		options: options,
@@ -224,6 +249,24 @@ func (db *db) MaxTxnRetries() int {
 	return defaultMaxTxnRetries
 }
 
+// HLC returns the hybrid logical clock timestamping the deltas created by this instance, and
+// observing the timestamps of the deltas it merges.
+func (db *db) HLC() *core.HLC {
+	return db.hlc
+}
+
+// SetPeerID sets the ID of the peer this instance is running as, which authors its commits.
+func (db *db) SetPeerID(peerID string) {
+	db.peerID.Store(peerID)
+}
+
+// commitContext returns a copy of the given context, in which commits are authored by the peer
+// this instance is running as unless the context already carries an author.
+func (db *db) commitContext(ctx context.Context) context.Context {
+	peerID, _ := db.peerID.Load().(string)
+	return core.WithDefaultCommitAuthor(ctx, peerID)
+}
+
 // PrintDump prints the entire database to console.
 func (db *db) PrintDump(ctx context.Context) error {
 	return printStore(ctx, db.multistore.Rootstore())
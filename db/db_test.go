@@ -13,6 +13,7 @@ package db
 import (
 	"context"
 	"testing"
+	"time"
 
 	badger "github.com/dgraph-io/badger/v3"
 	dag "github.com/ipfs/boxo/ipld/merkledag"
@@ -49,6 +50,20 @@ func TestNewDB(t *testing.T) {
 	}
 }
 
+func TestNewDBWithMaxClockOffsetOnlyBoundsItsOwnClock(t *testing.T) {
+	ctx := context.Background()
+	bounded, err := newMemoryDB(ctx)
+	assert.NoError(t, err)
+	WithMaxClockOffset(time.Second)(bounded.db)
+	unbounded, err := newMemoryDB(ctx)
+	assert.NoError(t, err)
+
+	remote := core.NewHLCTimestamp(time.Now().Add(time.Minute), 0)
+	assert.ErrorIs(t, bounded.HLC().Observe(remote), core.ErrHLCMaxOffsetExceeded)
+	assert.NoError(t, unbounded.HLC().Observe(remote))
+	assert.Less(t, bounded.HLC().Now(), remote)
+}
+
 func TestDBSaveSimpleDocument(t *testing.T) {
 	ctx := context.Background()
 	db, err := newMemoryDB(ctx)
@@ -329,6 +344,7 @@ func TestDocumentMerkleDAG(t *testing.T) {
 			"Name",
 		),
 		nil,
+		nil,
 	)
 	heads := clk.(*clock.MerkleClock).Heads()
 	cids, _, err := heads.List(ctx)
@@ -0,0 +1,83 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package db
+
+import (
+	"github.com/valyala/fastjson"
+
+	"github.com/sourcenetwork/defradb/client"
+)
+
+// applyDefaultValues sets each field of the given document that has a default value, and
+// that has not been given a value, to its default value.
+func (c *collection) applyDefaultValues(doc *client.Document) error {
+	fields := doc.Fields()
+	for _, field := range c.desc.Schema.Fields {
+		if !field.DefaultValue.HasValue() {
+			continue
+		}
+		if _, isSet := fields[field.Name]; isSet {
+			continue
+		}
+
+		value, err := field.DefaultValue.Value()
+		if err != nil {
+			return err
+		}
+		err = doc.Set(field.Name, value)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validateDefaultValues returns an error if the default value of any field of the given
+// schema is not a valid value of that field.
+//
+// Relation fields, blob fields, and embedded objects and their fields may not have default
+// values.
+func validateDefaultValues(schema client.SchemaDescription) error {
+	for _, field := range schema.Fields {
+		if !field.DefaultValue.HasValue() {
+			continue
+		}
+
+		if field.IsObject() || field.IsEmbedded() || field.IsEmbeddedField() ||
+			field.Kind == client.FieldKind_BLOB {
+			return NewErrInvalidDefaultValue(field.Name, field.DefaultValue)
+		}
+
+		val, err := fastjson.Parse(string(field.DefaultValue))
+		if err != nil {
+			return NewErrInvalidDefaultValue(field.Name, field.DefaultValue)
+		}
+		value, err := validateFieldSchema(val, field)
+		if err != nil {
+			return NewErrInvalidDefaultValue(field.Name, field.DefaultValue)
+		}
+
+		if field.Kind == client.FieldKind_ENUM {
+			enum, exists := schema.GetEnum(field.Schema)
+			str, isString := value.(string)
+			if !exists || !isString || !enum.HasValue(str) {
+				return NewErrInvalidDefaultValue(field.Name, field.DefaultValue)
+			}
+		}
+		if field.Kind == client.FieldKind_FLOAT_VECTOR {
+			err = validateVectorValue(field, value)
+			if err != nil {
+				return NewErrInvalidDefaultValue(field.Name, field.DefaultValue)
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,134 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package db
+
+import (
+	"strings"
+
+	"github.com/valyala/fastjson"
+
+	"github.com/sourcenetwork/defradb/client"
+)
+
+// embeddedFieldValues returns the values to save, by field name, of the given value of the
+// field of the given name.
+//
+// Objects set on embedded object fields are saved as the values of their own fields, each
+// with its own CRDT so that concurrent edits to different fields merge, alongside a marker
+// on the embedded object field recording that the object is set.  Setting an embedded
+// object field to null sets the marker and all the fields within it to null.  The values of other
+// fields are returned unchanged.
+func (c *collection) embeddedFieldValues(name string, val client.Value) (map[string]client.Value, error) {
+	field, exists := c.desc.GetField(name)
+	if !exists || !field.IsEmbedded() {
+		return map[string]client.Value{name: val}, nil
+	}
+
+	values := map[string]client.Value{}
+	if val.IsDelete() {
+		for _, f := range c.desc.Schema.Fields {
+			if f.Name == name || strings.HasPrefix(f.Name, name+client.EmbeddedFieldSeparator) {
+				values[f.Name] = client.NewCBORValue(f.Typ, nil)
+			}
+		}
+		return values, nil
+	}
+
+	subDoc, isDocument := val.Value().(*client.Document)
+	if !isDocument {
+		return nil, NewErrInvalidEmbeddedValue(name, val.Value())
+	}
+
+	values[name] = client.NewCBORValue(field.Typ, true)
+	for subName, subField := range subDoc.Fields() {
+		subVal, err := subDoc.GetValueWithField(subField)
+		if err != nil {
+			return nil, err
+		}
+
+		fieldName := name + client.EmbeddedFieldSeparator + subName
+		if _, exists := c.desc.GetField(fieldName); !exists {
+			return nil, client.NewErrFieldNotExist(fieldName)
+		}
+
+		subValues, err := c.embeddedFieldValues(fieldName, subVal)
+		if err != nil {
+			return nil, err
+		}
+		for k, v := range subValues {
+			values[k] = v
+		}
+	}
+	return values, nil
+}
+
+// flattenEmbeddedMerge returns the given merge values, by field name, with the objects
+// merged into embedded object fields replaced by the values of their own fields, alongside
+// a marker on the embedded object field recording that the object is set.
+func (c *collection) flattenEmbeddedMerge(merge map[string]*fastjson.Value) (map[string]*fastjson.Value, error) {
+	flattened := make(map[string]*fastjson.Value, len(merge))
+	for name, val := range merge {
+		field, exists := c.desc.GetField(name)
+		if !exists || !field.IsEmbedded() {
+			flattened[name] = val
+			continue
+		}
+
+		obj, err := val.Object()
+		if err != nil {
+			return nil, NewErrInvalidEmbeddedValue(name, val)
+		}
+
+		subMerge := map[string]*fastjson.Value{}
+		obj.Visit(func(k []byte, v *fastjson.Value) {
+			subMerge[name+client.EmbeddedFieldSeparator+string(k)] = v
+		})
+		subMerge, err = c.flattenEmbeddedMerge(subMerge)
+		if err != nil {
+			return nil, err
+		}
+
+		var arena fastjson.Arena
+		flattened[name] = arena.NewTrue()
+		for k, v := range subMerge {
+			flattened[k] = v
+		}
+	}
+	return flattened, nil
+}
+
+// validateEmbeddedFields returns an error if any embedded object field of the given schema
+// does not name its type or holds a relation, or if any field of an embedded object is not
+// held by an embedded object field.
+func validateEmbeddedFields(schema client.SchemaDescription) error {
+	fieldsByName := make(map[string]client.FieldDescription, len(schema.Fields))
+	for _, field := range schema.Fields {
+		fieldsByName[field.Name] = field
+	}
+
+	for _, field := range schema.Fields {
+		if field.IsEmbedded() && field.Schema == "" {
+			return NewErrInvalidEmbeddedField(field.Name)
+		}
+
+		if !field.IsEmbeddedField() {
+			continue
+		}
+		if field.IsObject() {
+			return NewErrInvalidEmbeddedField(field.Name)
+		}
+		hostName := field.Name[:strings.LastIndex(field.Name, client.EmbeddedFieldSeparator)]
+		if host, exists := fieldsByName[hostName]; !exists || !host.IsEmbedded() {
+			return NewErrEmbeddedFieldMissingHost(field.Name)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,89 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package db
+
+import (
+	"github.com/sourcenetwork/defradb/client"
+)
+
+// validateEnumValue returns an error if the given value of an enum field is not one of the
+// values of its enum.
+func (c *collection) validateEnumValue(field client.FieldDescription, value any) error {
+	enum, ok := c.desc.Schema.GetEnum(field.Schema)
+	if !ok {
+		return NewErrEnumNotFound(field.Name, field.Schema)
+	}
+
+	str, ok := value.(string)
+	if !ok || !enum.HasValue(str) {
+		return NewErrInvalidEnumValue(field.Name, enum.Name, value)
+	}
+	return nil
+}
+
+// validateEnums returns an error if the enums of the given proposed schema are not valid,
+// or remove any enum or enum value of the given existing schema.
+//
+// It returns true if the proposed enums differ from the existing ones.
+func validateEnums(existing client.SchemaDescription, proposed client.SchemaDescription) (bool, error) {
+	var hasChanged bool
+
+	names := map[string]struct{}{}
+	for _, enum := range proposed.Enums {
+		if _, isDuplicate := names[enum.Name]; isDuplicate {
+			return false, NewErrDuplicateEnum(enum.Name)
+		}
+		names[enum.Name] = struct{}{}
+
+		if len(enum.Values) == 0 {
+			return false, NewErrInvalidEnum(enum.Name)
+		}
+		values := map[string]struct{}{}
+		for _, value := range enum.Values {
+			if _, isDuplicate := values[value]; isDuplicate || value == "" {
+				return false, NewErrInvalidEnum(enum.Name)
+			}
+			values[value] = struct{}{}
+		}
+
+		existingEnum, exists := existing.GetEnum(enum.Name)
+		if !exists {
+			hasChanged = true
+			continue
+		}
+		if len(enum.Values) < len(existingEnum.Values) {
+			return false, NewErrCannotRemoveEnumValue(enum.Name)
+		}
+		for i, value := range existingEnum.Values {
+			if enum.Values[i] != value {
+				return false, NewErrCannotRemoveEnumValue(enum.Name)
+			}
+		}
+		hasChanged = hasChanged || len(enum.Values) != len(existingEnum.Values)
+	}
+
+	for _, enum := range existing.Enums {
+		if _, stillExists := names[enum.Name]; !stillExists {
+			return false, NewErrCannotRemoveEnumValue(enum.Name)
+		}
+	}
+
+	for _, field := range proposed.Fields {
+		if field.Kind != client.FieldKind_ENUM {
+			continue
+		}
+		if _, exists := names[field.Schema]; !exists {
+			return false, NewErrEnumNotFound(field.Name, field.Schema)
+		}
+	}
+
+	return hasChanged, nil
+}
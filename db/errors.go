@@ -32,9 +32,42 @@ const (
 	errDuplicateField                string = "duplicate field"
 	errCannotMutateField             string = "mutating an existing field is not supported"
 	errCannotMoveField               string = "moving fields is not currently supported"
-	errInvalidCRDTType               string = "only default or LWW (last writer wins) CRDT types are supported"
+	errInvalidCRDTType               string = "the CRDT type is not supported by fields"
 	errCannotDeleteField             string = "deleting an existing field is not supported"
 	errFieldKindNotFound             string = "no type found for given name"
+	errIndexMissingFields            string = "index must contain at least one field"
+	errIndexFieldNotFound            string = "indexed field does not exist"
+	errIndexFieldNotIndexable        string = "indexes are not supported for fields of the given kind"
+	errDuplicateIndexName            string = "duplicate index name"
+	errCannotModifyIndexes           string = "modifying the indexes of an existing collection is not supported"
+	errUniqueConstraintViolated      string = "a document with the same values for the unique fields already exists"
+	errInvalidFullTextIndex          string = "full-text indexes must be built from a single string field"
+	errInvalidVectorIndex            string = "vector indexes must be built from a single vector field, measured by the COSINE or L2 metric"
+	errInvalidVectorDimensions       string = "vector fields must have a positive number of dimensions"
+	errVectorDimensionsMismatch      string = "vector value does not have the number of dimensions of its field"
+	errInvalidBlobValue              string = "blob values must be base64 encoded strings"
+	errInvalidEnumValue              string = "value is not one of the values of the field's enum"
+	errEnumNotFound                  string = "no enum found for the given name"
+	errInvalidEnum                   string = "enums must have at least one value, and no duplicate values"
+	errDuplicateEnum                 string = "duplicate enum name"
+	errCannotRemoveEnumValue         string = "the values of an enum may be added to, but not removed or reordered"
+	errInvalidEmbeddedValue          string = "embedded object fields may only be set to objects or null"
+	errEmbeddedFieldMissingHost      string = "no embedded object field found for the given embedded field"
+	errInvalidEmbeddedField          string = "embedded object fields must name their type, and may not hold relations"
+	errInvalidDefaultValue           string = "default value is not a valid value of the field"
+	errRequiredFieldMissing          string = "a value must be given for the required field"
+	errInvalidRequiredField          string = "relation and embedded object fields may not be required"
+	errRequiredFieldWithoutDefault   string = "required fields added to an existing schema must have a default value"
+	errInvalidConstraint             string = "constraint is not valid for the field"
+	errConstraintsViolated           string = "the given values violate the constraints of their fields"
+	errInvalidCounterField           string = "PN counters may only be used by int and float fields"
+	errFieldNotCounter               string = "only PN counter fields may be incremented"
+	errInvalidIncrement              string = "increments must be numbers of the kind of their field"
+	errInvalidSetField               string = "OR sets may only be used by array fields"
+	errInvalidSetOperation           string = "OR set fields may only be updated by arrays, or objects of _add and _remove arrays"
+	errInvalidTextField              string = "text CRDTs may only be used by string fields"
+	errInvalidTextOperation          string = "text fields may only be updated by strings, or objects of _edit arrays"
+	errInvalidMVRegisterField        string = "MV registers may not be used by object fields"
 )
 
 var (
@@ -82,6 +115,41 @@ var (
 	ErrInvalidCRDTType          = errors.New(errInvalidCRDTType)
 	ErrCannotDeleteField        = errors.New(errCannotDeleteField)
 	ErrFieldKindNotFound        = errors.New(errFieldKindNotFound)
+	ErrIndexMissingFields       = errors.New(errIndexMissingFields)
+	ErrIndexFieldNotFound       = errors.New(errIndexFieldNotFound)
+	ErrIndexFieldNotIndexable   = errors.New(errIndexFieldNotIndexable)
+	ErrDuplicateIndexName       = errors.New(errDuplicateIndexName)
+	ErrCannotModifyIndexes      = errors.New(errCannotModifyIndexes)
+	// ErrUniqueConstraintViolated occurs when a document is written with the same values for the
+	// fields of a unique index as another document.
+	ErrUniqueConstraintViolated    = errors.New(errUniqueConstraintViolated)
+	ErrInvalidFullTextIndex        = errors.New(errInvalidFullTextIndex)
+	ErrInvalidVectorIndex          = errors.New(errInvalidVectorIndex)
+	ErrInvalidVectorDimensions     = errors.New(errInvalidVectorDimensions)
+	ErrVectorDimensionsMismatch    = errors.New(errVectorDimensionsMismatch)
+	ErrInvalidBlobValue            = errors.New(errInvalidBlobValue)
+	ErrInvalidEnumValue            = errors.New(errInvalidEnumValue)
+	ErrEnumNotFound                = errors.New(errEnumNotFound)
+	ErrInvalidEnum                 = errors.New(errInvalidEnum)
+	ErrDuplicateEnum               = errors.New(errDuplicateEnum)
+	ErrCannotRemoveEnumValue       = errors.New(errCannotRemoveEnumValue)
+	ErrInvalidEmbeddedValue        = errors.New(errInvalidEmbeddedValue)
+	ErrEmbeddedFieldMissingHost    = errors.New(errEmbeddedFieldMissingHost)
+	ErrInvalidEmbeddedField        = errors.New(errInvalidEmbeddedField)
+	ErrInvalidDefaultValue         = errors.New(errInvalidDefaultValue)
+	ErrRequiredFieldMissing        = errors.New(errRequiredFieldMissing)
+	ErrInvalidRequiredField        = errors.New(errInvalidRequiredField)
+	ErrRequiredFieldWithoutDefault = errors.New(errRequiredFieldWithoutDefault)
+	ErrInvalidConstraint           = errors.New(errInvalidConstraint)
+	ErrConstraintsViolated         = errors.New(errConstraintsViolated)
+	ErrInvalidCounterField         = errors.New(errInvalidCounterField)
+	ErrFieldNotCounter             = errors.New(errFieldNotCounter)
+	ErrInvalidIncrement            = errors.New(errInvalidIncrement)
+	ErrInvalidSetField             = errors.New(errInvalidSetField)
+	ErrInvalidSetOperation         = errors.New(errInvalidSetOperation)
+	ErrInvalidTextField            = errors.New(errInvalidTextField)
+	ErrInvalidTextOperation        = errors.New(errInvalidTextOperation)
+	ErrInvalidMVRegisterField      = errors.New(errInvalidMVRegisterField)
 )
 
 // NewErrFailedToGetHeads returns a new error indicating that the heads of a document
@@ -214,3 +282,201 @@ func NewErrCannotDeleteField(name string, id client.FieldID) error {
 		errors.NewKV("ID", id),
 	)
 }
+
+func NewErrIndexMissingFields(indexName string) error {
+	return errors.New(
+		errIndexMissingFields,
+		errors.NewKV("Index", indexName),
+	)
+}
+
+func NewErrIndexFieldNotFound(indexName string, fieldName string) error {
+	return errors.New(
+		errIndexFieldNotFound,
+		errors.NewKV("Index", indexName),
+		errors.NewKV("Field", fieldName),
+	)
+}
+
+func NewErrIndexFieldNotIndexable(indexName string, fieldName string, kind client.FieldKind) error {
+	return errors.New(
+		errIndexFieldNotIndexable,
+		errors.NewKV("Index", indexName),
+		errors.NewKV("Field", fieldName),
+		errors.NewKV("Kind", kind),
+	)
+}
+
+func NewErrDuplicateIndexName(indexName string) error {
+	return errors.New(
+		errDuplicateIndexName,
+		errors.NewKV("Index", indexName),
+	)
+}
+
+func NewErrCannotModifyIndexes(collectionName string) error {
+	return errors.New(
+		errCannotModifyIndexes,
+		errors.NewKV("Collection", collectionName),
+	)
+}
+
+func NewErrUniqueConstraintViolated(
+	indexName string,
+	fieldNames []string,
+	docKey string,
+	existingDocKey string,
+) error {
+	return errors.New(
+		errUniqueConstraintViolated,
+		errors.NewKV("Index", indexName),
+		errors.NewKV("Fields", fieldNames),
+		errors.NewKV("DocKey", docKey),
+		errors.NewKV("ExistingDocKey", existingDocKey),
+	)
+}
+
+func NewErrInvalidFullTextIndex(indexName string) error {
+	return errors.New(
+		errInvalidFullTextIndex,
+		errors.NewKV("Index", indexName),
+	)
+}
+
+func NewErrInvalidVectorIndex(indexName string) error {
+	return errors.New(
+		errInvalidVectorIndex,
+		errors.NewKV("Index", indexName),
+	)
+}
+
+func NewErrInvalidVectorDimensions(fieldName string, dimensions int) error {
+	return errors.New(
+		errInvalidVectorDimensions,
+		errors.NewKV("Field", fieldName),
+		errors.NewKV("Dimensions", dimensions),
+	)
+}
+
+func NewErrVectorDimensionsMismatch(fieldName string, expected int, actual int) error {
+	return errors.New(
+		errVectorDimensionsMismatch,
+		errors.NewKV("Field", fieldName),
+		errors.NewKV("Expected", expected),
+		errors.NewKV("Actual", actual),
+	)
+}
+
+func NewErrInvalidBlobValue(fieldName string, inner error) error {
+	return errors.Wrap(
+		errInvalidBlobValue,
+		inner,
+		errors.NewKV("Field", fieldName),
+	)
+}
+
+func NewErrInvalidEnumValue(fieldName string, enumName string, value any) error {
+	return errors.New(
+		errInvalidEnumValue,
+		errors.NewKV("Field", fieldName),
+		errors.NewKV("Enum", enumName),
+		errors.NewKV("Value", value),
+	)
+}
+
+func NewErrEnumNotFound(fieldName string, enumName string) error {
+	return errors.New(
+		errEnumNotFound,
+		errors.NewKV("Field", fieldName),
+		errors.NewKV("Enum", enumName),
+	)
+}
+
+func NewErrInvalidEnum(enumName string) error {
+	return errors.New(errInvalidEnum, errors.NewKV("Enum", enumName))
+}
+
+func NewErrDuplicateEnum(enumName string) error {
+	return errors.New(errDuplicateEnum, errors.NewKV("Enum", enumName))
+}
+
+func NewErrCannotRemoveEnumValue(enumName string) error {
+	return errors.New(errCannotRemoveEnumValue, errors.NewKV("Enum", enumName))
+}
+
+func NewErrInvalidEmbeddedValue(fieldName string, value any) error {
+	return errors.New(
+		errInvalidEmbeddedValue,
+		errors.NewKV("Field", fieldName),
+		errors.NewKV("Value", value),
+	)
+}
+
+func NewErrEmbeddedFieldMissingHost(fieldName string) error {
+	return errors.New(errEmbeddedFieldMissingHost, errors.NewKV("Field", fieldName))
+}
+
+func NewErrInvalidEmbeddedField(fieldName string) error {
+	return errors.New(errInvalidEmbeddedField, errors.NewKV("Field", fieldName))
+}
+
+func NewErrInvalidDefaultValue(fieldName string, value client.DefaultValue) error {
+	return errors.New(
+		errInvalidDefaultValue,
+		errors.NewKV("Field", fieldName),
+		errors.NewKV("Value", string(value)),
+	)
+}
+
+func NewErrRequiredFieldMissing(fieldName string) error {
+	return errors.New(errRequiredFieldMissing, errors.NewKV("Field", fieldName))
+}
+
+func NewErrInvalidRequiredField(fieldName string) error {
+	return errors.New(errInvalidRequiredField, errors.NewKV("Field", fieldName))
+}
+
+func NewErrRequiredFieldWithoutDefault(fieldName string) error {
+	return errors.New(errRequiredFieldWithoutDefault, errors.NewKV("Field", fieldName))
+}
+
+func NewErrInvalidConstraint(fieldName string) error {
+	return errors.New(errInvalidConstraint, errors.NewKV("Field", fieldName))
+}
+
+// NewErrConstraintsViolated returns an error listing every given constraint violation.
+func NewErrConstraintsViolated(violations []string) error {
+	return errors.New(errConstraintsViolated, errors.NewKV("Violations", violations))
+}
+
+func NewErrInvalidCounterField(fieldName string, kind client.FieldKind) error {
+	return errors.New(errInvalidCounterField, errors.NewKV("Field", fieldName), errors.NewKV("Kind", kind))
+}
+
+func NewErrFieldNotCounter(fieldName string) error {
+	return errors.New(errFieldNotCounter, errors.NewKV("Field", fieldName))
+}
+
+func NewErrInvalidIncrement(fieldName string, value any) error {
+	return errors.New(errInvalidIncrement, errors.NewKV("Field", fieldName), errors.NewKV("Value", value))
+}
+
+func NewErrInvalidSetField(fieldName string, kind client.FieldKind) error {
+	return errors.New(errInvalidSetField, errors.NewKV("Field", fieldName), errors.NewKV("Kind", kind))
+}
+
+func NewErrInvalidSetOperation(fieldName string, operation string) error {
+	return errors.New(errInvalidSetOperation, errors.NewKV("Field", fieldName), errors.NewKV("Operation", operation))
+}
+
+func NewErrInvalidTextField(fieldName string, kind client.FieldKind) error {
+	return errors.New(errInvalidTextField, errors.NewKV("Field", fieldName), errors.NewKV("Kind", kind))
+}
+
+func NewErrInvalidTextOperation(fieldName string, operation string) error {
+	return errors.New(errInvalidTextOperation, errors.NewKV("Field", fieldName), errors.NewKV("Operation", operation))
+}
+
+func NewErrInvalidMVRegisterField(fieldName string, kind client.FieldKind) error {
+	return errors.New(errInvalidMVRegisterField, errors.NewKV("Field", fieldName), errors.NewKV("Kind", kind))
+}
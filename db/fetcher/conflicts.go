@@ -0,0 +1,79 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package fetcher
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/sourcenetwork/defradb/client"
+	"github.com/sourcenetwork/defradb/client/request"
+	"github.com/sourcenetwork/defradb/core"
+	"github.com/sourcenetwork/defradb/datastore"
+	"github.com/sourcenetwork/defradb/db/base"
+	"github.com/sourcenetwork/defradb/events"
+	"github.com/sourcenetwork/defradb/merkle/crdt"
+)
+
+// FetchConflicts returns the values written concurrently to each MV register field of the
+// document of the given key that holds more than one value, keyed by field name.
+//
+// The values of each field are returned newest first, each with the CID of the block
+// writing it.
+func FetchConflicts(
+	ctx context.Context,
+	txn datastore.Txn,
+	col client.CollectionDescription,
+	docKey string,
+) (map[string]any, error) {
+	conflicts := map[string]any{}
+	for _, field := range col.Schema.Fields {
+		if field.Typ != client.MV_REGISTER {
+			continue
+		}
+
+		merkleCRDT, err := crdt.DefaultFactory.InstanceWithStores(
+			txn,
+			core.NewCollectionSchemaVersionKey(col.Schema.VersionID),
+			events.EmptyUpdateChannel,
+			client.MV_REGISTER,
+			base.MakeDocKey(col, docKey).WithFieldId(fmt.Sprint(field.ID)),
+		)
+		if err != nil {
+			return nil, err
+		}
+		values, err := merkleCRDT.(*crdt.MerkleMVRegister).Values(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if len(values) < 2 {
+			continue
+		}
+
+		fieldConflicts := make([]any, len(values))
+		for i, value := range values {
+			var val any
+			if len(value.Data) > 0 {
+				property := encProperty{Desc: field, Raw: append([]byte{byte(field.Typ)}, value.Data...)}
+				_, val, err = property.Decode()
+				if err != nil {
+					return nil, err
+				}
+			}
+			fieldConflicts[i] = map[string]any{
+				request.CidFieldName:       value.Cid.String(),
+				request.ConflictValueField: val,
+			}
+		}
+		conflicts[field.Name] = fieldConflicts
+	}
+	return conflicts, nil
+}
@@ -12,6 +12,8 @@ package fetcher
 
 import (
 	"fmt"
+	"math"
+	"reflect"
 
 	"github.com/fxamacker/cbor/v2"
 	"github.com/sourcenetwork/immutable"
@@ -31,16 +33,63 @@ type encProperty struct {
 	// encoding base.DataEncoding
 }
 
+// newDefaultProperty returns a property holding the default value of the given field,
+// encoded as it would be had the value been saved.
+func newDefaultProperty(field client.FieldDescription) (*encProperty, error) {
+	val, err := field.DefaultValue.Value()
+	if err != nil {
+		return nil, err
+	}
+
+	switch field.Kind {
+	case client.FieldKind_DECIMAL:
+		val, err = client.ParseDecimal(val)
+	case client.FieldKind_BIG_INT:
+		val, err = client.ParseBigInt(val)
+	default:
+		if number, isFloat := val.(float64); isFloat && float64(int64(number)) == number {
+			val = int64(number)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	buf, err := client.NewCBORValue(field.Typ, val).Bytes()
+	if err != nil {
+		return nil, err
+	}
+	return &encProperty{
+		Desc: field,
+		Raw:  append([]byte{byte(field.Typ)}, buf...),
+	}, nil
+}
+
 // Decode returns the decoded value and CRDT type for the given property.
 func (e encProperty) Decode() (client.CType, any, error) {
 	ctype := client.CType(e.Raw[0])
 	buf := e.Raw[1:]
+	if e.Desc.Kind == client.FieldKind_JSON {
+		val, err := decodeJSON(buf)
+		return ctype, val, err
+	}
+
 	var val any
 	err := cbor.Unmarshal(buf, &val)
 	if err != nil {
 		return ctype, nil, err
 	}
 
+	switch e.Desc.Kind {
+	case client.FieldKind_DECIMAL:
+		val, err = client.ParseDecimal(val)
+		return ctype, val, err
+
+	case client.FieldKind_BIG_INT:
+		val, err = client.ParseBigInt(val)
+		return ctype, val, err
+	}
+
 	if array, isArray := val.([]any); isArray {
 		var ok bool
 		switch e.Desc.Kind {
@@ -76,7 +125,7 @@ func (e encProperty) Decode() (client.CType, any, error) {
 				return ctype, nil, err
 			}
 
-		case client.FieldKind_FLOAT_ARRAY:
+		case client.FieldKind_FLOAT_ARRAY, client.FieldKind_FLOAT_VECTOR:
 			floatArray := make([]float64, len(array))
 			for i, untypedValue := range array {
 				floatArray[i], ok = untypedValue.(float64)
@@ -127,6 +176,42 @@ func (e encProperty) Decode() (client.CType, any, error) {
 	return ctype, val, nil
 }
 
+// jsonDecMode decodes CBOR maps into string keyed maps, as JSON objects may only be keyed
+// by strings.
+var jsonDecMode, _ = cbor.DecOptions{
+	DefaultMapType: reflect.TypeOf(map[string]any(nil)),
+}.DecMode()
+
+// decodeJSON decodes the given CBOR encoded JSON value.
+//
+// Integers are returned as int64, like those of the filters they are matched against.
+func decodeJSON(buf []byte) (any, error) {
+	var val any
+	err := jsonDecMode.Unmarshal(buf, &val)
+	if err != nil {
+		return nil, err
+	}
+	return normalizeJSON(val), nil
+}
+
+func normalizeJSON(val any) any {
+	switch v := val.(type) {
+	case map[string]any:
+		for key, item := range v {
+			v[key] = normalizeJSON(item)
+		}
+	case []any:
+		for i, item := range v {
+			v[i] = normalizeJSON(item)
+		}
+	case uint64:
+		if v <= math.MaxInt64 {
+			return int64(v)
+		}
+	}
+	return val
+}
+
 func convertNillableArray[T any](propertyName string, items []any) ([]immutable.Option[T], error) {
 	resultArray := make([]immutable.Option[T], len(items))
 	for i, untypedValue := range items {
@@ -51,6 +51,10 @@ type DocumentFetcher struct {
 	schemaFields map[uint32]client.FieldDescription
 	fields       []*client.FieldDescription
 
+	// defaultProperties holds the encoded default values of the fields that have one, and
+	// is read through for documents that have no value for those fields.
+	defaultProperties map[client.FieldDescription]*encProperty
+
 	doc         *encodedDocument
 	decodedDoc  *client.Document
 	initialized bool
@@ -119,8 +123,17 @@ func (df *DocumentFetcher) init(
 	df.kvIter = nil
 
 	df.schemaFields = make(map[uint32]client.FieldDescription)
+	df.defaultProperties = make(map[client.FieldDescription]*encProperty)
 	for _, field := range col.Schema.Fields {
 		df.schemaFields[uint32(field.ID)] = field
+
+		if field.DefaultValue.HasValue() {
+			property, err := newDefaultProperty(field)
+			if err != nil {
+				return err
+			}
+			df.defaultProperties[field] = property
+		}
 	}
 	return nil
 }
@@ -347,6 +360,16 @@ func (df *DocumentFetcher) processKV(kv *core.KeyValue) error {
 	return nil
 }
 
+// applyDefaultProperties sets the properties of the current document that have no value
+// to their default value, if they have one.
+func (df *DocumentFetcher) applyDefaultProperties() {
+	for field, property := range df.defaultProperties {
+		if _, exists := df.doc.Properties[field]; !exists {
+			df.doc.Properties[field] = property
+		}
+	}
+}
+
 // FetchNext returns a raw binary encoded document. It iterates over all the relevant
 // keypairs from the underlying store and constructs the document.
 func (df *DocumentFetcher) FetchNext(ctx context.Context) (*encodedDocument, error) {
@@ -375,6 +398,7 @@ func (df *DocumentFetcher) FetchNext(ctx context.Context) (*encodedDocument, err
 			return nil, err
 		}
 		if end {
+			df.applyDefaultProperties()
 			return df.doc, nil
 		}
 
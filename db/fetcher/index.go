@@ -0,0 +1,434 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package fetcher
+
+import (
+	"bytes"
+	"context"
+
+	ds "github.com/ipfs/go-datastore"
+	dsq "github.com/ipfs/go-datastore/query"
+
+	"github.com/sourcenetwork/defradb/client"
+	"github.com/sourcenetwork/defradb/core"
+	"github.com/sourcenetwork/defradb/datastore"
+	"github.com/sourcenetwork/defradb/datastore/iterable"
+	"github.com/sourcenetwork/defradb/db/base"
+)
+
+// IndexFetcher is a utility to fetch the documents found within the given spans of
+// a secondary index. Documents are yielded in the order of the index, or in the reverse
+// order if requested.
+//
+// Vector indexes are searched instead for the documents with the vectors nearest to a given
+// vector, which are yielded from the nearest to the furthest.
+//
+// If spans are provided on Start, the index will not be used and the fetcher will behave as
+// a [DocumentFetcher] over the given spans.
+type IndexFetcher struct {
+	docFetcher DocumentFetcher
+
+	col        *client.CollectionDescription
+	index      client.IndexDescription
+	indexSpans []core.IndexSpan
+	reverse    bool
+
+	txn       datastore.Txn
+	usesIndex bool
+
+	curSpanIndex int
+	indexIter    iterable.Iterator
+	indexResults dsq.Results
+
+	// fetchedDocKey holds the keys of the documents already yielded, if the same document
+	// may be found more than once, and is nil otherwise.
+	fetchedDocKey map[string]struct{}
+
+	// vector is the vector searched for within a vector index.
+	vector []float64
+	// minEf is the number of documents searched for at first within a vector index.
+	minEf int
+	// ef is the number of documents searched for by the last search of a vector index.
+	ef int
+	// nearest holds the DocKeys found by the last search of a vector index.
+	nearest      []string
+	nearestIndex int
+}
+
+// minVectorSearchEf is the minimum number of documents searched for at once within a
+// vector index, the more are searched for the more accurate the search is.
+const minVectorSearchEf = 64
+
+var _ Fetcher = (*IndexFetcher)(nil)
+
+// NewIndexFetcher returns a new fetcher that will fetch the documents found within the given
+// spans of the given index.
+func NewIndexFetcher(index client.IndexDescription, spans []core.IndexSpan) *IndexFetcher {
+	return &IndexFetcher{
+		index:      index,
+		indexSpans: spans,
+	}
+}
+
+// NewVectorIndexFetcher returns a new fetcher that will fetch the documents of the given vector
+// index with the vectors nearest to the given vector, from the nearest to the furthest.
+//
+// At least k documents are searched for at once.  If more documents are requested, the search
+// is repeated for twice as many documents until the documents of the index are exhausted.
+func NewVectorIndexFetcher(index client.IndexDescription, vector []float64, k uint64) *IndexFetcher {
+	minEf := minVectorSearchEf
+	if k > uint64(minEf) {
+		minEf = int(k)
+	}
+	return &IndexFetcher{
+		index:  index,
+		vector: vector,
+		minEf:  minEf,
+	}
+}
+
+// Init implements Fetcher.
+func (f *IndexFetcher) Init(
+	col *client.CollectionDescription,
+	fields []*client.FieldDescription,
+	reverse bool,
+	showDeleted bool,
+) error {
+	f.col = col
+	f.reverse = reverse
+	// Each document is fetched on its own, the order of the index is preserved regardless of
+	// the order in which the underlying fetcher scans the document.
+	return f.docFetcher.Init(col, fields, false, showDeleted)
+}
+
+// Start implements Fetcher.
+func (f *IndexFetcher) Start(ctx context.Context, txn datastore.Txn, spans core.Spans) error {
+	if spans.HasValue {
+		f.usesIndex = false
+		return f.docFetcher.Start(ctx, txn, spans)
+	}
+
+	err := f.closeIndexIter()
+	if err != nil {
+		return err
+	}
+
+	f.txn = txn
+	f.usesIndex = true
+	f.curSpanIndex = -1
+	if f.reverse {
+		f.curSpanIndex = len(f.indexSpans)
+	}
+	// A document has a single entry within a regular index, so it can only be found more than
+	// once if the spans overlap, by several terms of a full-text index, or by repeated searches
+	// of a vector index.
+	f.fetchedDocKey = nil
+	if len(f.indexSpans) > 1 || f.index.FullText || f.index.Vector {
+		f.fetchedDocKey = map[string]struct{}{}
+	}
+	f.nearest = nil
+	f.nearestIndex = 0
+	f.ef = f.minEf
+	return nil
+}
+
+// FetchNext implements Fetcher.
+func (f *IndexFetcher) FetchNext(ctx context.Context) (*encodedDocument, error) {
+	if !f.usesIndex {
+		return f.docFetcher.FetchNext(ctx)
+	}
+
+	for {
+		hasNext, err := f.startNextDoc(ctx)
+		if err != nil || !hasNext {
+			return nil, err
+		}
+
+		doc, err := f.docFetcher.FetchNext(ctx)
+		if err != nil || doc != nil {
+			return doc, err
+		}
+	}
+}
+
+// FetchNextDecoded implements Fetcher.
+func (f *IndexFetcher) FetchNextDecoded(ctx context.Context) (*client.Document, error) {
+	if !f.usesIndex {
+		return f.docFetcher.FetchNextDecoded(ctx)
+	}
+
+	for {
+		hasNext, err := f.startNextDoc(ctx)
+		if err != nil || !hasNext {
+			return nil, err
+		}
+
+		doc, err := f.docFetcher.FetchNextDecoded(ctx)
+		if err != nil || doc != nil {
+			return doc, err
+		}
+	}
+}
+
+// FetchNextDoc implements Fetcher.
+func (f *IndexFetcher) FetchNextDoc(
+	ctx context.Context,
+	mapping *core.DocumentMapping,
+) ([]byte, core.Doc, error) {
+	if !f.usesIndex {
+		return f.docFetcher.FetchNextDoc(ctx, mapping)
+	}
+
+	for {
+		hasNext, err := f.startNextDoc(ctx)
+		if err != nil || !hasNext {
+			return nil, core.Doc{}, err
+		}
+
+		key, doc, err := f.docFetcher.FetchNextDoc(ctx, mapping)
+		if err != nil || key != nil {
+			return key, doc, err
+		}
+	}
+}
+
+// Close implements Fetcher.
+func (f *IndexFetcher) Close() error {
+	err := f.closeIndexIter()
+	if err != nil {
+		return err
+	}
+	return f.docFetcher.Close()
+}
+
+// startNextDoc starts the underlying document fetcher on the next document found
+// within the index spans.
+//
+// Returns false if there are no more documents.
+func (f *IndexFetcher) startNextDoc(ctx context.Context) (bool, error) {
+	docKey, hasNext, err := f.nextDocKey(ctx)
+	if err != nil || !hasNext {
+		return false, err
+	}
+
+	key := base.MakeDocKey(*f.col, docKey)
+	err = f.docFetcher.Start(ctx, f.txn, core.NewSpans(core.NewSpan(key, key.PrefixEnd())))
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// nextDocKey returns the key of the next document found within the index spans.
+//
+// Each document key will only be returned once.
+func (f *IndexFetcher) nextDocKey(ctx context.Context) (string, bool, error) {
+	if f.index.Vector {
+		return f.nextNearestDocKey(ctx)
+	}
+
+	for {
+		if f.indexResults == nil {
+			hasNext, err := f.startNextIndexSpan(ctx)
+			if err != nil || !hasNext {
+				return "", false, err
+			}
+		}
+
+		res, hasNext := f.indexResults.NextSync()
+		if !hasNext {
+			err := f.closeIndexResults()
+			if err != nil {
+				return "", false, err
+			}
+			continue
+		}
+		if res.Error != nil {
+			return "", false, res.Error
+		}
+
+		key, err := core.NewIndexDataStoreKey(res.Key, len(f.index.Fields))
+		if err != nil {
+			return "", false, err
+		}
+
+		withinSpan, pastSpan := f.isWithinSpan(f.indexSpans[f.curSpanIndex], key)
+		if pastSpan {
+			err := f.closeIndexResults()
+			if err != nil {
+				return "", false, err
+			}
+			continue
+		}
+		if !withinSpan {
+			continue
+		}
+
+		docKey := key.DocKey
+		if docKey == "" {
+			// The entries of unique indexes may hold the DocKey as their value.
+			docKey = string(res.Value)
+		}
+
+		if f.fetchedDocKey != nil {
+			if _, alreadyFetched := f.fetchedDocKey[docKey]; alreadyFetched {
+				continue
+			}
+			f.fetchedDocKey[docKey] = struct{}{}
+		}
+
+		return docKey, true, nil
+	}
+}
+
+// nextNearestDocKey returns the key of the next nearest document found within the vector index.
+//
+// Each document key will only be returned once.
+func (f *IndexFetcher) nextNearestDocKey(ctx context.Context) (string, bool, error) {
+	for {
+		if f.nearestIndex < len(f.nearest) {
+			docKey := f.nearest[f.nearestIndex]
+			f.nearestIndex++
+			if _, alreadyFetched := f.fetchedDocKey[docKey]; alreadyFetched {
+				continue
+			}
+			f.fetchedDocKey[docKey] = struct{}{}
+			return docKey, true, nil
+		}
+
+		if f.nearest != nil {
+			if len(f.nearest) < f.ef {
+				// No more documents may be found.
+				return "", false, nil
+			}
+			f.ef *= 2
+		}
+
+		var err error
+		f.nearest, err = base.SearchVectorIndex(ctx, f.txn.Datastore(), *f.col, f.index, f.vector, f.ef)
+		if err != nil {
+			return "", false, err
+		}
+		if f.nearest == nil {
+			f.nearest = []string{}
+		}
+		f.nearestIndex = 0
+	}
+}
+
+// isWithinSpan returns true if the given entry is within the bounds of the given span. It also
+// returns true if the entry has been iterated past the end of the span.
+//
+// The entry is expected to be within the prefix of the span.
+func (f *IndexFetcher) isWithinSpan(span core.IndexSpan, key core.IndexDataStoreKey) (bool, bool) {
+	if len(key.FieldValues) <= len(span.Prefix) {
+		return true, false
+	}
+	value := key.FieldValues[len(span.Prefix)]
+
+	if span.Lower.HasValue() && bytes.Compare(value, span.Lower.Value()) < 0 {
+		return false, f.reverse
+	}
+	if span.Upper.HasValue() && bytes.Compare(value, span.Upper.Value()) > 0 {
+		return false, !f.reverse
+	}
+	return true, false
+}
+
+// startNextIndexSpan starts iterating through the entries of the next index span.
+//
+// Returns false if there are no more spans.
+func (f *IndexFetcher) startNextIndexSpan(ctx context.Context) (bool, error) {
+	nextSpanIndex := f.curSpanIndex + 1
+	if f.reverse {
+		nextSpanIndex = f.curSpanIndex - 1
+	}
+	if nextSpanIndex < 0 || nextSpanIndex >= len(f.indexSpans) {
+		return false, nil
+	}
+
+	if f.indexIter == nil {
+		order := []dsq.Order{dsq.OrderByKey{}}
+		if f.reverse {
+			order = []dsq.Order{dsq.OrderByKeyDescending{}}
+		}
+
+		var err error
+		f.indexIter, err = f.txn.Datastore().GetIterator(dsq.Query{
+			KeysOnly: !f.index.Unique,
+			Orders:   order,
+		})
+		if err != nil {
+			return false, err
+		}
+	}
+
+	start, end := f.spanBounds(f.indexSpans[nextSpanIndex])
+	if f.reverse {
+		// Descending iterators start from the upper bound of the range.
+		start, end = end, start
+	}
+	results, err := f.indexIter.IteratePrefix(ctx, start, end)
+	if err != nil {
+		return false, err
+	}
+
+	f.indexResults = results
+	f.curSpanIndex = nextSpanIndex
+	return true, nil
+}
+
+// spanBounds returns the keys of the first and last entries that may be found within the
+// given span.
+//
+// The range starts at the lower bound of the span, and ends after the last entry holding
+// its upper bound, so that the entries outside of the bounds of the span are not iterated.
+func (f *IndexFetcher) spanBounds(span core.IndexSpan) (ds.Key, ds.Key) {
+	start := core.IndexDataStoreKey{
+		CollectionID: f.col.IDString(),
+		IndexID:      f.index.IDString(),
+		FieldValues:  span.Prefix,
+	}
+	end := start
+
+	if span.Lower.HasValue() {
+		start.FieldValues = append(append([][]byte{}, span.Prefix...), span.Lower.Value())
+	}
+	if span.Upper.HasValue() {
+		end.FieldValues = append(append([][]byte{}, span.Prefix...), span.Upper.Value())
+	}
+
+	// The entries holding the last value are keyed by DocKey below it, KeyMax sorts after all
+	// of them.
+	return start.ToDS(), ds.NewKey(end.ToString() + "/" + string(core.KeyMax))
+}
+
+func (f *IndexFetcher) closeIndexIter() error {
+	err := f.closeIndexResults()
+	if err != nil {
+		return err
+	}
+	if f.indexIter == nil {
+		return nil
+	}
+	err = f.indexIter.Close()
+	f.indexIter = nil
+	return err
+}
+
+func (f *IndexFetcher) closeIndexResults() error {
+	if f.indexResults == nil {
+		return nil
+	}
+	err := f.indexResults.Close()
+	f.indexResults = nil
+	return err
+}
@@ -353,9 +353,11 @@ func (vf *VersionedFetcher) merge(c cid.Cid) error {
 		if fieldID == uint32(0) {
 			return client.NewErrFieldNotExist(l.Name)
 		}
-		// @todo: Right now we ONLY handle LWW_REGISTER, need to swith on this and
-		//        get CType from descriptions
-		if err := vf.processNode(fieldID, subNd, client.LWW_REGISTER, l.Name); err != nil {
+		field, exists := vf.col.GetField(l.Name)
+		if !exists {
+			return client.NewErrFieldNotExist(l.Name)
+		}
+		if err := vf.processNode(fieldID, subNd, field.Typ, l.Name); err != nil {
 			return err
 		}
 	}
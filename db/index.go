@@ -0,0 +1,147 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package db
+
+import (
+	"context"
+
+	"github.com/sourcenetwork/defradb/client"
+	"github.com/sourcenetwork/defradb/client/request"
+	"github.com/sourcenetwork/defradb/core"
+	"github.com/sourcenetwork/defradb/datastore"
+	"github.com/sourcenetwork/defradb/db/base"
+)
+
+// initIndexes validates the secondary indexes of the given collection description,
+// and assigns their IDs.
+func initIndexes(desc *client.CollectionDescription) error {
+	indexNames := map[string]struct{}{}
+	for i, index := range desc.Indexes {
+		if _, isDuplicate := indexNames[index.Name]; isDuplicate {
+			return NewErrDuplicateIndexName(index.Name)
+		}
+		indexNames[index.Name] = struct{}{}
+
+		if len(index.Fields) == 0 {
+			return NewErrIndexMissingFields(index.Name)
+		}
+
+		if index.FullText {
+			if len(index.Fields) != 1 || index.Unique {
+				return NewErrInvalidFullTextIndex(index.Name)
+			}
+			field, exists := desc.GetField(index.Fields[0].Name)
+			if exists && field.Kind != client.FieldKind_STRING {
+				return NewErrInvalidFullTextIndex(index.Name)
+			}
+		}
+
+		if index.Vector || index.Metric != "" {
+			if !index.Vector || len(index.Fields) != 1 || index.Unique || index.FullText {
+				return NewErrInvalidVectorIndex(index.Name)
+			}
+			if index.Metric != string(request.CosineMetric) && index.Metric != string(request.L2Metric) {
+				return NewErrInvalidVectorIndex(index.Name)
+			}
+			field, exists := desc.GetField(index.Fields[0].Name)
+			if exists && field.Kind != client.FieldKind_FLOAT_VECTOR {
+				return NewErrInvalidVectorIndex(index.Name)
+			}
+		}
+
+		for _, indexedField := range index.Fields {
+			field, exists := desc.GetField(indexedField.Name)
+			if !exists {
+				return NewErrIndexFieldNotFound(index.Name, indexedField.Name)
+			}
+			if !base.IsIndexableKind(field.Kind) && !index.Vector {
+				return NewErrIndexFieldNotIndexable(index.Name, field.Name, field.Kind)
+			}
+		}
+
+		desc.Indexes[i].ID = uint32(i + 1)
+	}
+	return nil
+}
+
+// getIndexEntries returns the secondary index entries of the given document, built from
+// its currently stored values.
+func (c *collection) getIndexEntries(
+	ctx context.Context,
+	txn datastore.Txn,
+	docKey string,
+) ([]core.IndexDataStoreKey, error) {
+	return base.GetIndexEntries(ctx, txn.Datastore(), c.desc, docKey)
+}
+
+// updateIndexEntries rebuilds the secondary index entries of the given document from its
+// currently stored values, replacing the given existing entries.
+//
+// An error will be returned if the document violates the constraint of a unique index, in
+// which case the transaction must not be committed.
+func (c *collection) updateIndexEntries(
+	ctx context.Context,
+	txn datastore.Txn,
+	docKey string,
+	existingEntries []core.IndexDataStoreKey,
+) error {
+	newEntries, err := c.getIndexEntries(ctx, txn, docKey)
+	if err != nil {
+		return err
+	}
+
+	conflicts, err := base.UpdateIndexEntries(ctx, txn.Datastore(), c.desc, existingEntries, newEntries)
+	if err != nil {
+		return err
+	}
+	if len(conflicts) > 0 {
+		conflict := conflicts[0]
+		fieldNames := make([]string, len(conflict.Index.Fields))
+		for i, field := range conflict.Index.Fields {
+			fieldNames[i] = field.Name
+		}
+		return NewErrUniqueConstraintViolated(conflict.Index.Name, fieldNames, docKey, conflict.DocKey)
+	}
+
+	return nil
+}
+
+// GetUniqueConflicts returns the conflicts between documents holding the same values for the
+// fields of a unique index.
+func (c *collection) GetUniqueConflicts(ctx context.Context) ([]client.UniqueConflict, error) {
+	txn, err := c.getTxn(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+	defer c.discardImplicitTxn(ctx, txn)
+
+	keys, err := base.GetIndexConflicts(ctx, txn.Datastore(), c.desc)
+	if err != nil {
+		return nil, err
+	}
+
+	conflicts := make([]client.UniqueConflict, 0, len(keys))
+	for _, key := range keys {
+		var indexName string
+		for _, index := range c.desc.Indexes {
+			if index.IDString() == key.IndexID {
+				indexName = index.Name
+				break
+			}
+		}
+		conflicts = append(conflicts, client.UniqueConflict{
+			IndexName:         indexName,
+			DocKey:            key.DocKey,
+			ConflictingDocKey: key.ConflictingDocKey,
+		})
+	}
+	return conflicts, c.commitImplicitTxn(ctx, txn)
+}
@@ -0,0 +1,57 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package db
+
+import (
+	"github.com/sourcenetwork/defradb/client"
+)
+
+// validateRequiredFields returns an error if any required field of the given document has
+// no value.
+//
+// Documents being created must hold a value for each required field, whereas documents being
+// updated may only not clear the value of one.
+func (c *collection) validateRequiredFields(doc *client.Document, isCreate bool) error {
+	fields := doc.Fields()
+	for _, fieldDesc := range c.desc.Schema.Fields {
+		if !fieldDesc.IsRequired {
+			continue
+		}
+
+		field, isSet := fields[fieldDesc.Name]
+		if !isSet {
+			if isCreate {
+				return NewErrRequiredFieldMissing(fieldDesc.Name)
+			}
+			continue
+		}
+
+		val, err := doc.GetValueWithField(field)
+		if err != nil {
+			return err
+		}
+		if val.IsDelete() || val.Value() == nil {
+			return NewErrRequiredFieldMissing(fieldDesc.Name)
+		}
+	}
+	return nil
+}
+
+// validateRequiredFieldKinds returns an error if any required field of the given schema is
+// a relation or embedded object field, or a field of an embedded object.
+func validateRequiredFieldKinds(schema client.SchemaDescription) error {
+	for _, field := range schema.Fields {
+		if field.IsRequired && (field.IsObject() || field.IsEmbedded() || field.IsEmbeddedField()) {
+			return NewErrInvalidRequiredField(field.Name)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,190 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package db
+
+import (
+	"context"
+	"reflect"
+
+	cbor "github.com/fxamacker/cbor/v2"
+	ipld "github.com/ipfs/go-ipld-format"
+	"github.com/valyala/fastjson"
+
+	"github.com/sourcenetwork/defradb/client"
+	"github.com/sourcenetwork/defradb/core"
+	"github.com/sourcenetwork/defradb/datastore"
+	"github.com/sourcenetwork/defradb/merkle/crdt"
+)
+
+const (
+	setAddOperation    = "_add"
+	setRemoveOperation = "_remove"
+)
+
+// isSetKind returns true if fields of the given kind may be held by OR sets.
+func isSetKind(kind client.FieldKind) bool {
+	switch kind {
+	case client.FieldKind_BOOL_ARRAY,
+		client.FieldKind_INT_ARRAY,
+		client.FieldKind_FLOAT_ARRAY,
+		client.FieldKind_STRING_ARRAY,
+		client.FieldKind_NILLABLE_BOOL_ARRAY,
+		client.FieldKind_NILLABLE_INT_ARRAY,
+		client.FieldKind_NILLABLE_FLOAT_ARRAY,
+		client.FieldKind_NILLABLE_STRING_ARRAY:
+		return true
+	default:
+		return false
+	}
+}
+
+// normalizeSetValue returns the elements of the given array value of the given OR set field,
+// with each number held as the type of the kind of the field, so that equal elements are
+// encoded identically.
+func normalizeSetValue(field client.FieldDescription, value any) (any, error) {
+	if value == nil {
+		return nil, nil
+	}
+
+	array := reflect.ValueOf(value)
+	if array.Kind() != reflect.Slice {
+		return nil, client.NewErrUnexpectedType[[]any](field.Name, value)
+	}
+
+	elements := make([]any, array.Len())
+	for i := range elements {
+		element := array.Index(i)
+		for element.Kind() == reflect.Interface || element.Kind() == reflect.Pointer {
+			element = element.Elem()
+		}
+		if !element.IsValid() {
+			// Nil elements of nillable arrays are kept as nil.
+			continue
+		}
+
+		switch field.Kind {
+		case client.FieldKind_INT_ARRAY, client.FieldKind_NILLABLE_INT_ARRAY:
+			switch {
+			case element.CanInt():
+				elements[i] = element.Int()
+			case element.CanUint():
+				elements[i] = int64(element.Uint())
+			case element.CanFloat():
+				elements[i] = int64(element.Float())
+			default:
+				return nil, client.NewErrUnexpectedType[int64](field.Name, element.Interface())
+			}
+
+		case client.FieldKind_FLOAT_ARRAY, client.FieldKind_NILLABLE_FLOAT_ARRAY:
+			switch {
+			case element.CanInt():
+				elements[i] = float64(element.Int())
+			case element.CanUint():
+				elements[i] = float64(element.Uint())
+			case element.CanFloat():
+				elements[i] = element.Float()
+			default:
+				return nil, client.NewErrUnexpectedType[float64](field.Name, element.Interface())
+			}
+
+		default:
+			elements[i] = element.Interface()
+		}
+	}
+	return elements, nil
+}
+
+// updateSet adds the elements of the _add array of the given operation to the OR set of the
+// given field key, and removes the elements of its _remove array, returning the block of the
+// update and the resulting elements of the set.
+func (c *collection) updateSet(
+	ctx context.Context,
+	txn datastore.Txn,
+	key core.DataStoreKey,
+	field client.FieldDescription,
+	operation *fastjson.Value,
+) (ipld.Node, any, error) {
+	var added, removed any
+	var err error
+	operation.GetObject().Visit(func(k []byte, v *fastjson.Value) {
+		if err != nil {
+			return
+		}
+		var elements any
+		switch string(k) {
+		case setAddOperation:
+			elements, err = setOperationElements(field, string(k), v)
+			added = elements
+		case setRemoveOperation:
+			elements, err = setOperationElements(field, string(k), v)
+			removed = elements
+		default:
+			err = NewErrInvalidSetOperation(field.Name, string(k))
+		}
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	addedBuf, err := cbor.Marshal(added)
+	if err != nil {
+		return nil, nil, err
+	}
+	removedBuf, err := cbor.Marshal(removed)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	merkleCRDT, err := c.db.crdtFactory.InstanceWithStores(
+		txn,
+		core.NewCollectionSchemaVersionKey(c.Schema().VersionID),
+		c.db.events.Updates,
+		client.OR_SET,
+		key,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+	set := merkleCRDT.(*crdt.MerkleORSet)
+
+	node, _, err := set.Update(c.db.commitContext(ctx), addedBuf, removedBuf)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	buf, err := set.Value(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	var value []any
+	err = cbor.Unmarshal(buf, &value)
+	if err != nil {
+		return nil, nil, err
+	}
+	return node, value, nil
+}
+
+// setOperationElements returns the normalized elements of the given array of the given
+// operation of an OR set field.
+func setOperationElements(
+	field client.FieldDescription,
+	operation string,
+	val *fastjson.Value,
+) (any, error) {
+	if val.Type() != fastjson.TypeArray {
+		return nil, NewErrInvalidSetOperation(field.Name, operation)
+	}
+	elements, err := validateFieldSchema(val, field)
+	if err != nil {
+		return nil, err
+	}
+	return normalizeSetValue(field, elements)
+}
@@ -0,0 +1,134 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package db
+
+import (
+	"context"
+
+	cbor "github.com/fxamacker/cbor/v2"
+	ipld "github.com/ipfs/go-ipld-format"
+	"github.com/valyala/fastjson"
+
+	"github.com/sourcenetwork/defradb/client"
+	"github.com/sourcenetwork/defradb/core"
+	corecrdt "github.com/sourcenetwork/defradb/core/crdt"
+	"github.com/sourcenetwork/defradb/datastore"
+	"github.com/sourcenetwork/defradb/merkle/crdt"
+)
+
+const (
+	textEditOperation = "_edit"
+	textEditPosition  = "position"
+	textEditInsert    = "insert"
+	textEditDelete    = "delete"
+)
+
+// editText applies the edits of the _edit array of the given operation to the text of the
+// given field key, returning the block of the edit and the resulting text.
+//
+// Each edit deletes the given number of characters from its position, and then inserts the
+// given text at it, with positions counted in the text produced by the edits before it.
+func (c *collection) editText(
+	ctx context.Context,
+	txn datastore.Txn,
+	key core.DataStoreKey,
+	field client.FieldDescription,
+	operation *fastjson.Value,
+) (ipld.Node, any, error) {
+	var edits []corecrdt.TextEdit
+	var err error
+	operation.GetObject().Visit(func(k []byte, v *fastjson.Value) {
+		if err != nil {
+			return
+		}
+		if string(k) != textEditOperation {
+			err = NewErrInvalidTextOperation(field.Name, string(k))
+			return
+		}
+		edits, err = textEdits(field, v)
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	merkleCRDT, err := c.db.crdtFactory.InstanceWithStores(
+		txn,
+		core.NewCollectionSchemaVersionKey(c.Schema().VersionID),
+		c.db.events.Updates,
+		client.RGA_TEXT,
+		key,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+	text := merkleCRDT.(*crdt.MerkleRGAText)
+
+	node, _, err := text.Edit(c.db.commitContext(ctx), edits)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	buf, err := text.Value(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	var value string
+	err = cbor.Unmarshal(buf, &value)
+	if err != nil {
+		return nil, nil, err
+	}
+	return node, value, nil
+}
+
+// textEdits returns the edits of the given _edit array of a text field.
+func textEdits(field client.FieldDescription, val *fastjson.Value) ([]corecrdt.TextEdit, error) {
+	values, err := val.Array()
+	if err != nil {
+		return nil, NewErrInvalidTextOperation(field.Name, textEditOperation)
+	}
+
+	edits := make([]corecrdt.TextEdit, len(values))
+	for i, value := range values {
+		object, err := value.Object()
+		if err != nil {
+			return nil, NewErrInvalidTextOperation(field.Name, textEditOperation)
+		}
+
+		object.Visit(func(k []byte, v *fastjson.Value) {
+			if err != nil {
+				return
+			}
+			switch string(k) {
+			case textEditPosition:
+				edits[i].Position, err = v.Int()
+			case textEditDelete:
+				edits[i].Delete, err = v.Int()
+			case textEditInsert:
+				var insert []byte
+				insert, err = v.StringBytes()
+				edits[i].Insert = string(insert)
+			default:
+				err = NewErrInvalidTextOperation(field.Name, string(k))
+				return
+			}
+			if err != nil {
+				err = NewErrInvalidTextOperation(field.Name, string(k))
+			}
+		})
+		if err != nil {
+			return nil, err
+		}
+		if edits[i].Delete < 0 {
+			return nil, NewErrInvalidTextOperation(field.Name, textEditDelete)
+		}
+	}
+	return edits, nil
+}
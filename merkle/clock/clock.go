@@ -36,20 +36,27 @@ type MerkleClock struct {
 	// dagSyncer
 	headset *heads
 	crdt    core.ReplicatedData
+	// hlc is the hybrid logical clock observing the timestamps of the deltas, if any.
+	hlc *core.HLC
 }
 
 // NewMerkleClock returns a new MerkleClock.
+//
+// The timestamps of the deltas processed are observed by the given hybrid logical clock, or
+// are not observed if it is nil.
 func NewMerkleClock(
 	headstore datastore.DSReaderWriter,
 	dagstore datastore.DAGStore,
 	namespace core.HeadStoreKey,
 	crdt core.ReplicatedData,
+	hlc *core.HLC,
 ) core.MerkleClock {
 	return &MerkleClock{
 		headstore: headstore,
 		dagstore:  dagstore,
 		headset:   NewHeadSet(headstore, namespace),
 		crdt:      crdt,
+		hlc:       hlc,
 	}
 }
 
@@ -102,6 +109,10 @@ func (mc *MerkleClock) AddDAGNode(
 
 	delta.SetPriority(height)
 
+	if metadataDelta, ok := delta.(core.MetadataDelta); ok {
+		metadataDelta.SetMetadata(core.NewCommitMetadata(ctx))
+	}
+
 	// write the delta and heads to a new block
 	nd, err := mc.putBlock(ctx, heads, height, delta)
 	if err != nil {
@@ -133,6 +144,17 @@ func (mc *MerkleClock) ProcessNode(
 ) ([]cid.Cid, error) {
 	current := node.Cid()
 	log.Debug(ctx, "Running ProcessNode", logging.NewKV("CID", current))
+	// keep the local clock ahead of the timestamps of the deltas it has seen,
+	// so that deltas created after them are ordered after them, rejecting the deltas
+	// timestamped too far ahead of the physical time
+	timestamped, ok := delta.(core.TimestampedDelta)
+	if ok && mc.hlc != nil && timestamped.GetTimestamp() != 0 {
+		err := mc.hlc.Observe(timestamped.GetTimestamp())
+		if err != nil {
+			return nil, NewErrMergingDelta(current, err)
+		}
+	}
+
 	err := mc.crdt.Merge(ctx, delta, dshelp.MultihashToDsKey(current.Hash()).String())
 	if err != nil {
 		return nil, NewErrMergingDelta(current, err)
@@ -29,6 +29,7 @@ var (
 			mstore datastore.MultiStore,
 			schemaID core.CollectionSchemaVersionKey,
 			uCh events.UpdateChannel,
+			hlc *core.HLC,
 		) MerkleCRDTInitFn {
 			return func(key core.DataStoreKey) MerkleCRDT {
 				return NewMerkleCompositeDAG(
@@ -39,6 +40,7 @@ var (
 					uCh,
 					core.DataStoreKey{},
 					key,
+					hlc,
 				)
 			}
 		},
@@ -57,10 +59,14 @@ type MerkleCompositeDAG struct {
 	*baseMerkleCRDT
 	// core.ReplicatedData
 	reg corecrdt.CompositeDAG
+	// hlc is the hybrid logical clock timestamping the deltas of the CompositeDAG, if they are
+	// timestamped, and observing the timestamps of the deltas it processes.
+	hlc *core.HLC
 }
 
 // NewMerkleCompositeDAG creates a new instance (or loaded from DB) of a MerkleCRDT
-// backed by a CompositeDAG CRDT.
+// backed by a CompositeDAG CRDT, observing the timestamps of its deltas with the given
+// hybrid logical clock.
 func NewMerkleCompositeDAG(
 	datastore datastore.DSReaderWriter,
 	headstore datastore.DSReaderWriter,
@@ -69,6 +75,7 @@ func NewMerkleCompositeDAG(
 	uCh events.UpdateChannel,
 	ns,
 	key core.DataStoreKey,
+	hlc *core.HLC,
 ) *MerkleCompositeDAG {
 	compositeDag := corecrdt.NewCompositeDAG(
 		datastore,
@@ -77,15 +84,22 @@ func NewMerkleCompositeDAG(
 		key, /* stuff like namespace and ID */
 	)
 
-	clock := clock.NewMerkleClock(headstore, dagstore, key.ToHeadStoreKey(), compositeDag)
+	clock := clock.NewMerkleClock(headstore, dagstore, key.ToHeadStoreKey(), compositeDag, hlc)
 	base := &baseMerkleCRDT{clock: clock, crdt: compositeDag, updateChannel: uCh}
 
 	return &MerkleCompositeDAG{
 		baseMerkleCRDT: base,
 		reg:            compositeDag,
+		hlc:            hlc,
 	}
 }
 
+// WithTimestamps makes the CompositeDAG timestamp the deltas it creates.
+func (m *MerkleCompositeDAG) WithTimestamps() *MerkleCompositeDAG {
+	m.reg = m.reg.WithTimestamps(m.hlc)
+	return m
+}
+
 // Delete sets the values of CompositeDAG for a delete.
 func (m *MerkleCompositeDAG) Delete(
 	ctx context.Context,
@@ -20,12 +20,14 @@ import (
 // MerkleCRDTInitFn instantiates a MerkleCRDT with a given key.
 type MerkleCRDTInitFn func(core.DataStoreKey) MerkleCRDT
 
-// MerkleCRDTFactory instantiates a MerkleCRDTInitFn with a MultiStore.
+// MerkleCRDTFactory instantiates a MerkleCRDTInitFn with a MultiStore and the hybrid
+// logical clock timestamping the deltas, which may be nil if the CRDTs do not create deltas.
 // Returns a MerkleCRDTInitFn with all the necessary stores set.
 type MerkleCRDTFactory func(
 	mstore datastore.MultiStore,
 	schemaVersionKey core.CollectionSchemaVersionKey,
 	uCh events.UpdateChannel,
+	hlc *core.HLC,
 ) MerkleCRDTInitFn
 
 // Factory is a helper utility for instantiating new MerkleCRDTs.
@@ -34,6 +36,7 @@ type MerkleCRDTFactory func(
 type Factory struct {
 	crdts      map[client.CType]*MerkleCRDTFactory
 	multistore datastore.MultiStore
+	hlc        *core.HLC
 }
 
 var (
@@ -73,7 +76,7 @@ func (factory Factory) Instance(
 	if err != nil {
 		return nil, err
 	}
-	return (*fn)(factory, schemaVersionKey, uCh)(key), nil
+	return (*fn)(factory, schemaVersionKey, uCh, factory.hlc)(key), nil
 }
 
 // InstanceWithStore executes the registered factory function for the given MerkleCRDT type
@@ -90,7 +93,7 @@ func (factory Factory) InstanceWithStores(
 		return nil, err
 	}
 
-	return (*fn)(store, schemaVersionKey, uCh)(key), nil
+	return (*fn)(store, schemaVersionKey, uCh, factory.hlc)(key), nil
 }
 
 func (factory Factory) getRegisteredFactory(t client.CType) (*MerkleCRDTFactory, error) {
@@ -113,6 +116,13 @@ func (factory Factory) WithStores(multistore datastore.MultiStore) Factory {
 	return factory
 }
 
+// WithHLC returns a new instance of the Factory timestamping deltas with the given hybrid
+// logical clock, and observing the timestamps of the deltas it processes with it.
+func (factory Factory) WithHLC(hlc *core.HLC) Factory {
+	factory.hlc = hlc
+	return factory
+}
+
 // Rootstore implements MultiStore.
 func (factory Factory) Rootstore() datastore.DSReaderWriter {
 	return nil
@@ -25,7 +25,12 @@ import (
 
 var (
 	lwwFactoryFn = MerkleCRDTFactory(
-		func(mstore datastore.MultiStore, schemaID core.CollectionSchemaVersionKey, _ events.UpdateChannel) MerkleCRDTInitFn {
+		func(
+			mstore datastore.MultiStore,
+			schemaID core.CollectionSchemaVersionKey,
+			_ events.UpdateChannel,
+			_ *core.HLC,
+		) MerkleCRDTInitFn {
 			return func(key core.DataStoreKey) MerkleCRDT {
 				return NewMerkleLWWRegister(
 					mstore.Datastore(),
@@ -38,6 +43,26 @@ var (
 			}
 		},
 	)
+	lwwHLCFactoryFn = MerkleCRDTFactory(
+		func(
+			mstore datastore.MultiStore,
+			schemaID core.CollectionSchemaVersionKey,
+			_ events.UpdateChannel,
+			hlc *core.HLC,
+		) MerkleCRDTInitFn {
+			return func(key core.DataStoreKey) MerkleCRDT {
+				return NewMerkleHLCLWWRegister(
+					mstore.Datastore(),
+					mstore.Headstore(),
+					mstore.DAGstore(),
+					schemaID,
+					core.DataStoreKey{},
+					key,
+					hlc,
+				)
+			}
+		},
+	)
 )
 
 func init() {
@@ -45,6 +70,10 @@ func init() {
 	if err != nil {
 		panic(err)
 	}
+	err = DefaultFactory.Register(client.LWW_HLC_REGISTER, &lwwHLCFactoryFn)
+	if err != nil {
+		panic(err)
+	}
 }
 
 // MerkleLWWRegister is a MerkleCRDT implementation of the LWWRegister using MerkleClocks.
@@ -65,7 +94,31 @@ func NewMerkleLWWRegister(
 	ns, key core.DataStoreKey,
 ) *MerkleLWWRegister {
 	register := corecrdt.NewLWWRegister(datastore, schemaVersionKey, key /* stuff like namespace and ID */)
-	clk := clock.NewMerkleClock(headstore, dagstore, key.ToHeadStoreKey(), register)
+	return newMerkleLWWRegister(headstore, dagstore, key, register, nil)
+}
+
+// NewMerkleHLCLWWRegister creates a new instance (or loaded from DB) of a MerkleCRDT
+// backed by a LWWRegister CRDT ordering its values by the time of the given hybrid logical clock.
+func NewMerkleHLCLWWRegister(
+	datastore datastore.DSReaderWriter,
+	headstore datastore.DSReaderWriter,
+	dagstore datastore.DAGStore,
+	schemaVersionKey core.CollectionSchemaVersionKey,
+	ns, key core.DataStoreKey,
+	hlc *core.HLC,
+) *MerkleLWWRegister {
+	register := corecrdt.NewHLCLWWRegister(datastore, schemaVersionKey, key, hlc)
+	return newMerkleLWWRegister(headstore, dagstore, key, register, hlc)
+}
+
+func newMerkleLWWRegister(
+	headstore datastore.DSReaderWriter,
+	dagstore datastore.DAGStore,
+	key core.DataStoreKey,
+	register corecrdt.LWWRegister,
+	hlc *core.HLC,
+) *MerkleLWWRegister {
+	clk := clock.NewMerkleClock(headstore, dagstore, key.ToHeadStoreKey(), register, hlc)
 
 	// newBaseMerkleCRDT(clock, register)
 	base := &baseMerkleCRDT{clock: clk, crdt: register}
@@ -35,9 +35,22 @@ type MerkleCRDT interface {
 	Clock() core.MerkleClock
 }
 
+// FieldMerkleCRDT is a MerkleCRDT holding the value of a document field, which may be set
+// to a given CBOR encoded value.
+type FieldMerkleCRDT interface {
+	MerkleCRDT
+	Set(ctx context.Context, value []byte) (ipld.Node, uint64, error)
+}
+
 var (
 	// defaultMerkleCRDTs                     = make(map[Type]MerkleCRDTFactory)
 	_ core.ReplicatedData = (*baseMerkleCRDT)(nil)
+
+	_ FieldMerkleCRDT = (*MerkleLWWRegister)(nil)
+	_ FieldMerkleCRDT = (*MerklePNCounter)(nil)
+	_ FieldMerkleCRDT = (*MerkleORSet)(nil)
+	_ FieldMerkleCRDT = (*MerkleRGAText)(nil)
+	_ FieldMerkleCRDT = (*MerkleMVRegister)(nil)
 )
 
 // baseMerkleCRDT handles the MerkleCRDT overhead functions that aren't CRDT specific like the mutations and state
@@ -0,0 +1,141 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package crdt
+
+import (
+	"context"
+
+	dshelp "github.com/ipfs/boxo/datastore/dshelp"
+	"github.com/ipfs/go-cid"
+	ds "github.com/ipfs/go-datastore"
+	ipld "github.com/ipfs/go-ipld-format"
+
+	"github.com/sourcenetwork/defradb/client"
+	"github.com/sourcenetwork/defradb/core"
+	corecrdt "github.com/sourcenetwork/defradb/core/crdt"
+	"github.com/sourcenetwork/defradb/datastore"
+	"github.com/sourcenetwork/defradb/events"
+	"github.com/sourcenetwork/defradb/merkle/clock"
+)
+
+var (
+	mvRegisterFactoryFn = MerkleCRDTFactory(
+		func(
+			mstore datastore.MultiStore,
+			schemaID core.CollectionSchemaVersionKey,
+			_ events.UpdateChannel,
+			_ *core.HLC,
+		) MerkleCRDTInitFn {
+			return func(key core.DataStoreKey) MerkleCRDT {
+				return NewMerkleMVRegister(
+					mstore.Datastore(),
+					mstore.Headstore(),
+					mstore.DAGstore(),
+					schemaID,
+					key,
+				)
+			}
+		},
+	)
+)
+
+func init() {
+	err := DefaultFactory.Register(client.MV_REGISTER, &mvRegisterFactoryFn)
+	if err != nil {
+		panic(err)
+	}
+}
+
+// MerkleMVRegister is a MerkleCRDT implementation of the MVRegister using MerkleClocks.
+type MerkleMVRegister struct {
+	*baseMerkleCRDT
+
+	reg corecrdt.MVRegister
+}
+
+// NewMerkleMVRegister creates a new instance (or loaded from DB) of a MerkleCRDT
+// backed by a MVRegister CRDT.
+func NewMerkleMVRegister(
+	datastore datastore.DSReaderWriter,
+	headstore datastore.DSReaderWriter,
+	dagstore datastore.DAGStore,
+	schemaVersionKey core.CollectionSchemaVersionKey,
+	key core.DataStoreKey,
+) *MerkleMVRegister {
+	isMerged := func(ctx context.Context, id string) (bool, error) {
+		// Blocks are put into the DAG store as they are merged.
+		hash, err := dshelp.DsKeyToMultihash(ds.NewKey(id))
+		if err != nil {
+			return false, err
+		}
+		return dagstore.Has(ctx, cid.NewCidV1(cid.DagProtobuf, hash))
+	}
+	reg := corecrdt.NewMVRegister(datastore, schemaVersionKey, key, isMerged)
+	clk := clock.NewMerkleClock(headstore, dagstore, key.ToHeadStoreKey(), reg, nil)
+	base := &baseMerkleCRDT{clock: clk, crdt: reg}
+	return &MerkleMVRegister{
+		baseMerkleCRDT: base,
+		reg:            reg,
+	}
+}
+
+// Set sets the value of the register, replacing all of its current values.
+func (mmvr *MerkleMVRegister) Set(ctx context.Context, value []byte) (ipld.Node, uint64, error) {
+	delta, err := mmvr.reg.Set(ctx, value)
+	if err != nil {
+		return nil, 0, err
+	}
+	nd, err := mmvr.Publish(ctx, delta)
+	return nd, delta.GetPriority(), err
+}
+
+// Value will retrieve the current value from the db.
+func (mmvr *MerkleMVRegister) Value(ctx context.Context) ([]byte, error) {
+	return mmvr.reg.Value(ctx)
+}
+
+// Merge writes the provided delta to state using a supplied
+// merge semantic.
+func (mmvr *MerkleMVRegister) Merge(ctx context.Context, other core.Delta, id string) error {
+	return mmvr.reg.Merge(ctx, other, id)
+}
+
+// MVRegisterValue is a value of a MerkleMVRegister, written by the block of the given CID.
+type MVRegisterValue struct {
+	Cid  cid.Cid
+	Data []byte
+}
+
+// Values returns all of the values of the register, newest first.
+//
+// The register holds more than one value if they were written concurrently, and no write has
+// replaced them since.
+func (mmvr *MerkleMVRegister) Values(ctx context.Context) ([]MVRegisterValue, error) {
+	values, err := mmvr.reg.Values(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]MVRegisterValue, len(values))
+	for i, value := range values {
+		// Values are identified by the datastore key of the multihash of their block, as given
+		// to Merge by the MerkleClock.
+		hash, err := dshelp.DsKeyToMultihash(ds.NewKey(value.ID))
+		if err != nil {
+			return nil, err
+		}
+		result[i] = MVRegisterValue{
+			Cid:  cid.NewCidV1(cid.DagProtobuf, hash),
+			Data: value.Data,
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,109 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package crdt
+
+import (
+	"context"
+
+	ipld "github.com/ipfs/go-ipld-format"
+
+	"github.com/sourcenetwork/defradb/client"
+	"github.com/sourcenetwork/defradb/core"
+	corecrdt "github.com/sourcenetwork/defradb/core/crdt"
+	"github.com/sourcenetwork/defradb/datastore"
+	"github.com/sourcenetwork/defradb/events"
+	"github.com/sourcenetwork/defradb/merkle/clock"
+)
+
+var (
+	orSetFactoryFn = MerkleCRDTFactory(
+		func(
+			mstore datastore.MultiStore,
+			schemaID core.CollectionSchemaVersionKey,
+			_ events.UpdateChannel,
+			_ *core.HLC,
+		) MerkleCRDTInitFn {
+			return func(key core.DataStoreKey) MerkleCRDT {
+				return NewMerkleORSet(
+					mstore.Datastore(),
+					mstore.Headstore(),
+					mstore.DAGstore(),
+					schemaID,
+					key,
+				)
+			}
+		},
+	)
+)
+
+func init() {
+	err := DefaultFactory.Register(client.OR_SET, &orSetFactoryFn)
+	if err != nil {
+		panic(err)
+	}
+}
+
+// MerkleORSet is a MerkleCRDT implementation of the ORSet using MerkleClocks.
+type MerkleORSet struct {
+	*baseMerkleCRDT
+
+	set corecrdt.ORSet
+}
+
+// NewMerkleORSet creates a new instance (or loaded from DB) of a MerkleCRDT
+// backed by a ORSet CRDT.
+func NewMerkleORSet(
+	datastore datastore.DSReaderWriter,
+	headstore datastore.DSReaderWriter,
+	dagstore datastore.DAGStore,
+	schemaVersionKey core.CollectionSchemaVersionKey,
+	key core.DataStoreKey,
+) *MerkleORSet {
+	set := corecrdt.NewORSet(datastore, schemaVersionKey, key)
+	clk := clock.NewMerkleClock(headstore, dagstore, key.ToHeadStoreKey(), set, nil)
+	base := &baseMerkleCRDT{clock: clk, crdt: set}
+	return &MerkleORSet{
+		baseMerkleCRDT: base,
+		set:            set,
+	}
+}
+
+// Update adds the elements of the given CBOR encoded added array to the set, and removes
+// those of the given CBOR encoded removed array.
+func (mors *MerkleORSet) Update(ctx context.Context, added []byte, removed []byte) (ipld.Node, uint64, error) {
+	delta, err := mors.set.Update(ctx, added, removed)
+	if err != nil {
+		return nil, 0, err
+	}
+	nd, err := mors.Publish(ctx, delta)
+	return nd, delta.GetPriority(), err
+}
+
+// Set updates the set such that it holds the elements of the given CBOR encoded array.
+func (mors *MerkleORSet) Set(ctx context.Context, value []byte) (ipld.Node, uint64, error) {
+	delta, err := mors.set.Set(ctx, value)
+	if err != nil {
+		return nil, 0, err
+	}
+	nd, err := mors.Publish(ctx, delta)
+	return nd, delta.GetPriority(), err
+}
+
+// Value will retrieve the current value from the db.
+func (mors *MerkleORSet) Value(ctx context.Context) ([]byte, error) {
+	return mors.set.Value(ctx)
+}
+
+// Merge writes the provided delta to state using a supplied
+// merge semantic.
+func (mors *MerkleORSet) Merge(ctx context.Context, other core.Delta, id string) error {
+	return mors.set.Merge(ctx, other, id)
+}
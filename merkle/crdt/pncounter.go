@@ -0,0 +1,106 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package crdt
+
+import (
+	"context"
+
+	ipld "github.com/ipfs/go-ipld-format"
+
+	"github.com/sourcenetwork/defradb/client"
+	"github.com/sourcenetwork/defradb/core"
+	corecrdt "github.com/sourcenetwork/defradb/core/crdt"
+	"github.com/sourcenetwork/defradb/datastore"
+	"github.com/sourcenetwork/defradb/events"
+	"github.com/sourcenetwork/defradb/merkle/clock"
+)
+
+var (
+	pnCounterFactoryFn = MerkleCRDTFactory(
+		func(
+			mstore datastore.MultiStore,
+			schemaID core.CollectionSchemaVersionKey,
+			_ events.UpdateChannel,
+			_ *core.HLC,
+		) MerkleCRDTInitFn {
+			return func(key core.DataStoreKey) MerkleCRDT {
+				return NewMerklePNCounter(
+					mstore.Datastore(),
+					mstore.Headstore(),
+					mstore.DAGstore(),
+					schemaID,
+					key,
+				)
+			}
+		},
+	)
+)
+
+func init() {
+	err := DefaultFactory.Register(client.PN_COUNTER, &pnCounterFactoryFn)
+	if err != nil {
+		panic(err)
+	}
+}
+
+// MerklePNCounter is a MerkleCRDT implementation of the PNCounter using MerkleClocks.
+type MerklePNCounter struct {
+	*baseMerkleCRDT
+
+	counter corecrdt.PNCounter
+}
+
+// NewMerklePNCounter creates a new instance (or loaded from DB) of a MerkleCRDT
+// backed by a PNCounter CRDT.
+func NewMerklePNCounter(
+	datastore datastore.DSReaderWriter,
+	headstore datastore.DSReaderWriter,
+	dagstore datastore.DAGStore,
+	schemaVersionKey core.CollectionSchemaVersionKey,
+	key core.DataStoreKey,
+) *MerklePNCounter {
+	counter := corecrdt.NewPNCounter(datastore, schemaVersionKey, key)
+	clk := clock.NewMerkleClock(headstore, dagstore, key.ToHeadStoreKey(), counter, nil)
+	base := &baseMerkleCRDT{clock: clk, crdt: counter}
+	return &MerklePNCounter{
+		baseMerkleCRDT: base,
+		counter:        counter,
+	}
+}
+
+// Increment increments the counter by the given CBOR encoded amount, which may be negative.
+func (mpnc *MerklePNCounter) Increment(ctx context.Context, value []byte) (ipld.Node, uint64, error) {
+	delta := mpnc.counter.Increment(value)
+	nd, err := mpnc.Publish(ctx, delta)
+	return nd, delta.GetPriority(), err
+}
+
+// Set sets the counter to the given CBOR encoded value, by incrementing it by the difference
+// between the given value and its current value.
+func (mpnc *MerklePNCounter) Set(ctx context.Context, value []byte) (ipld.Node, uint64, error) {
+	delta, err := mpnc.counter.Set(ctx, value)
+	if err != nil {
+		return nil, 0, err
+	}
+	nd, err := mpnc.Publish(ctx, delta)
+	return nd, delta.GetPriority(), err
+}
+
+// Value will retrieve the current value from the db.
+func (mpnc *MerklePNCounter) Value(ctx context.Context) ([]byte, error) {
+	return mpnc.counter.Value(ctx)
+}
+
+// Merge writes the provided delta to state using a supplied
+// merge semantic.
+func (mpnc *MerklePNCounter) Merge(ctx context.Context, other core.Delta, id string) error {
+	return mpnc.counter.Merge(ctx, other, id)
+}
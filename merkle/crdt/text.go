@@ -0,0 +1,108 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package crdt
+
+import (
+	"context"
+
+	ipld "github.com/ipfs/go-ipld-format"
+
+	"github.com/sourcenetwork/defradb/client"
+	"github.com/sourcenetwork/defradb/core"
+	corecrdt "github.com/sourcenetwork/defradb/core/crdt"
+	"github.com/sourcenetwork/defradb/datastore"
+	"github.com/sourcenetwork/defradb/events"
+	"github.com/sourcenetwork/defradb/merkle/clock"
+)
+
+var (
+	rgaTextFactoryFn = MerkleCRDTFactory(
+		func(
+			mstore datastore.MultiStore,
+			schemaID core.CollectionSchemaVersionKey,
+			_ events.UpdateChannel,
+			_ *core.HLC,
+		) MerkleCRDTInitFn {
+			return func(key core.DataStoreKey) MerkleCRDT {
+				return NewMerkleRGAText(
+					mstore.Datastore(),
+					mstore.Headstore(),
+					mstore.DAGstore(),
+					schemaID,
+					key,
+				)
+			}
+		},
+	)
+)
+
+func init() {
+	err := DefaultFactory.Register(client.RGA_TEXT, &rgaTextFactoryFn)
+	if err != nil {
+		panic(err)
+	}
+}
+
+// MerkleRGAText is a MerkleCRDT implementation of the RGAText using MerkleClocks.
+type MerkleRGAText struct {
+	*baseMerkleCRDT
+
+	text corecrdt.RGAText
+}
+
+// NewMerkleRGAText creates a new instance (or loaded from DB) of a MerkleCRDT
+// backed by a RGAText CRDT.
+func NewMerkleRGAText(
+	datastore datastore.DSReaderWriter,
+	headstore datastore.DSReaderWriter,
+	dagstore datastore.DAGStore,
+	schemaVersionKey core.CollectionSchemaVersionKey,
+	key core.DataStoreKey,
+) *MerkleRGAText {
+	text := corecrdt.NewRGAText(datastore, schemaVersionKey, key)
+	clk := clock.NewMerkleClock(headstore, dagstore, key.ToHeadStoreKey(), text, nil)
+	base := &baseMerkleCRDT{clock: clk, crdt: text}
+	return &MerkleRGAText{
+		baseMerkleCRDT: base,
+		text:           text,
+	}
+}
+
+// Edit applies the given edits to the text, in order.
+func (mrt *MerkleRGAText) Edit(ctx context.Context, edits []corecrdt.TextEdit) (ipld.Node, uint64, error) {
+	delta, err := mrt.text.Edit(ctx, edits)
+	if err != nil {
+		return nil, 0, err
+	}
+	nd, err := mrt.Publish(ctx, delta)
+	return nd, delta.GetPriority(), err
+}
+
+// Set replaces the text with the given CBOR encoded string.
+func (mrt *MerkleRGAText) Set(ctx context.Context, value []byte) (ipld.Node, uint64, error) {
+	delta, err := mrt.text.Set(ctx, value)
+	if err != nil {
+		return nil, 0, err
+	}
+	nd, err := mrt.Publish(ctx, delta)
+	return nd, delta.GetPriority(), err
+}
+
+// Value will retrieve the current value from the db.
+func (mrt *MerkleRGAText) Value(ctx context.Context) ([]byte, error) {
+	return mrt.text.Value(ctx)
+}
+
+// Merge writes the provided delta to state using a supplied
+// merge semantic.
+func (mrt *MerkleRGAText) Merge(ctx context.Context, other core.Delta, id string) error {
+	return mrt.text.Merge(ctx, other, id)
+}
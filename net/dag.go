@@ -17,11 +17,13 @@ import (
 	"sync"
 	"time"
 
+	"github.com/fxamacker/cbor/v2"
 	"github.com/ipfs/go-cid"
 	ipld "github.com/ipfs/go-ipld-format"
 
 	"github.com/sourcenetwork/defradb/client"
 	"github.com/sourcenetwork/defradb/core"
+	corecrdt "github.com/sourcenetwork/defradb/core/crdt"
 	"github.com/sourcenetwork/defradb/datastore"
 	"github.com/sourcenetwork/defradb/logging"
 )
@@ -168,6 +170,57 @@ func (p *Peer) dagWorker(jobs chan *dagJob) {
 	}
 }
 
+// fetchBlob fetches the blocks of the blob referenced by the given delta of a blob field,
+// storing them in the DAG store of the given transaction.
+//
+// The chunks of the blob are stored before its root block, so that holding the root block
+// implies holding the whole blob.
+func fetchBlob(
+	ctx context.Context,
+	txn datastore.Txn,
+	getter ipld.NodeGetter,
+	delta core.Delta,
+) error {
+	lwwDelta, ok := delta.(*corecrdt.LWWRegDelta)
+	if !ok || len(lwwDelta.Data) == 0 {
+		return nil
+	}
+
+	var cidStr string
+	if err := cbor.Unmarshal(lwwDelta.Data, &cidStr); err != nil {
+		return err
+	}
+	blobCID, err := cid.Decode(cidStr)
+	if err != nil {
+		return err
+	}
+
+	hasBlob, err := txn.DAGstore().Has(ctx, blobCID)
+	if err != nil || hasBlob {
+		return err
+	}
+
+	root, err := getter.Get(ctx, blobCID)
+	if err != nil {
+		return err
+	}
+
+	chunkCIDs := make([]cid.Cid, len(root.Links()))
+	for i, link := range root.Links() {
+		chunkCIDs[i] = link.Cid
+	}
+	for chunk := range getter.GetMany(ctx, chunkCIDs) {
+		if chunk.Err != nil {
+			return chunk.Err
+		}
+		if err := txn.DAGstore().Put(ctx, chunk.Node); err != nil {
+			return err
+		}
+	}
+
+	return txn.DAGstore().Put(ctx, root)
+}
+
 type cidSafeSet struct {
 	set map[cid.Cid]struct{}
 	mux sync.Mutex
@@ -58,6 +58,10 @@ type Peer struct {
 	db            client.DB
 	updateChannel chan events.Update
 
+	// hlc is the hybrid logical clock of the database, observing the timestamps of the blocks
+	// received from other peers.
+	hlc *core.HLC
+
 	host host.Host
 	dht  routing.Routing
 	ps   *pubsub.PubSub
@@ -86,6 +90,12 @@ type Peer struct {
 	cancel context.CancelFunc
 }
 
+// clockedDB is a database with a hybrid logical clock of its own, timestamping the deltas it
+// creates and observing the timestamps of the deltas it merges.
+type clockedDB interface {
+	HLC() *core.HLC
+}
+
 // NewPeer creates a new instance of the DefraDB server as a peer-to-peer node.
 func NewPeer(
 	ctx context.Context,
@@ -115,6 +125,11 @@ func NewPeer(
 		replicators:    make(map[string]map[peer.ID]struct{}),
 		queuedChildren: newCidSafeSet(),
 	}
+	if clocked, ok := db.(clockedDB); ok {
+		p.hlc = clocked.HLC()
+	} else {
+		p.hlc = core.NewHLC(time.Now, core.DefaultHLCMaxOffset)
+	}
 	var err error
 	p.server, err = newServer(p, db, dialOptions...)
 	if err != nil {
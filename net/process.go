@@ -47,7 +47,7 @@ func (p *Peer) processLog(
 ) ([]cid.Cid, error) {
 	log.Debug(ctx, "Running processLog")
 
-	crdt, err := initCRDTForType(ctx, txn, col, dockey, field)
+	crdt, err := initCRDTForType(ctx, txn, p.hlc, col, dockey, field)
 	if err != nil {
 		return nil, err
 	}
@@ -57,6 +57,12 @@ func (p *Peer) processLog(
 		return nil, errors.Wrap("failed to decode delta object", err)
 	}
 
+	if fd, ok := col.Description().GetField(field); ok && fd.Kind == client.FieldKind_BLOB {
+		if err := fetchBlob(ctx, txn, getter, delta); err != nil {
+			return nil, errors.Wrap("failed to fetch blob", err)
+		}
+	}
+
 	log.Debug(
 		ctx,
 		"Processing PushLog request",
@@ -64,6 +70,14 @@ func (p *Peer) processLog(
 		logging.NewKV("CID", c),
 	)
 
+	// A block timestamped too far ahead of the local clock is rejected before it is stored, as a
+	// stored block is skipped when it is received again, and would never be merged.
+	if timestamped, ok := delta.(core.TimestampedDelta); ok && timestamped.GetTimestamp() != 0 {
+		if err := p.hlc.Observe(timestamped.GetTimestamp()); err != nil {
+			return nil, err
+		}
+	}
+
 	if err := txn.DAGstore().Put(ctx, nd); err != nil {
 		return nil, err
 	}
@@ -85,6 +99,7 @@ func (p *Peer) processLog(
 func initCRDTForType(
 	ctx context.Context,
 	txn datastore.MultiStore,
+	hlc *core.HLC,
 	col client.Collection,
 	docKey core.DataStoreKey,
 	field string,
@@ -111,7 +126,7 @@ func initCRDTForType(
 		key = base.MakeCollectionKey(description).WithInstanceInfo(docKey).WithFieldId(fieldID)
 	}
 	log.Debug(ctx, "Got CRDT Type", logging.NewKV("CType", ctype), logging.NewKV("Field", field))
-	return crdt.DefaultFactory.InstanceWithStores(
+	return crdt.DefaultFactory.WithHLC(hlc).InstanceWithStores(
 		txn,
 		core.NewCollectionSchemaVersionKey(col.Schema().VersionID),
 		events.EmptyUpdateChannel,
@@ -29,6 +29,7 @@ import (
 	"github.com/sourcenetwork/defradb/client"
 	"github.com/sourcenetwork/defradb/core"
 	"github.com/sourcenetwork/defradb/datastore/badger/v3"
+	"github.com/sourcenetwork/defradb/db/base"
 	"github.com/sourcenetwork/defradb/errors"
 	"github.com/sourcenetwork/defradb/logging"
 	pb "github.com/sourcenetwork/defradb/net/pb"
@@ -263,21 +264,24 @@ func (s *server) PushLog(ctx context.Context, req *pb.PushLogRequest) (*pb.PushL
 			getter = sessionMaker.Session(ctx)
 		}
 
+		// Merged values bypass the collection write path, so the secondary index entries of
+		// the document are rebuilt from its merged state once the DAG has been processed.
+		existingIndexEntries, err := base.GetIndexEntries(ctx, txn.Datastore(), col.Description(), docKey.DocKey)
+		if err != nil {
+			return nil, err
+		}
+
 		// handleComposite
 		nd, err := decodeBlockBuffer(req.Body.Log.Block, cid)
 		if err != nil {
 			return nil, errors.Wrap("failed to decode block to ipld.Node", err)
 		}
 
+		// The transaction is discarded if the block cannot be processed, so that the sender
+		// pushes it again instead of it being skipped as an existing block.
 		cids, err := s.peer.processLog(ctx, txn, col, docKey, cid, "", nd, getter, false)
 		if err != nil {
-			log.ErrorE(
-				ctx,
-				"Failed to process PushLog node",
-				err,
-				logging.NewKV("DocKey", docKey),
-				logging.NewKV("CID", cid),
-			)
+			return nil, errors.Wrap(fmt.Sprintf("failed to process PushLog node %s", cid), err)
 		}
 
 		// handleChildren
@@ -298,6 +302,41 @@ func (s *server) PushLog(ctx context.Context, req *pb.PushLogRequest) (*pb.PushL
 			log.Debug(ctx, "No more children to process for log", logging.NewKV("CID", cid))
 		}
 
+		newIndexEntries, err := base.GetIndexEntries(ctx, txn.Datastore(), col.Description(), docKey.DocKey)
+		if err != nil {
+			return nil, err
+		}
+		conflicts, err := base.UpdateIndexEntries(
+			ctx,
+			txn.Datastore(),
+			col.Description(),
+			existingIndexEntries,
+			newIndexEntries,
+		)
+		if err != nil {
+			return nil, err
+		}
+		// Values violating a unique constraint may be written concurrently on different peers.
+		// Rejecting the merged values would leave the peers permanently diverged, so the values
+		// are always accepted, the document remains reachable through the index, and the
+		// conflict is flagged for the operator to resolve, see [client.Collection.GetUniqueConflicts].
+		// Any further local writes of the same values will be rejected until the conflict is
+		// resolved.
+		err = base.FlagIndexConflicts(ctx, txn.Datastore(), col.Description(), conflicts)
+		if err != nil {
+			return nil, err
+		}
+		for _, conflict := range conflicts {
+			log.Error(
+				ctx,
+				"Received document violates a unique index, the conflict must be resolved manually",
+				logging.NewKV("Collection", col.Name()),
+				logging.NewKV("Index", conflict.Index.Name),
+				logging.NewKV("DocKey", docKey.DocKey),
+				logging.NewKV("ExistingDocKey", conflict.DocKey),
+			)
+		}
+
 		if txnErr = txn.Commit(ctx); txnErr != nil {
 			if errors.Is(txnErr, badger.ErrTxnConflict) {
 				continue
@@ -0,0 +1,95 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package net
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	badger "github.com/dgraph-io/badger/v3"
+	"github.com/libp2p/go-libp2p"
+	"github.com/stretchr/testify/require"
+	grpcpeer "google.golang.org/grpc/peer"
+
+	"github.com/sourcenetwork/defradb/client"
+	"github.com/sourcenetwork/defradb/core"
+	badgerds "github.com/sourcenetwork/defradb/datastore/badger/v3"
+	"github.com/sourcenetwork/defradb/db"
+	pb "github.com/sourcenetwork/defradb/net/pb"
+)
+
+const usersSchema = `
+	type Users {
+		Name: String @crdt(type: lwwhlc)
+	}
+`
+
+func newTestDB(ctx context.Context, t *testing.T, opts ...db.Option) client.DB {
+	rootstore, err := badgerds.NewDatastore(
+		"",
+		&badgerds.Options{Options: badger.DefaultOptions("").WithInMemory(true)},
+	)
+	require.NoError(t, err)
+	database, err := db.NewDB(ctx, rootstore, opts...)
+	require.NoError(t, err)
+	t.Cleanup(func() { database.Close(ctx) })
+	require.NoError(t, database.AddSchema(ctx, usersSchema))
+	return database
+}
+
+func TestPushLogWithTimestampTooFarAheadIsNeitherStoredNorMerged(t *testing.T) {
+	ctx := context.Background()
+
+	sender := newTestDB(ctx, t, db.WithUpdateEvents())
+	// The clock of the sender runs ahead of the physical time by less than the default maximum
+	// offset, but by more than the maximum offset of the receiver.
+	ahead := core.NewHLCTimestamp(time.Now().Add(4*time.Minute), 0)
+	require.NoError(t, sender.(clockedDB).HLC().Observe(ahead))
+	updates, err := sender.Events().Updates.Value().Subscribe()
+	require.NoError(t, err)
+
+	col, err := sender.GetCollectionByName(ctx, "Users")
+	require.NoError(t, err)
+	doc, err := client.NewDocFromJSON([]byte(`{"Name": "John"}`))
+	require.NoError(t, err)
+	require.NoError(t, col.Create(ctx, doc))
+	update := <-updates
+
+	receiver := newTestDB(ctx, t, db.WithMaxClockOffset(time.Minute))
+	h, err := libp2p.New(libp2p.NoListenAddrs)
+	require.NoError(t, err)
+	p, err := NewPeer(ctx, receiver, h, nil, nil, nil, nil, nil)
+	require.NoError(t, err)
+	defer p.Close() //nolint:errcheck
+
+	req := &pb.PushLogRequest{
+		Body: &pb.PushLogRequest_Body{
+			DocKey:   &pb.ProtoDocKey{DocKey: doc.Key()},
+			Cid:      &pb.ProtoCid{Cid: update.Cid},
+			SchemaID: []byte(update.SchemaID),
+			Creator:  h.ID().String(),
+			Log:      &pb.Document_Log{Block: update.Block.RawData()},
+		},
+	}
+	pushCtx := grpcpeer.NewContext(ctx, &grpcpeer.Peer{Addr: addr{id: h.ID()}})
+	_, err = p.server.PushLog(pushCtx, req)
+	require.ErrorIs(t, err, core.ErrHLCMaxOffsetExceeded)
+
+	stored, err := receiver.Blockstore().Has(ctx, update.Cid)
+	require.NoError(t, err)
+	require.False(t, stored)
+
+	receivedCol, err := receiver.GetCollectionByName(ctx, "Users")
+	require.NoError(t, err)
+	_, err = receivedCol.Get(ctx, doc.Key(), false)
+	require.ErrorIs(t, err, client.ErrDocumentNotFound)
+}
@@ -141,6 +141,8 @@ func NewNode(
 		logging.NewKV("PeerId", h.ID()),
 		logging.NewKV("Address", options.ListenAddrs),
 	)
+	// commits made by the database are authored by this node
+	db.SetPeerID(h.ID().String())
 
 	var ps *pubsub.PubSub
 	if options.EnablePubSub {
@@ -11,6 +11,8 @@
 package planner
 
 import (
+	"math/big"
+
 	"github.com/sourcenetwork/defradb/client"
 	"github.com/sourcenetwork/defradb/client/request"
 	"github.com/sourcenetwork/defradb/core"
@@ -98,6 +100,11 @@ func (n *averageNode) Next() (bool, error) {
 		n.currentValue.Fields[n.virtualFieldIndex] = sum / float64(count)
 	case int64:
 		n.currentValue.Fields[n.virtualFieldIndex] = float64(sum) / float64(count)
+	case *big.Rat:
+		n.currentValue.Fields[n.virtualFieldIndex] = new(big.Rat).Quo(sum, big.NewRat(int64(count), 1))
+	case *big.Int:
+		// The average of integers is rarely an integer, so is returned as a decimal.
+		n.currentValue.Fields[n.virtualFieldIndex] = new(big.Rat).SetFrac(sum, big.NewInt(int64(count)))
 	default:
 		return false, client.NewErrUnhandledType("sum", sumProp)
 	}
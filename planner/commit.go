@@ -11,6 +11,8 @@
 package planner
 
 import (
+	"time"
+
 	"github.com/fxamacker/cbor/v2"
 	dag "github.com/ipfs/boxo/ipld/merkledag"
 	blocks "github.com/ipfs/go-block-format"
@@ -318,6 +320,26 @@ func (n *dagScanNode) dagBlockToNodeDoc(block blocks.Block) (core.Doc, []*ipld.L
 	n.commitSelect.DocumentMapping.SetFirstOfName(&commit, request.HeightFieldName, int64(prio))
 	n.commitSelect.DocumentMapping.SetFirstOfName(&commit, request.DeltaFieldName, delta["Data"])
 
+	// the timestamp is omitted from deltas that were not timestamped
+	if timestamp, ok := delta["Timestamp"].(uint64); ok {
+		n.commitSelect.DocumentMapping.SetFirstOfName(&commit, request.TimeFieldName,
+			core.HLCTimestamp(timestamp).Time().Format(time.RFC3339Nano))
+	}
+
+	// the metadata is omitted from the blocks of commits without any
+	if metadata, ok := delta["Metadata"].(map[any]any); ok {
+		if author, ok := metadata["Author"].(string); ok {
+			n.commitSelect.DocumentMapping.SetFirstOfName(&commit, request.AuthorFieldName, author)
+		}
+		if authorTime, ok := metadata["Time"].(uint64); ok {
+			n.commitSelect.DocumentMapping.SetFirstOfName(&commit, request.AuthorTimeFieldName,
+				time.UnixMilli(int64(authorTime)).UTC().Format(time.RFC3339Nano))
+		}
+		if message, ok := metadata["Message"].(string); ok {
+			n.commitSelect.DocumentMapping.SetFirstOfName(&commit, request.MessageFieldName, message)
+		}
+	}
+
 	dockey, ok := delta["DocKey"].([]byte)
 	if !ok {
 		return core.Doc{}, nil, ErrDeltaMissingDockey
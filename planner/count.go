@@ -126,7 +126,7 @@ func (n *countNode) Next() (bool, error) {
 		switch v.Kind() {
 		// v.Len will panic if v is not one of these types, we don't want it to panic
 		case reflect.Array, reflect.Chan, reflect.Map, reflect.Slice, reflect.String:
-			if source.Filter == nil && source.Limit == nil {
+			if source.Filter == nil && source.Limit == nil && source.Distinct == nil {
 				count = count + v.Len()
 			} else {
 				var arrayCount int
@@ -136,28 +136,28 @@ func (n *countNode) Next() (bool, error) {
 					arrayCount = countDocs(array)
 
 				case []bool:
-					arrayCount, err = countItems(array, source.Filter, source.Limit)
+					arrayCount, err = countItems(array, source.Filter, source.Limit, source.Distinct)
 
 				case []immutable.Option[bool]:
-					arrayCount, err = countItems(array, source.Filter, source.Limit)
+					arrayCount, err = countItems(array, source.Filter, source.Limit, source.Distinct)
 
 				case []int64:
-					arrayCount, err = countItems(array, source.Filter, source.Limit)
+					arrayCount, err = countItems(array, source.Filter, source.Limit, source.Distinct)
 
 				case []immutable.Option[int64]:
-					arrayCount, err = countItems(array, source.Filter, source.Limit)
+					arrayCount, err = countItems(array, source.Filter, source.Limit, source.Distinct)
 
 				case []float64:
-					arrayCount, err = countItems(array, source.Filter, source.Limit)
+					arrayCount, err = countItems(array, source.Filter, source.Limit, source.Distinct)
 
 				case []immutable.Option[float64]:
-					arrayCount, err = countItems(array, source.Filter, source.Limit)
+					arrayCount, err = countItems(array, source.Filter, source.Limit, source.Distinct)
 
 				case []string:
-					arrayCount, err = countItems(array, source.Filter, source.Limit)
+					arrayCount, err = countItems(array, source.Filter, source.Limit, source.Distinct)
 
 				case []immutable.Option[string]:
-					arrayCount, err = countItems(array, source.Filter, source.Limit)
+					arrayCount, err = countItems(array, source.Filter, source.Limit, source.Distinct)
 				}
 				if err != nil {
 					return false, err
@@ -185,7 +185,12 @@ func countDocs(docs []core.Doc) int {
 	return count
 }
 
-func countItems[T any](source []T, filter *mapper.Filter, limit *mapper.Limit) (int, error) {
+func countItems[T comparable](
+	source []T,
+	filter *mapper.Filter,
+	limit *mapper.Limit,
+	distinct *mapper.Distinct,
+) (int, error) {
 	items := enumerable.New(source)
 	if filter != nil {
 		items = enumerable.Where(items, func(item T) (bool, error) {
@@ -193,6 +198,17 @@ func countItems[T any](source []T, filter *mapper.Filter, limit *mapper.Limit) (
 		})
 	}
 
+	if distinct != nil {
+		countedItems := map[T]struct{}{}
+		items = enumerable.Where(items, func(item T) (bool, error) {
+			if _, isCounted := countedItems[item]; isCounted {
+				return false, nil
+			}
+			countedItems[item] = struct{}{}
+			return true, nil
+		})
+	}
+
 	if limit != nil {
 		items = enumerable.Skip(items, limit.Offset)
 		items = enumerable.Take(items, limit.Limit)
@@ -41,6 +41,9 @@ type createNode struct {
 	newDocStr string
 	doc       *client.Document
 
+	// message is recorded in the commits made by the create, if not empty
+	message string
+
 	err error
 
 	returned bool
@@ -80,7 +83,8 @@ func (n *createNode) Next() (bool, error) {
 		return false, nil
 	}
 
-	if err := n.collection.WithTxn(n.p.txn).Create(n.p.ctx, n.doc); err != nil {
+	ctx := core.WithCommitMessage(n.p.ctx, n.message)
+	if err := n.collection.WithTxn(n.p.txn).Create(ctx, n.doc); err != nil {
 		return false, err
 	}
 
@@ -170,6 +174,7 @@ func (p *Planner) CreateDoc(parsed *mapper.Mutation) (planNode, error) {
 	create := &createNode{
 		p:         p,
 		newDocStr: parsed.Data,
+		message:   parsed.Message,
 		results:   results,
 		docMapper: docMapper{&parsed.DocumentMapping},
 	}
@@ -29,6 +29,9 @@ type deleteNode struct {
 	filter *mapper.Filter
 	ids    []string
 
+	// message is recorded in the commits made by the delete, if not empty
+	message string
+
 	execInfo deleteExecInfo
 }
 
@@ -53,7 +56,7 @@ func (n *deleteNode) Next() (bool, error) {
 	if err != nil {
 		return false, err
 	}
-	_, err = n.collection.DeleteWithKey(n.p.ctx, key)
+	_, err = n.collection.DeleteWithKey(core.WithCommitMessage(n.p.ctx, n.message), key)
 	if err != nil {
 		return false, err
 	}
@@ -132,6 +135,7 @@ func (p *Planner) DeleteDocs(parsed *mapper.Mutation) (planNode, error) {
 		p:          p,
 		filter:     parsed.Filter,
 		ids:        parsed.DocKeys.Value(),
+		message:    parsed.Message,
 		collection: col.WithTxn(p.txn),
 		source:     slctNode,
 		docMapper:  docMapper{&parsed.DocumentMapping},
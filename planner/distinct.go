@@ -0,0 +1,144 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package planner
+
+import (
+	"github.com/sourcenetwork/defradb/client/request"
+	"github.com/sourcenetwork/defradb/core"
+	"github.com/sourcenetwork/defradb/planner/mapper"
+)
+
+// distinctNode yields only the first of each set of documents that share the same
+// values for the distinct fields.
+type distinctNode struct {
+	documentIterator
+	docMapper
+
+	p    *Planner
+	plan planNode
+
+	fields []mapper.Field
+
+	// indexedSource is the scan of the source collection, if it uses a secondary
+	// index that may yield the documents grouped by the distinct fields.
+	indexedSource *scanNode
+
+	// The keys of the distinct field values already yielded.
+	//
+	// If the documents are grouped by the index in use only the last key is
+	// required, and this is not populated.
+	yieldedKeys map[string]struct{}
+	lastKey     string
+	hasLastKey  bool
+
+	execInfo distinctExecInfo
+}
+
+type distinctExecInfo struct {
+	// Total number of times distinctNode was executed.
+	iterations uint64
+
+	// Total number of documents skipped as they were not distinct.
+	duplicates uint64
+}
+
+// Distinct creates a new distinctNode initalized from the mapper.Distinct object.
+func (p *Planner) Distinct(parsed *mapper.Select, n *mapper.Distinct) (*distinctNode, error) {
+	if n == nil {
+		return nil, nil // nothing to do
+	}
+	return &distinctNode{
+		p:         p,
+		fields:    n.Fields,
+		docMapper: docMapper{&parsed.DocumentMapping},
+	}, nil
+}
+
+func (n *distinctNode) Kind() string {
+	return "distinctNode"
+}
+
+func (n *distinctNode) Init() error {
+	// reset stateful data
+	n.yieldedKeys = map[string]struct{}{}
+	n.lastKey = ""
+	n.hasLastKey = false
+	return n.plan.Init()
+}
+
+func (n *distinctNode) Start() error           { return n.plan.Start() }
+func (n *distinctNode) Spans(spans core.Spans) { n.plan.Spans(spans) }
+func (n *distinctNode) Close() error           { return n.plan.Close() }
+func (n *distinctNode) Source() planNode       { return n.plan }
+
+func (n *distinctNode) Next() (bool, error) {
+	n.execInfo.iterations++
+
+	isGroupedByIndex := n.indexedSource != nil && n.indexedSource.isGroupedByIndex()
+	for {
+		hasNext, err := n.plan.Next()
+		if err != nil || !hasNext {
+			return hasNext, err
+		}
+
+		doc := n.plan.Value()
+		key := generateKey(doc, n.fields)
+
+		if isGroupedByIndex {
+			// Documents sharing the same values are yielded together by the index,
+			// so only the last key needs to be compared against.
+			if n.hasLastKey && key == n.lastKey {
+				n.execInfo.duplicates++
+				continue
+			}
+			n.lastKey = key
+			n.hasLastKey = true
+		} else {
+			if _, isYielded := n.yieldedKeys[key]; isYielded {
+				n.execInfo.duplicates++
+				continue
+			}
+			n.yieldedKeys[key] = struct{}{}
+		}
+
+		n.currentValue = doc
+		return true, nil
+	}
+}
+
+func (n *distinctNode) simpleExplain() (map[string]any, error) {
+	fieldNames := make([]string, len(n.fields))
+	for i, field := range n.fields {
+		fieldNames[i] = field.Name
+	}
+
+	return map[string]any{
+		"fields": fieldNames,
+	}, nil
+}
+
+// Explain method returns a map containing all attributes of this node that
+// are to be explained, subscribes / opts-in this node to be an explainablePlanNode.
+func (n *distinctNode) Explain(explainType request.ExplainType) (map[string]any, error) {
+	switch explainType {
+	case request.SimpleExplain:
+		return n.simpleExplain()
+
+	case request.ExecuteExplain:
+		return map[string]any{
+			"iterations": n.execInfo.iterations,
+			"duplicates": n.execInfo.duplicates,
+		}, nil
+
+	default:
+		return nil, ErrUnknownExplainRequestType
+	}
+}
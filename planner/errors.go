@@ -16,6 +16,7 @@ const (
 	errUnknownDependency              string = "given field does not exist"
 	errFailedToClosePlan              string = "failed to close the plan"
 	errFailedToCollectExecExplainInfo string = "failed to collect execution explain information"
+	errSimilarDimensionsMismatch      string = "the vector of a similarity search does not have the number of dimensions of the searched field"
 )
 
 var (
@@ -33,6 +34,7 @@ var (
 	ErrUnknownExplainRequestType           = errors.New("can not explain request of unknown type")
 	ErrFailedToCollectExecExplainInfo      = errors.New(errFailedToCollectExecExplainInfo)
 	ErrUnknownDependency                   = errors.New(errUnknownDependency)
+	ErrSimilarDimensionsMismatch           = errors.New(errSimilarDimensionsMismatch)
 )
 
 func NewErrUnknownDependency(name string) error {
@@ -46,3 +48,12 @@ func NewErrFailedToClosePlan(inner error, location string) error {
 func NewErrFailedToCollectExecExplainInfo(inner error) error {
 	return errors.Wrap(errFailedToCollectExecExplainInfo, inner)
 }
+
+func NewErrSimilarDimensionsMismatch(fieldName string, expected int, actual int) error {
+	return errors.New(
+		errSimilarDimensionsMismatch,
+		errors.NewKV("Field", fieldName),
+		errors.NewKV("Expected", expected),
+		errors.NewKV("Actual", actual),
+	)
+}
@@ -170,21 +170,41 @@ func (n *groupNode) Next() (bool, error) {
 				}
 
 				childDocs := subSelect.([]core.Doc)
-				if childSelect.Limit != nil {
-					l := uint64(len(childDocs))
+				if childSelect.Distinct != nil {
+					// We must hide all child documents sharing their distinct values with
+					// an earlier child document
+					yieldedKeys := map[string]struct{}{}
+					for i := range childDocs {
+						key := generateKey(childDocs[i], childSelect.Distinct.Fields)
+						if _, isYielded := yieldedKeys[key]; isYielded {
+							childDocs[i].Hidden = true
+							continue
+						}
+						yieldedKeys[key] = struct{}{}
+					}
+				}
 
-					// We must hide all child documents before the offset
-					for i := uint64(0); i < childSelect.Limit.Offset && i < l; i++ {
-						childDocs[i].Hidden = true
+				if childSelect.Limit != nil {
+					// Child documents already hidden are not counted towards the offset
+					// or limit
+					var visibleIndex uint64
+					for i := range childDocs {
+						if childDocs[i].Hidden {
+							continue
+						}
 
-						n.execInfo.hiddenBeforeOffset++
-					}
+						if visibleIndex < childSelect.Limit.Offset {
+							// We must hide all child documents before the offset
+							childDocs[i].Hidden = true
 
-					// We must hide all child documents after the offset plus limit
-					for i := childSelect.Limit.Limit + childSelect.Limit.Offset; i < l; i++ {
-						childDocs[i].Hidden = true
+							n.execInfo.hiddenBeforeOffset++
+						} else if visibleIndex >= childSelect.Limit.Limit+childSelect.Limit.Offset {
+							// We must hide all child documents after the offset plus limit
+							childDocs[i].Hidden = true
 
-						n.execInfo.hiddenAfterLimit++
+							n.execInfo.hiddenAfterLimit++
+						}
+						visibleIndex++
 					}
 				}
 			}
@@ -0,0 +1,485 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package planner
+
+import (
+	"bytes"
+	"sort"
+
+	"github.com/sourcenetwork/immutable"
+
+	"github.com/sourcenetwork/defradb/client"
+	"github.com/sourcenetwork/defradb/connor/fulltext"
+	"github.com/sourcenetwork/defradb/core"
+	"github.com/sourcenetwork/defradb/db/base"
+	"github.com/sourcenetwork/defradb/planner/mapper"
+)
+
+// The selectivity of the index spans that may be built from the condition on a single
+// indexed field, higher is better.
+//
+// The score of a set of spans is the sum of the scores of the fields that they are built from.
+const (
+	indexScoreNone  = 0
+	indexScoreRange = 1
+	indexScoreEq    = 2
+)
+
+// indexPlan describes how a secondary index may be used to serve a request.
+type indexPlan struct {
+	// The index to scan.
+	index client.IndexDescription
+
+	// The spans of the index that contain all the documents that may pass the filter.
+	//
+	// The spans may contain documents that do not pass the filter, the filter must still
+	// be applied to the fetched documents.
+	spans []core.IndexSpan
+
+	// If true the documents will be yielded in the order requested by the request, and
+	// no further sorting is required.
+	isOrdered bool
+
+	// If true the index should be scanned in reverse order.
+	reverse bool
+
+	// If true the documents sharing the same values for the fields of the distinct clause
+	// will be yielded together, and so may be deduplicated without remembering every value.
+	isGrouped bool
+}
+
+// findIndexPlan returns the plan for the secondary index of the given collection best suited
+// to serving the given filter, ordering and distinct clause.
+//
+// Indexes able to narrow down the documents to scan are preferred over those that are only
+// able to satisfy the ordering, which are in turn preferred over those that are only able to
+// group the documents by the distinct fields.
+//
+// Returns false if no index may be used to serve the request.
+func findIndexPlan(
+	desc client.CollectionDescription,
+	filter *mapper.Filter,
+	orderBy *mapper.OrderBy,
+	distinct *mapper.Distinct,
+	mapping *core.DocumentMapping,
+) (indexPlan, bool) {
+	if len(desc.Indexes) == 0 {
+		return indexPlan{}, false
+	}
+
+	var conditions map[string]any
+	if filter != nil {
+		conditions = filter.ExternalConditions
+	}
+	orderFields, orderDirection, hasOrder := getIndexableOrdering(orderBy, mapping)
+	// Documents will only remain grouped by the index if they are not then sorted.
+	canGroup := distinct != nil && len(distinct.Fields) > 0
+	requiresSort := orderBy != nil && len(orderBy.Conditions) > 0
+
+	var bestPlan indexPlan
+	bestScore := indexScoreNone
+	hasBestPlan := false
+	for _, index := range desc.Indexes {
+		if index.Vector {
+			continue
+		}
+		spans, fixedFields, score := getIndexSpans(desc, index, conditions)
+		// Full-text indexes are ordered by term, not by the value of the indexed field.
+		isOrdered := hasOrder && !index.FullText && isOrderedByIndex(index, orderFields, fixedFields)
+		isGrouped := canGroup && !index.FullText && (isOrdered || !requiresSort) &&
+			isGroupedByIndex(index, distinct.Fields, fixedFields)
+		if score == indexScoreNone {
+			if !isOrdered && !isGrouped {
+				continue
+			}
+			// The index cannot narrow down the documents, but may still be scanned in
+			// full to avoid sorting or deduplicating them in memory.
+			spans = []core.IndexSpan{{}}
+		}
+
+		isBetter := !hasBestPlan ||
+			score > bestScore ||
+			(score == bestScore && isOrdered && !bestPlan.isOrdered) ||
+			(score == bestScore && isOrdered == bestPlan.isOrdered && isGrouped && !bestPlan.isGrouped)
+		if !isBetter {
+			continue
+		}
+
+		bestPlan = indexPlan{
+			index:     index,
+			spans:     spans,
+			isOrdered: isOrdered,
+			reverse:   isOrdered && orderDirection == mapper.DESC,
+			isGrouped: isGrouped,
+		}
+		bestScore = score
+		hasBestPlan = true
+	}
+
+	return bestPlan, hasBestPlan
+}
+
+// findVectorIndex returns the vector index of the given collection able to serve the given
+// similarity search, if any.
+//
+// The index must measure the distance between vectors by the metric of the search.
+func findVectorIndex(
+	desc client.CollectionDescription,
+	similar *mapper.Similar,
+) (client.IndexDescription, bool) {
+	if similar == nil {
+		return client.IndexDescription{}, false
+	}
+	for _, index := range desc.Indexes {
+		if index.Vector && index.Fields[0].Name == similar.Name && index.Metric == string(similar.Metric) {
+			return index, true
+		}
+	}
+	return client.IndexDescription{}, false
+}
+
+// getIndexableOrdering returns the names of the fields by which the results should be
+// ordered, and the direction in which they should be ordered.
+//
+// Returns false if the ordering cannot be served by an index, for example if it targets
+// related objects, or mixes directions.
+func getIndexableOrdering(
+	orderBy *mapper.OrderBy,
+	mapping *core.DocumentMapping,
+) ([]string, mapper.SortDirection, bool) {
+	if orderBy == nil || len(orderBy.Conditions) == 0 {
+		return nil, "", false
+	}
+
+	direction := orderBy.Conditions[0].Direction
+	fieldNames := make([]string, len(orderBy.Conditions))
+	for i, condition := range orderBy.Conditions {
+		if len(condition.FieldIndexes) != 1 || condition.Direction != direction {
+			return nil, "", false
+		}
+		fieldName, found := mapping.TryToFindNameFromIndex(condition.FieldIndexes[0])
+		if !found {
+			return nil, "", false
+		}
+		fieldNames[i] = fieldName
+	}
+
+	return fieldNames, direction, true
+}
+
+// isOrderedByIndex returns true if scanning the given index yields the documents ordered
+// by the given fields.
+//
+// Indexed fields that are fixed to a single value by the filter do not affect the ordering
+// and may be skipped over.
+func isOrderedByIndex(
+	index client.IndexDescription,
+	orderFields []string,
+	fixedFields map[string]struct{},
+) bool {
+	orderFieldIndex := 0
+	for _, indexedField := range index.Fields {
+		if orderFieldIndex == len(orderFields) {
+			break
+		}
+		if indexedField.Name == orderFields[orderFieldIndex] {
+			orderFieldIndex++
+			continue
+		}
+		if _, isFixed := fixedFields[indexedField.Name]; isFixed {
+			continue
+		}
+		return false
+	}
+	return orderFieldIndex == len(orderFields)
+}
+
+// isGroupedByIndex returns true if scanning the given index yields the documents sharing
+// the same values for the given distinct fields together.
+//
+// This is the case if the distinct fields lead the index, in any order.  Indexed fields
+// that are fixed to a single value by the filter may be skipped over.
+func isGroupedByIndex(
+	index client.IndexDescription,
+	distinctFields []mapper.Field,
+	fixedFields map[string]struct{},
+) bool {
+	remainingFields := make(map[string]struct{}, len(distinctFields))
+	for _, field := range distinctFields {
+		if _, isFixed := fixedFields[field.Name]; !isFixed {
+			remainingFields[field.Name] = struct{}{}
+		}
+	}
+
+	for _, indexedField := range index.Fields {
+		if len(remainingFields) == 0 {
+			break
+		}
+		if _, isDistinct := remainingFields[indexedField.Name]; isDistinct {
+			delete(remainingFields, indexedField.Name)
+			continue
+		}
+		if _, isFixed := fixedFields[indexedField.Name]; isFixed {
+			continue
+		}
+		return false
+	}
+	return len(remainingFields) == 0
+}
+
+// getIndexSpans returns the spans of the given index that contain all the documents that may
+// pass the given conditions, along with the score of those spans.
+//
+// Leading indexed fields with equality conditions extend the prefix of the spans, the first
+// remaining field may then restrict the spans by range.
+//
+// The names of the indexed fields fixed to a single value by the spans are also returned.
+func getIndexSpans(
+	desc client.CollectionDescription,
+	index client.IndexDescription,
+	conditions map[string]any,
+) ([]core.IndexSpan, map[string]struct{}, int) {
+	if index.FullText {
+		spans, score := getFullTextIndexSpans(index, conditions)
+		return spans, nil, score
+	}
+
+	spans := []core.IndexSpan{{}}
+	fixedFields := map[string]struct{}{}
+	score := indexScoreNone
+
+	for _, indexedField := range index.Fields {
+		field, ok := desc.GetField(indexedField.Name)
+		if !ok {
+			break
+		}
+
+		fieldConditions, ok := conditions[field.Name].(map[string]any)
+		if !ok {
+			break
+		}
+
+		values, ok := getEqIndexValues(field.Kind, fieldConditions)
+		if ok {
+			spans = extendIndexSpans(spans, values)
+			if len(values) == 1 {
+				fixedFields[field.Name] = struct{}{}
+			}
+			score += indexScoreEq
+			continue
+		}
+
+		lower, upper := getRangeIndexBounds(field.Kind, fieldConditions)
+		if lower.HasValue() || upper.HasValue() {
+			for i := range spans {
+				spans[i].Lower = lower
+				spans[i].Upper = upper
+			}
+			score += indexScoreRange
+		}
+		break
+	}
+
+	return spans, fixedFields, score
+}
+
+// getFullTextIndexSpans returns the spans of the given full-text index that contain all the
+// documents that may pass the `_match` condition on the indexed field, along with the score
+// of those spans.
+//
+// As matching documents must contain all the terms of the condition, only the entries of
+// the first term need to be scanned.
+func getFullTextIndexSpans(
+	index client.IndexDescription,
+	conditions map[string]any,
+) ([]core.IndexSpan, int) {
+	fieldConditions, ok := conditions[index.Fields[0].Name].(map[string]any)
+	if !ok {
+		return nil, indexScoreNone
+	}
+	query, ok := fieldConditions["_match"].(string)
+	if !ok {
+		return nil, indexScoreNone
+	}
+
+	terms := fulltext.Terms(query)
+	if len(terms) == 0 {
+		// A query without any terms matches nothing.
+		return []core.IndexSpan{}, indexScoreEq
+	}
+
+	encodedTerm, err := base.EncodeIndexValue(client.FieldKind_STRING, terms[0])
+	if err != nil {
+		return nil, indexScoreNone
+	}
+	return []core.IndexSpan{{Prefix: [][]byte{encodedTerm}}}, indexScoreEq
+}
+
+// getEqIndexValues returns the distinct encoded values, in index order, that the field must
+// equal in order to pass the given conditions.
+//
+// Returns false if the conditions do not restrict the field to a set of values.
+func getEqIndexValues(kind client.FieldKind, fieldConditions map[string]any) ([][]byte, bool) {
+	if value, ok := fieldConditions["_eq"]; ok {
+		encodedValue, err := base.EncodeIndexValue(kind, value)
+		if err == nil {
+			return [][]byte{encodedValue}, true
+		}
+	}
+
+	if values, ok := fieldConditions["_in"].([]any); ok {
+		encodedValues, err := getInIndexValues(kind, values)
+		if err == nil {
+			return encodedValues, true
+		}
+	}
+
+	return nil, false
+}
+
+// getRangeIndexBounds returns the inclusive bounds that the encoded value of the field must be
+// within in order to pass the given conditions.
+func getRangeIndexBounds(
+	kind client.FieldKind,
+	fieldConditions map[string]any,
+) (immutable.Option[[]byte], immutable.Option[[]byte]) {
+	var lower, upper immutable.Option[[]byte]
+
+	for _, op := range []string{"_gt", "_ge"} {
+		value, ok := fieldConditions[op]
+		if !ok || value == nil {
+			continue
+		}
+		// Bounds are inclusive, the filter will exclude any equal values for `_gt`.
+		encodedValue, err := base.EncodeIndexValue(kind, value)
+		if err != nil {
+			continue
+		}
+		if !lower.HasValue() || bytes.Compare(encodedValue, lower.Value()) > 0 {
+			lower = immutable.Some(encodedValue)
+		}
+	}
+	for _, op := range []string{"_lt", "_le"} {
+		value, ok := fieldConditions[op]
+		if !ok || value == nil {
+			continue
+		}
+		encodedValue, err := base.EncodeIndexValue(kind, value)
+		if err != nil {
+			continue
+		}
+		if !upper.HasValue() || bytes.Compare(encodedValue, upper.Value()) < 0 {
+			upper = immutable.Some(encodedValue)
+		}
+	}
+
+	return lower, upper
+}
+
+// extendIndexSpans returns a span for each combination of the given span prefixes and values,
+// preserving index order.
+func extendIndexSpans(spans []core.IndexSpan, values [][]byte) []core.IndexSpan {
+	extendedSpans := make([]core.IndexSpan, 0, len(spans)*len(values))
+	for _, span := range spans {
+		for _, value := range values {
+			prefix := make([][]byte, len(span.Prefix), len(span.Prefix)+1)
+			copy(prefix, span.Prefix)
+			extendedSpans = append(extendedSpans, core.IndexSpan{Prefix: append(prefix, value)})
+		}
+	}
+	return extendedSpans
+}
+
+// getInIndexValues returns the distinct given values encoded, in index order.
+func getInIndexValues(kind client.FieldKind, values []any) ([][]byte, error) {
+	encodedValues := make([][]byte, 0, len(values))
+	for _, value := range values {
+		encodedValue, err := base.EncodeIndexValue(kind, value)
+		if err != nil {
+			return nil, err
+		}
+		encodedValues = append(encodedValues, encodedValue)
+	}
+
+	sort.Slice(encodedValues, func(i, j int) bool {
+		return bytes.Compare(encodedValues[i], encodedValues[j]) < 0
+	})
+
+	distinctValues := make([][]byte, 0, len(encodedValues))
+	for i, encodedValue := range encodedValues {
+		if i > 0 && bytes.Equal(encodedValue, encodedValues[i-1]) {
+			continue
+		}
+		distinctValues = append(distinctValues, encodedValue)
+	}
+	return distinctValues, nil
+}
+
+// matchCondition is a full-text search condition on a field.
+type matchCondition struct {
+	fieldName string
+	query     string
+}
+
+// getMatchConditions returns the full-text search conditions of the given filter conditions,
+// including those nested within `_and` and `_or` conditions.
+func getMatchConditions(conditions map[string]any) []matchCondition {
+	var matchConditions []matchCondition
+	for key, value := range conditions {
+		switch key {
+		case "_and", "_or":
+			innerConditions, ok := value.([]any)
+			if !ok {
+				continue
+			}
+			for _, innerCondition := range innerConditions {
+				if innerConditionMap, ok := innerCondition.(map[string]any); ok {
+					matchConditions = append(matchConditions, getMatchConditions(innerConditionMap)...)
+				}
+			}
+
+		default:
+			fieldConditions, ok := value.(map[string]any)
+			if !ok {
+				continue
+			}
+			if query, ok := fieldConditions["_match"].(string); ok {
+				matchConditions = append(matchConditions, matchCondition{
+					fieldName: key,
+					query:     query,
+				})
+			}
+		}
+	}
+	return matchConditions
+}
+
+// score returns the relevance of the given document to the full-text search conditions of
+// the scan, the sum of its relevance to each condition.
+//
+// Returns nil if there are no full-text search conditions.
+func (n *scanNode) score(doc core.Doc) any {
+	if len(n.matchConditions) == 0 {
+		return nil
+	}
+
+	var score float64
+	for _, condition := range n.matchConditions {
+		indexes, ok := n.documentMapping.IndexesByName[condition.fieldName]
+		if !ok {
+			continue
+		}
+		if text, ok := doc.Fields[indexes[0]].(string); ok {
+			score += fulltext.Score(text, condition.query)
+		}
+	}
+	return score
+}
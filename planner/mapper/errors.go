@@ -12,8 +12,19 @@ package mapper
 
 import "github.com/sourcenetwork/defradb/errors"
 
+const (
+	errInvalidDistinctField string = "distinct may only be applied to fields of the selected type"
+)
+
 var (
 	ErrUnableToIdAggregateChild = errors.New("unable to identify aggregate child")
 	ErrAggregateTargetMissing   = errors.New("aggregate must be provided with a property to aggregate")
 	ErrFailedToFindHostField    = errors.New("failed to find host field")
+	ErrInvalidDistinctField     = errors.New(errInvalidDistinctField)
 )
+
+// NewErrInvalidDistinctField returns an error indicating that the given field cannot be
+// used to determine whether results are distinct, for example as it is a related object.
+func NewErrInvalidDistinctField(name string) error {
+	return errors.New(errInvalidDistinctField, errors.NewKV("Field", name))
+}
@@ -13,6 +13,7 @@ package mapper
 import (
 	"context"
 	"reflect"
+	"regexp"
 	"strings"
 
 	"github.com/sourcenetwork/immutable"
@@ -68,6 +69,10 @@ func toSelect(
 	}
 	fields = append(fields, filterDependencies...)
 
+	if err := validateDistinct(selectRequest.Distinct, mapping); err != nil {
+		return nil, err
+	}
+
 	// Resolve order dependencies that may have been missed due to not being rendered.
 	if err := resolveOrderDependencies(
 		descriptionsRepo, collectionName, selectRequest.OrderBy, mapping, &fields); err != nil {
@@ -95,8 +100,13 @@ func toSelect(
 		}
 	}
 
+	targetable, err := toTargetable(thisIndex, selectRequest, mapping)
+	if err != nil {
+		return nil, err
+	}
+
 	return &Select{
-		Targetable:      toTargetable(thisIndex, selectRequest, mapping),
+		Targetable:      targetable,
 		DocumentMapping: *mapping,
 		Cid:             selectRequest.CID,
 		CollectionName:  collectionName,
@@ -199,25 +209,38 @@ func resolveAggregates(
 					// If the hostExternalName matches a non-object field
 					// we don't have to search for it and can just construct the
 					// targeting info here.
+					filter, err := ToFilter(target.filter, mapping)
+					if err != nil {
+						return nil, err
+					}
 					hasHost = true
 					host = &Targetable{
 						Field: Field{
 							Index: int(fieldDesc.ID),
 							Name:  target.hostExternalName,
 						},
-						Filter:  ToFilter(target.filter, mapping),
-						Limit:   target.limit,
-						OrderBy: order,
+						Filter:   filter,
+						Limit:    target.limit,
+						OrderBy:  order,
+						Distinct: toDistinct(target.distinct, mapping),
 					}
 				} else {
 					childObjectIndex := mapping.FirstIndexOfName(target.hostExternalName)
 					childMapping := mapping.ChildMappings[childObjectIndex]
-					convertedFilter = ToFilter(target.filter, childMapping)
+					if err := validateDistinct(target.distinct, childMapping); err != nil {
+						return nil, err
+					}
+					var err error
+					convertedFilter, err = ToFilter(target.filter, childMapping)
+					if err != nil {
+						return nil, err
+					}
 					host, hasHost = tryGetTarget(
 						target.hostExternalName,
 						convertedFilter,
 						target.limit,
 						toOrderBy(target.order, childMapping),
+						toDistinct(target.distinct, childMapping),
 						fields,
 					)
 				}
@@ -254,10 +277,17 @@ func resolveAggregates(
 				childMapping = childMapping.CloneWithoutRender()
 				mapping.SetChildAt(index, childMapping)
 
+				if err := validateDistinct(target.distinct, childMapping); err != nil {
+					return nil, err
+				}
+
 				if !childIsMapped {
 					// If the child was not mapped, the filter will not have been converted yet
 					// so we must do that now.
-					convertedFilter = ToFilter(target.filter, mapping.ChildMappings[index])
+					convertedFilter, err = ToFilter(target.filter, mapping.ChildMappings[index])
+					if err != nil {
+						return nil, err
+					}
 				}
 
 				dummyJoin := &Select{
@@ -266,9 +296,10 @@ func resolveAggregates(
 							Index: index,
 							Name:  target.hostExternalName,
 						},
-						Filter:  convertedFilter,
-						Limit:   target.limit,
-						OrderBy: toOrderBy(target.order, childMapping),
+						Filter:   convertedFilter,
+						Limit:    target.limit,
+						OrderBy:  toOrderBy(target.order, childMapping),
+						Distinct: toDistinct(target.distinct, childMapping),
 					},
 					CollectionName:  childCollectionName,
 					DocumentMapping: *childMapping,
@@ -507,6 +538,28 @@ func getRequestables(
 				Key:   getRenderKey(f),
 			})
 		case *request.Select:
+			if fieldDesc, isField := desc.GetField(f.Name); isField && fieldDesc.IsEmbedded() {
+				// Embedded objects are stored inline, and so are rendered from the fields
+				// already mapped onto this object instead of from a child select.
+				index := mapping.FirstIndexOfName(f.Name)
+				embeddedMapping, err := toEmbeddedMapping(f, mapping, f.Name)
+				if err != nil {
+					return nil, nil, err
+				}
+
+				fields = append(fields, &Field{
+					Index: index,
+					Name:  f.Name,
+				})
+				mapping.SetEmbeddedAt(index, embeddedMapping)
+
+				mapping.RenderKeys = append(mapping.RenderKeys, core.RenderKey{
+					Index: index,
+					Key:   getRenderKey(&f.Field),
+				})
+				continue
+			}
+
 			index := mapping.GetNextIndex()
 
 			innerSelect, err := toSelect(descriptionsRepo, index, f, desc.Name)
@@ -544,6 +597,47 @@ func getRequestables(
 	return
 }
 
+// toEmbeddedMapping returns the render mapping of the given selection of the embedded object
+// held by the field at the given path, whose render keys index the given host mapping.
+func toEmbeddedMapping(
+	selectRequest *request.Select,
+	hostMapping *core.DocumentMapping,
+	path string,
+) (*core.DocumentMapping, error) {
+	embeddedMapping := core.NewDocumentMapping()
+	for _, field := range selectRequest.Fields {
+		var f *request.Field
+		switch typedField := field.(type) {
+		case *request.Field:
+			f = typedField
+		case *request.Select:
+			f = &typedField.Field
+		default:
+			return nil, client.NewErrUnhandledType("field", field)
+		}
+
+		name := path + client.EmbeddedFieldSeparator + f.Name
+		indexes, isMapped := hostMapping.IndexesByName[name]
+		if !isMapped {
+			return nil, client.NewErrFieldNotExist(name)
+		}
+
+		if innerSelect, isSelect := field.(*request.Select); isSelect {
+			innerMapping, err := toEmbeddedMapping(innerSelect, hostMapping, name)
+			if err != nil {
+				return nil, err
+			}
+			embeddedMapping.SetEmbeddedAt(indexes[0], innerMapping)
+		}
+
+		embeddedMapping.RenderKeys = append(embeddedMapping.RenderKeys, core.RenderKey{
+			Index: indexes[0],
+			Key:   getRenderKey(f),
+		})
+	}
+	return embeddedMapping, nil
+}
+
 func getRenderKey(field *request.Field) string {
 	if field.Alias.HasValue() {
 		return field.Alias.Value()
@@ -643,6 +737,18 @@ func getTopLevelInfo(
 
 		mapping.Add(mapping.GetNextIndex(), request.DeletedFieldName)
 
+		if desc.HasFullTextIndex() {
+			mapping.Add(mapping.GetNextIndex(), request.ScoreFieldName)
+		}
+
+		if desc.HasVectorField() {
+			mapping.Add(mapping.GetNextIndex(), request.DistanceFieldName)
+		}
+
+		if desc.HasMVRegisterField() {
+			mapping.Add(mapping.GetNextIndex(), request.ConflictsFieldName)
+		}
+
 		return mapping, &desc, nil
 	}
 
@@ -698,6 +804,19 @@ func resolveInnerFilterDependencies(
 
 	for key := range source {
 		if strings.HasPrefix(key, "_") && key != request.KeyFieldName {
+			// Compound operators hold conditions on the same object, which may depend on
+			// relations too.
+			innerFields, err := resolveCompoundFilterDependencies(
+				descriptionsRepo,
+				parentCollectionName,
+				source[key],
+				mapping,
+				concatRequestables(newFields, existingFields),
+			)
+			if err != nil {
+				return nil, err
+			}
+			newFields = append(newFields, innerFields...)
 			continue
 		}
 
@@ -817,6 +936,53 @@ func resolveInnerFilterDependencies(
 	return newFields, nil
 }
 
+// resolveCompoundFilterDependencies returns the fields required by the conditions held by a
+// compound filter operator, such as `_and`, `_or` or `_not`.
+//
+// The clauses of other operators hold no conditions, and so have no dependencies.
+func resolveCompoundFilterDependencies(
+	descriptionsRepo *DescriptionsRepo,
+	parentCollectionName string,
+	clause any,
+	mapping *core.DocumentMapping,
+	existingFields []Requestable,
+) ([]Requestable, error) {
+	var innerSources []map[string]any
+	switch typedClause := clause.(type) {
+	case []any:
+		for _, innerClause := range typedClause {
+			if innerSource, isMap := innerClause.(map[string]any); isMap {
+				innerSources = append(innerSources, innerSource)
+			}
+		}
+	case map[string]any:
+		innerSources = append(innerSources, typedClause)
+	}
+
+	newFields := []Requestable{}
+	for _, innerSource := range innerSources {
+		innerFields, err := resolveInnerFilterDependencies(
+			descriptionsRepo,
+			parentCollectionName,
+			innerSource,
+			mapping,
+			concatRequestables(newFields, existingFields),
+		)
+		if err != nil {
+			return nil, err
+		}
+		newFields = append(newFields, innerFields...)
+	}
+	return newFields, nil
+}
+
+// concatRequestables returns a new slice of the given requestables.
+func concatRequestables(a []Requestable, b []Requestable) []Requestable {
+	result := make([]Requestable, 0, len(a)+len(b))
+	result = append(result, a...)
+	return append(result, b...)
+}
+
 // ToCommitSelect converts the given [request.CommitSelect] into a [CommitSelect].
 //
 // In the process of doing so it will construct the document map required to access the data
@@ -850,22 +1016,29 @@ func ToMutation(ctx context.Context, txn datastore.Txn, mutationRequest *request
 	}
 
 	return &Mutation{
-		Select: *underlyingSelect,
-		Type:   MutationType(mutationRequest.Type),
-		Data:   mutationRequest.Data,
+		Select:  *underlyingSelect,
+		Type:    MutationType(mutationRequest.Type),
+		Data:    mutationRequest.Data,
+		Message: mutationRequest.Message,
 	}, nil
 }
 
-func toTargetable(index int, selectRequest *request.Select, docMap *core.DocumentMapping) Targetable {
+func toTargetable(index int, selectRequest *request.Select, docMap *core.DocumentMapping) (Targetable, error) {
+	filter, err := ToFilter(selectRequest.Filter, docMap)
+	if err != nil {
+		return Targetable{}, err
+	}
 	return Targetable{
 		Field:       toField(index, selectRequest),
 		DocKeys:     selectRequest.DocKeys,
-		Filter:      ToFilter(selectRequest.Filter, docMap),
+		Filter:      filter,
 		Limit:       toLimit(selectRequest.Limit, selectRequest.Offset),
 		GroupBy:     toGroupBy(selectRequest.GroupBy, docMap),
 		OrderBy:     toOrderBy(selectRequest.OrderBy, docMap),
+		Distinct:    toDistinct(selectRequest.Distinct, docMap),
+		Similar:     toSimilar(selectRequest.Similar, docMap),
 		ShowDeleted: selectRequest.ShowDeleted,
-	}
+	}, nil
 }
 
 func toField(index int, selectRequest *request.Select) Field {
@@ -878,21 +1051,24 @@ func toField(index int, selectRequest *request.Select) Field {
 // ToFilter converts the given `source` request filter to a Filter using the given mapping.
 //
 // Any requestables identified by name will be converted to being identified by index instead.
-func ToFilter(source immutable.Option[request.Filter], mapping *core.DocumentMapping) *Filter {
+func ToFilter(source immutable.Option[request.Filter], mapping *core.DocumentMapping) (*Filter, error) {
 	if !source.HasValue() {
-		return nil
+		return nil, nil
 	}
 	conditions := make(map[connor.FilterKey]any, len(source.Value().Conditions))
 
 	for sourceKey, sourceClause := range source.Value().Conditions {
-		key, clause := toFilterMap(sourceKey, sourceClause, mapping)
+		key, clause, err := toFilterMap(sourceKey, sourceClause, mapping)
+		if err != nil {
+			return nil, err
+		}
 		conditions[key] = clause
 	}
 
 	return &Filter{
 		Conditions:         conditions,
 		ExternalConditions: source.Value().Conditions,
-	}
+	}, nil
 }
 
 // toFilterMap converts a consumer-defined filter key-value into a filter clause
@@ -903,7 +1079,7 @@ func toFilterMap(
 	sourceKey string,
 	sourceClause any,
 	mapping *core.DocumentMapping,
-) (connor.FilterKey, any) {
+) (connor.FilterKey, any, error) {
 	if strings.HasPrefix(sourceKey, "_") && sourceKey != request.KeyFieldName {
 		key := &Operator{
 			Operation: sourceKey,
@@ -918,7 +1094,10 @@ func toFilterMap(
 				case map[string]any:
 					innerMapClause := map[connor.FilterKey]any{}
 					for innerSourceKey, innerSourceValue := range typedInnerSourceClause {
-						rKey, rValue := toFilterMap(innerSourceKey, innerSourceValue, mapping)
+						rKey, rValue, err := toFilterMap(innerSourceKey, innerSourceValue, mapping)
+						if err != nil {
+							return nil, nil, err
+						}
 						innerMapClause[rKey] = rValue
 					}
 					returnClause = innerMapClause
@@ -927,11 +1106,42 @@ func toFilterMap(
 				}
 				returnClauses = append(returnClauses, returnClause)
 			}
-			return key, returnClauses
+			return key, returnClauses, nil
+		case map[string]any:
+			if !hasInnerConditions(sourceKey) {
+				// Other operators may compare against object values, such as those of JSON fields.
+				return key, typedClause, nil
+			}
+			returnClause := map[connor.FilterKey]any{}
+			for innerSourceKey, innerSourceValue := range typedClause {
+				rKey, rValue, err := toFilterMap(innerSourceKey, innerSourceValue, mapping)
+				if err != nil {
+					return nil, nil, err
+				}
+				returnClause[rKey] = rValue
+			}
+			return key, returnClause, nil
+		case string:
+			if sourceKey == "_regex" {
+				// Compile the pattern once here rather than once per document matched, which
+				// also rejects invalid patterns even if no document is matched.
+				re, err := regexp.Compile(typedClause)
+				if err != nil {
+					return nil, nil, connor.NewErrInvalidRegex(typedClause, err)
+				}
+				return key, re, nil
+			}
+			return key, typedClause, nil
 		default:
-			return key, typedClause
+			return key, typedClause, nil
 		}
 	} else {
+		if embeddedClause, isMap := sourceClause.(map[string]any); isMap && isEmbedded(sourceKey, mapping) {
+			// The fields of embedded objects are stored inline, so their conditions are
+			// matched against the fields of this object as a single `_and` clause.
+			return toFilterMap("_and", []any{prefixFilterKeys(sourceKey, embeddedClause)}, mapping)
+		}
+
 		// If there are multiple properties of the same name we can just take the first as
 		// we have no other reasonable way of identifying which property they mean if multiple
 		// consumer specified requestables are available.  Aggregate dependencies should not
@@ -950,20 +1160,85 @@ func toFilterMap(
 					// If the innerSourceValue is also a map, then we should parse the nested clause
 					// using the child mapping, as this key must refer to a host property in a join
 					// and deeper keys must refer to properties on the child items.
-					innerMapping = mapping.ChildMappings[index]
+					//
+					// Properties that are not joins, such as JSON fields, have no child mapping and
+					// the map is an operator's value.
+					if index < len(mapping.ChildMappings) {
+						innerMapping = mapping.ChildMappings[index]
+					} else {
+						innerMapping = mapping
+					}
 				default:
 					innerMapping = mapping
 				}
-				rKey, rValue := toFilterMap(innerSourceKey, innerSourceValue, innerMapping)
+				rKey, rValue, err := toFilterMap(innerSourceKey, innerSourceValue, innerMapping)
+				if err != nil {
+					return nil, nil, err
+				}
 				returnClause[rKey] = rValue
 			}
-			return key, returnClause
+			return key, returnClause, nil
 		default:
-			return key, sourceClause
+			return key, sourceClause, nil
 		}
 	}
 }
 
+// hasInnerConditions returns true if the clause of the given operator holds conditions, either
+// on the same object, as `_not` does, or on the items of an array, as `_any`, `_all` and
+// `_none` do.
+func hasInnerConditions(operator string) bool {
+	switch operator {
+	case "_not", "_any", "_all", "_none":
+		return true
+	default:
+		return false
+	}
+}
+
+// isEmbedded returns true if the property of the given name is an embedded object, whose
+// fields are mapped onto the given mapping named by their path from it.
+func isEmbedded(name string, mapping *core.DocumentMapping) bool {
+	prefix := name + client.EmbeddedFieldSeparator
+	for fieldName := range mapping.IndexesByName {
+		if strings.HasPrefix(fieldName, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// prefixFilterKeys returns the given conditions on the fields of the embedded object of the
+// given name, with the fields named by their path from the object holding it.
+func prefixFilterKeys(name string, conditions map[string]any) map[string]any {
+	result := make(map[string]any, len(conditions))
+	for key, clause := range conditions {
+		if !strings.HasPrefix(key, "_") {
+			result[name+client.EmbeddedFieldSeparator+key] = clause
+			continue
+		}
+
+		// Compound operators hold conditions on the same fields.
+		switch typedClause := clause.(type) {
+		case []any:
+			innerClauses := make([]any, len(typedClause))
+			for i, innerClause := range typedClause {
+				if innerConditions, isMap := innerClause.(map[string]any); isMap {
+					innerClauses[i] = prefixFilterKeys(name, innerConditions)
+				} else {
+					innerClauses[i] = innerClause
+				}
+			}
+			result[key] = innerClauses
+		case map[string]any:
+			result[key] = prefixFilterKeys(name, typedClause)
+		default:
+			result[key] = clause
+		}
+	}
+	return result
+}
+
 func toLimit(limit immutable.Option[uint64], offset immutable.Option[uint64]) *Limit {
 	var limitValue uint64
 	var offsetValue uint64
@@ -1009,6 +1284,45 @@ func toGroupBy(source immutable.Option[request.GroupBy], mapping *core.DocumentM
 	}
 }
 
+func toDistinct(source immutable.Option[request.Distinct], mapping *core.DocumentMapping) *Distinct {
+	if !source.HasValue() {
+		return nil
+	}
+
+	fields := make([]Field, len(source.Value().Fields))
+	for i, fieldName := range source.Value().Fields {
+		// As with groupBy, if there are multiple properties of the same name we take the first.
+		fields[i] = Field{
+			Index: mapping.FirstIndexOfName(fieldName),
+			Name:  fieldName,
+		}
+	}
+
+	return &Distinct{
+		Fields: fields,
+	}
+}
+
+// validateDistinct returns an error if any of the fields of the given distinct clause cannot
+// be compared, for example if they are related objects.
+func validateDistinct(source immutable.Option[request.Distinct], mapping *core.DocumentMapping) error {
+	if !source.HasValue() {
+		return nil
+	}
+
+	for _, fieldName := range source.Value().Fields {
+		indexes := mapping.IndexesByName[fieldName]
+		if len(indexes) == 0 {
+			return NewErrInvalidDistinctField(fieldName)
+		}
+		if indexes[0] < len(mapping.ChildMappings) && mapping.ChildMappings[indexes[0]] != nil {
+			return NewErrInvalidDistinctField(fieldName)
+		}
+	}
+
+	return nil
+}
+
 func toOrderBy(source immutable.Option[request.OrderBy], mapping *core.DocumentMapping) *OrderBy {
 	if !source.HasValue() {
 		return nil
@@ -1016,16 +1330,24 @@ func toOrderBy(source immutable.Option[request.OrderBy], mapping *core.DocumentM
 
 	conditions := make([]OrderCondition, len(source.Value().Conditions))
 	for conditionIndex, condition := range source.Value().Conditions {
-		fieldIndexes := make([]int, len(condition.Fields))
+		fieldIndexes := make([]int, 0, len(condition.Fields))
 		currentMapping := mapping
-		for fieldIndex, field := range condition.Fields {
+		for i := 0; i < len(condition.Fields); i++ {
+			field := condition.Fields[i]
+			// The fields of embedded objects are stored inline, and are mapped onto the
+			// object holding them named by their path from it.
+			for i != len(condition.Fields)-1 && isEmbedded(field, currentMapping) {
+				i++
+				field += client.EmbeddedFieldSeparator + condition.Fields[i]
+			}
+
 			// If there are multiple properties of the same name we can just take the first as
 			// we have no other reasonable way of identifying which property they mean if multiple
 			// consumer specified requestables are available.  Aggregate dependencies should not
 			// impact this as they are added after selects.
 			firstFieldIndex := currentMapping.FirstIndexOfName(field)
-			fieldIndexes[fieldIndex] = firstFieldIndex
-			if fieldIndex != len(condition.Fields)-1 {
+			fieldIndexes = append(fieldIndexes, firstFieldIndex)
+			if i != len(condition.Fields)-1 {
 				// no need to do this for the last (and will panic)
 				currentMapping = currentMapping.ChildMappings[firstFieldIndex]
 			}
@@ -1042,6 +1364,22 @@ func toOrderBy(source immutable.Option[request.OrderBy], mapping *core.DocumentM
 	}
 }
 
+func toSimilar(source immutable.Option[request.Similar], mapping *core.DocumentMapping) *Similar {
+	if !source.HasValue() {
+		return nil
+	}
+
+	return &Similar{
+		Field: Field{
+			Index: mapping.FirstIndexOfName(source.Value().Field),
+			Name:  source.Value().Field,
+		},
+		Vector: source.Value().Vector,
+		K:      source.Value().K,
+		Metric: source.Value().Metric,
+	}
+}
+
 // RunFilter runs the given filter expression
 // using the document, and evaluates.
 func RunFilter(doc any, filter *Filter) (bool, error) {
@@ -1071,6 +1409,10 @@ func (s Targetable) equal(other Targetable) bool {
 		return false
 	}
 
+	if !s.Distinct.equal(other.Distinct) {
+		return false
+	}
+
 	return true
 }
 
@@ -1086,6 +1428,28 @@ func (l *Limit) equal(other *Limit) bool {
 	return l.Limit == other.Limit && l.Offset == other.Offset
 }
 
+func (d *Distinct) equal(other *Distinct) bool {
+	if d == nil {
+		return other == nil
+	}
+
+	if other == nil {
+		return d == nil
+	}
+
+	if len(d.Fields) != len(other.Fields) {
+		return false
+	}
+
+	for i, field := range d.Fields {
+		if field.Index != other.Fields[i].Index {
+			return false
+		}
+	}
+
+	return true
+}
+
 func (f *Filter) equal(other *Filter) bool {
 	if f == nil {
 		return other == nil
@@ -1212,6 +1576,10 @@ type aggregateRequestTarget struct {
 	// The order in which items should be aggregated. Affects results when used with
 	// limit. Optional.
 	order immutable.Option[request.OrderBy]
+
+	// The fields by which items should be considered distinct, only one of each set of
+	// items sharing the same values is aggregated. Optional.
+	distinct immutable.Option[request.Distinct]
 }
 
 // Returns the source of the aggregate as requested by the consumer
@@ -1225,6 +1593,7 @@ func getAggregateSources(field *request.Aggregate) ([]*aggregateRequestTarget, e
 			filter:            target.Filter,
 			limit:             toLimit(target.Limit, target.Offset),
 			order:             target.OrderBy,
+			distinct:          target.Distinct,
 		}
 	}
 
@@ -1292,15 +1661,17 @@ func tryGetTarget(
 	filter *Filter,
 	limit *Limit,
 	order *OrderBy,
+	distinct *Distinct,
 	collection []Requestable,
 ) (Requestable, bool) {
 	dummyTarget := Targetable{
 		Field: Field{
 			Name: name,
 		},
-		Filter:  filter,
-		Limit:   limit,
-		OrderBy: order,
+		Filter:   filter,
+		Limit:    limit,
+		OrderBy:  order,
+		Distinct: distinct,
 	}
 
 	for _, field := range collection {
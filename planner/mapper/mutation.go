@@ -17,6 +17,7 @@ const (
 	CreateObjects
 	UpdateObjects
 	DeleteObjects
+	IncrementObjects
 )
 
 // Mutation represents a request to mutate data stored in Defra.
@@ -30,6 +31,9 @@ type Mutation struct {
 	// The data to be used for the mutation.  For example, during a create this
 	// will be the json representation of the object to be inserted.
 	Data string
+
+	// The message recorded in the commits made by the mutation, if any.
+	Message string
 }
 
 func (m *Mutation) CloneTo(index int) Requestable {
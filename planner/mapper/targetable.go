@@ -13,6 +13,7 @@ package mapper
 import (
 	"github.com/sourcenetwork/immutable"
 
+	"github.com/sourcenetwork/defradb/client/request"
 	"github.com/sourcenetwork/defradb/connor"
 	"github.com/sourcenetwork/defradb/core"
 )
@@ -103,6 +104,15 @@ type GroupBy struct {
 	Fields []Field
 }
 
+// Distinct represents an instruction to yield only the first of each set of results
+// that share the same values for a set of fields.
+type Distinct struct {
+	// The indexes of fields by which results are considered distinct.
+	//
+	// This is empty for inline arrays, the items themselves are then compared.
+	Fields []Field
+}
+
 type SortDirection string
 
 const (
@@ -126,6 +136,22 @@ type OrderBy struct {
 	Conditions []OrderCondition
 }
 
+// Similar represents a nearest-neighbour search, restricting the results of a request to
+// the K documents with the values of a vector field nearest to a given vector.
+type Similar struct {
+	// The vector field by which documents are searched.
+	Field
+
+	// The vector that the field values are compared to.
+	Vector []float64
+
+	// The maximum number of documents to return.
+	K uint64
+
+	// The metric by which the distance between vectors is measured.
+	Metric request.SimilarityMetric
+}
+
 // Targetable represents a targetable property.
 type Targetable struct {
 	// The basic field information of this property.
@@ -151,6 +177,14 @@ type Targetable struct {
 	// value
 	OrderBy *OrderBy
 
+	// An optional distinct clause, that can be specified to yield only the first of
+	// each set of results sharing the same property values.
+	Distinct *Distinct
+
+	// An optional nearest-neighbour search, that can be specified to restrict results
+	// to the documents most similar to a given vector.
+	Similar *Similar
+
 	ShowDeleted bool
 }
 
@@ -162,6 +196,8 @@ func (t *Targetable) cloneTo(index int) *Targetable {
 		Limit:       t.Limit,
 		GroupBy:     t.GroupBy,
 		OrderBy:     t.OrderBy,
+		Distinct:    t.Distinct,
+		Similar:     t.Similar,
 		ShowDeleted: t.ShowDeleted,
 	}
 }
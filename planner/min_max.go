@@ -0,0 +1,354 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package planner
+
+import (
+	"strings"
+	"time"
+
+	"github.com/sourcenetwork/immutable"
+	"github.com/sourcenetwork/immutable/enumerable"
+
+	"github.com/sourcenetwork/defradb/client"
+	"github.com/sourcenetwork/defradb/client/request"
+	"github.com/sourcenetwork/defradb/connor/numbers"
+	"github.com/sourcenetwork/defradb/core"
+	"github.com/sourcenetwork/defradb/planner/mapper"
+)
+
+// minMaxNode is the plan node of the min and max aggregates, which yield the least
+// and greatest value of their targets respectively.
+type minMaxNode struct {
+	documentIterator
+	docMapper
+
+	p    *Planner
+	plan planNode
+
+	// isMax is true if this node yields the greatest value, instead of the least.
+	isMax             bool
+	virtualFieldIndex int
+	aggregateMapping  []mapper.AggregateTarget
+	// targetKinds holds the kind of the values of each of the aggregate targets.
+	targetKinds []client.FieldKind
+
+	execInfo minMaxExecInfo
+}
+
+type minMaxExecInfo struct {
+	// Total number of times minMaxNode was executed.
+	iterations uint64
+}
+
+func (p *Planner) Min(field *mapper.Aggregate, parent *mapper.Select) (*minMaxNode, error) {
+	return p.newMinMaxNode(field, parent, false)
+}
+
+func (p *Planner) Max(field *mapper.Aggregate, parent *mapper.Select) (*minMaxNode, error) {
+	return p.newMinMaxNode(field, parent, true)
+}
+
+func (p *Planner) newMinMaxNode(
+	field *mapper.Aggregate,
+	parent *mapper.Select,
+	isMax bool,
+) (*minMaxNode, error) {
+	targetKinds := make([]client.FieldKind, len(field.AggregateTargets))
+	for i, target := range field.AggregateTargets {
+		kind, err := p.getMinMaxKind(parent, &target)
+		if err != nil {
+			return nil, err
+		}
+		targetKinds[i] = kind
+	}
+
+	return &minMaxNode{
+		p:                 p,
+		isMax:             isMax,
+		aggregateMapping:  field.AggregateTargets,
+		targetKinds:       targetKinds,
+		virtualFieldIndex: field.Index,
+		docMapper:         docMapper{&field.DocumentMapping},
+	}, nil
+}
+
+// Returns the kind of the values of which the least or greatest is to be found.
+func (p *Planner) getMinMaxKind(
+	parent *mapper.Select,
+	source *mapper.AggregateTarget,
+) (client.FieldKind, error) {
+	if !source.ChildTarget.HasValue {
+		parentDescription, err := p.getCollectionDesc(parent.CollectionName)
+		if err != nil {
+			return client.FieldKind_None, err
+		}
+
+		fieldDescription, fieldDescriptionFound := parentDescription.GetField(source.Name)
+		if !fieldDescriptionFound {
+			return client.FieldKind_None, client.NewErrFieldNotExist(source.Name)
+		}
+		return fieldDescription.Kind, nil
+	}
+
+	switch source.ChildTarget.Name {
+	case request.CountFieldName:
+		return client.FieldKind_INT, nil
+	case request.AverageFieldName:
+		return client.FieldKind_FLOAT, nil
+	}
+
+	child, isChildSelect := parent.FieldAt(source.Index).AsSelect()
+	if !isChildSelect {
+		return client.FieldKind_None, ErrMissingChildSelect
+	}
+
+	if _, isAggregate := request.Aggregates[source.ChildTarget.Name]; isAggregate {
+		// If we are aggregating an aggregate, the values are of the kind of the root field
+		// of the aggregation chain.
+		sourceField := child.FieldAt(source.ChildTarget.Index).(*mapper.Aggregate)
+		if len(sourceField.AggregateTargets) == 0 {
+			return client.FieldKind_None, nil
+		}
+		return p.getMinMaxKind(child, &sourceField.AggregateTargets[0])
+	}
+
+	childCollectionDescription, err := p.getCollectionDesc(child.CollectionName)
+	if err != nil {
+		return client.FieldKind_None, err
+	}
+
+	fieldDescription, fieldDescriptionFound := childCollectionDescription.GetField(source.ChildTarget.Name)
+	if !fieldDescriptionFound {
+		return client.FieldKind_None, client.NewErrFieldNotExist(source.ChildTarget.Name)
+	}
+	return fieldDescription.Kind, nil
+}
+
+func (n *minMaxNode) Kind() string {
+	if n.isMax {
+		return "maxNode"
+	}
+	return "minNode"
+}
+
+func (n *minMaxNode) Init() error {
+	return n.plan.Init()
+}
+
+func (n *minMaxNode) Start() error { return n.plan.Start() }
+
+func (n *minMaxNode) Spans(spans core.Spans) { n.plan.Spans(spans) }
+
+func (n *minMaxNode) Close() error { return n.plan.Close() }
+
+func (n *minMaxNode) Source() planNode { return n.plan }
+
+func (n *minMaxNode) simpleExplain() (map[string]any, error) {
+	sourceExplanations := make([]map[string]any, len(n.aggregateMapping))
+
+	for i, source := range n.aggregateMapping {
+		simpleExplainMap := map[string]any{}
+
+		// Add the filter attribute if it exists.
+		if source.Filter == nil || source.Filter.ExternalConditions == nil {
+			simpleExplainMap[filterLabel] = nil
+		} else {
+			simpleExplainMap[filterLabel] = source.Filter.ExternalConditions
+		}
+
+		// Add the main field name.
+		simpleExplainMap[fieldNameLabel] = source.Field.Name
+
+		// Add the child field name if it exists.
+		if source.ChildTarget.HasValue {
+			simpleExplainMap[childFieldNameLabel] = source.ChildTarget.Name
+		} else {
+			simpleExplainMap[childFieldNameLabel] = nil
+		}
+
+		sourceExplanations[i] = simpleExplainMap
+	}
+
+	return map[string]any{
+		sourcesLabel: sourceExplanations,
+	}, nil
+}
+
+// Explain method returns a map containing all attributes of this node that
+// are to be explained, subscribes / opts-in this node to be an explainablePlanNode.
+func (n *minMaxNode) Explain(explainType request.ExplainType) (map[string]any, error) {
+	switch explainType {
+	case request.SimpleExplain:
+		return n.simpleExplain()
+
+	case request.ExecuteExplain:
+		return map[string]any{
+			"iterations": n.execInfo.iterations,
+		}, nil
+
+	default:
+		return nil, ErrUnknownExplainRequestType
+	}
+}
+
+func (n *minMaxNode) Next() (bool, error) {
+	n.execInfo.iterations++
+
+	hasNext, err := n.plan.Next()
+	if err != nil || !hasNext {
+		return hasNext, err
+	}
+
+	n.currentValue = n.plan.Value()
+
+	var result any
+	for i, source := range n.aggregateMapping {
+		var values []any
+		var err error
+		switch childCollection := n.currentValue.Fields[source.Index].(type) {
+		case []core.Doc:
+			for _, childItem := range childCollection {
+				// Hidden docs are skipped, as they are in [sumDocs].
+				if !childItem.Hidden {
+					values = append(values, childItem.Fields[source.ChildTarget.Index])
+				}
+			}
+
+		case []int64:
+			values, err = minMaxItems(childCollection, &source, lessN[int64], anyValue[int64])
+
+		case []immutable.Option[int64]:
+			values, err = minMaxItems(childCollection, &source, lessO[int64], optionValue[int64])
+
+		case []float64:
+			values, err = minMaxItems(childCollection, &source, lessN[float64], anyValue[float64])
+
+		case []immutable.Option[float64]:
+			values, err = minMaxItems(childCollection, &source, lessO[float64], optionValue[float64])
+
+		case []string:
+			values, err = minMaxItems(childCollection, &source, lessN[string], anyValue[string])
+
+		case []immutable.Option[string]:
+			values, err = minMaxItems(childCollection, &source, lessO[string], optionValue[string])
+		}
+		if err != nil {
+			return false, err
+		}
+
+		for _, value := range values {
+			if value == nil {
+				continue
+			}
+			if result == nil {
+				result = value
+				continue
+			}
+			comparison, ok := compareMinMaxValues(value, result, n.targetKinds[i])
+			if !ok {
+				continue
+			}
+			if (n.isMax && comparison > 0) || (!n.isMax && comparison < 0) {
+				result = value
+			}
+		}
+	}
+
+	n.currentValue.Fields[n.virtualFieldIndex] = result
+	return true, nil
+}
+
+func (n *minMaxNode) SetPlan(p planNode) { n.plan = p }
+
+// minMaxItems returns the values of the given inline array items that the aggregate
+// target selects.
+func minMaxItems[T any](
+	source []T,
+	aggregateTarget *mapper.AggregateTarget,
+	less func(T, T) bool,
+	toValue func(T) any,
+) ([]any, error) {
+	items := enumerable.New(source)
+	if aggregateTarget.Filter != nil {
+		items = enumerable.Where(items, func(item T) (bool, error) {
+			return mapper.RunFilter(item, aggregateTarget.Filter)
+		})
+	}
+
+	if aggregateTarget.OrderBy != nil && len(aggregateTarget.OrderBy.Conditions) > 0 {
+		if aggregateTarget.OrderBy.Conditions[0].Direction == mapper.ASC {
+			items = enumerable.Sort(items, less, len(source))
+		} else {
+			items = enumerable.Sort(items, reverse(less), len(source))
+		}
+	}
+
+	if aggregateTarget.Limit != nil {
+		items = enumerable.Skip(items, aggregateTarget.Limit.Offset)
+		items = enumerable.Take(items, aggregateTarget.Limit.Limit)
+	}
+
+	values := []any{}
+	err := enumerable.ForEach(items, func(item T) {
+		values = append(values, toValue(item))
+	})
+
+	return values, err
+}
+
+func anyValue[T any](item T) any {
+	return item
+}
+
+func optionValue[T any](item immutable.Option[T]) any {
+	if !item.HasValue() {
+		return nil
+	}
+	return item.Value()
+}
+
+// compareMinMaxValues compares the two given values, returning -1 if a < b, 0 if a == b
+// and 1 if a > b.
+//
+// Numbers are compared exactly, and the values of DateTime fields chronologically. Other
+// strings are compared lexically. False is returned if the values cannot be compared.
+func compareMinMaxValues(a, b any, kind client.FieldKind) (int, bool) {
+	if comparison, ok := numbers.Compare(a, b); ok {
+		return comparison, true
+	}
+
+	aString, isString := a.(string)
+	if !isString {
+		return 0, false
+	}
+	bString, isString := b.(string)
+	if !isString {
+		return 0, false
+	}
+
+	if kind != client.FieldKind_DATETIME {
+		return strings.Compare(aString, bString), true
+	}
+
+	aTime, aErr := time.Parse(time.RFC3339, aString)
+	bTime, bErr := time.Parse(time.RFC3339, bString)
+	if aErr == nil && bErr == nil {
+		switch {
+		case aTime.Before(bTime):
+			return -1, true
+		case aTime.After(bTime):
+			return 1, true
+		default:
+			return 0, true
+		}
+	}
+	return strings.Compare(aString, bString), true
+}
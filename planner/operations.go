@@ -16,8 +16,10 @@ var (
 	_ planNode = (*createNode)(nil)
 	_ planNode = (*dagScanNode)(nil)
 	_ planNode = (*deleteNode)(nil)
+	_ planNode = (*distinctNode)(nil)
 	_ planNode = (*groupNode)(nil)
 	_ planNode = (*limitNode)(nil)
+	_ planNode = (*minMaxNode)(nil)
 	_ planNode = (*multiScanNode)(nil)
 	_ planNode = (*orderNode)(nil)
 	_ planNode = (*parallelNode)(nil)
@@ -25,6 +27,7 @@ var (
 	_ planNode = (*scanNode)(nil)
 	_ planNode = (*selectNode)(nil)
 	_ planNode = (*selectTopNode)(nil)
+	_ planNode = (*similarNode)(nil)
 	_ planNode = (*sumNode)(nil)
 	_ planNode = (*topLevelNode)(nil)
 	_ planNode = (*typeIndexJoin)(nil)
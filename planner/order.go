@@ -61,6 +61,14 @@ type orderNode struct {
 	// consuming and sorting data.
 	needSort bool
 
+	// indexedSource is the scan of the source collection, if it uses a secondary
+	// index that may yield the documents in the requested order.
+	indexedSource *scanNode
+
+	// indicates if the plan already yields the documents in the
+	// requested order, in which case they are not sorted.
+	isOrdered bool
+
 	execInfo orderExecInfo
 }
 
@@ -93,6 +101,7 @@ func (n *orderNode) Kind() string {
 func (n *orderNode) Init() error {
 	// reset stateful data
 	n.needSort = true
+	n.isOrdered = false
 	n.orderStrategy = nil
 	return n.plan.Init()
 }
@@ -155,6 +164,15 @@ func (n *orderNode) Next() (bool, error) {
 	n.execInfo.iterations++
 
 	for n.needSort {
+		if n.indexedSource != nil && n.indexedSource.isOrderedByIndex() {
+			// the documents are already ordered by the index, iterate
+			// through the plan directly
+			n.valueIter = n.plan
+			n.isOrdered = true
+			n.needSort = false
+			break
+		}
+
 		// make sure our orderStrategy is initialized
 		if n.orderStrategy == nil {
 			v := n.p.newContainerValuesNode(n.ordering)
@@ -192,7 +210,7 @@ func (n *orderNode) Close() error {
 		return err
 	}
 
-	if n.valueIter != nil {
+	if n.valueIter != nil && !n.isOrdered {
 		return n.valueIter.Close()
 	}
 
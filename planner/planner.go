@@ -160,6 +160,9 @@ func (p *Planner) newObjectMutationPlan(stmt *mapper.Mutation) (planNode, error)
 	case mapper.DeleteObjects:
 		return p.DeleteDocs(stmt)
 
+	case mapper.IncrementObjects:
+		return p.IncrementDocs(stmt)
+
 	default:
 		return nil, client.NewErrUnhandledType("mutation", stmt.Type)
 	}
@@ -254,6 +257,12 @@ func (p *Planner) expandSelectTopNodePlan(plan *selectTopNode, parentPlan *selec
 	// wire up source to plan
 	plan.planNode = plan.selectNode
 
+	// if similar
+	if plan.similar != nil {
+		plan.similar.plan = plan.planNode
+		plan.planNode = plan.similar
+	}
+
 	// if group
 	if plan.group != nil {
 		err := p.expandGroupNodePlan(plan)
@@ -271,6 +280,10 @@ func (p *Planner) expandSelectTopNodePlan(plan *selectTopNode, parentPlan *selec
 		plan.planNode = plan.order
 	}
 
+	if plan.distinct != nil {
+		p.expandDistinctPlan(plan, parentPlan)
+	}
+
 	if plan.limit != nil {
 		p.expandLimitPlan(plan, parentPlan)
 	}
@@ -388,6 +401,22 @@ func (p *Planner) expandLimitPlan(topNodeSelect *selectTopNode, parentPlan *sele
 	topNodeSelect.planNode = topNodeSelect.limit
 }
 
+func (p *Planner) expandDistinctPlan(topNodeSelect *selectTopNode, parentPlan *selectTopNode) {
+	if topNodeSelect.distinct == nil {
+		return
+	}
+
+	// As with limits, distinct clauses on the child selects of groups are handled
+	// internally by the group
+	if parentPlan != nil && parentPlan.group != nil && len(parentPlan.group.childSelects) != 0 {
+		topNodeSelect.distinct = nil
+		return
+	}
+
+	topNodeSelect.distinct.plan = topNodeSelect.planNode
+	topNodeSelect.planNode = topNodeSelect.distinct
+}
+
 // walkAndReplace walks through the provided plan, and searches for an instance
 // of the target plan, and replaces it with the replace plan
 func (p *Planner) walkAndReplacePlan(planNode, target, replace planNode) error {
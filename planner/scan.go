@@ -11,6 +11,8 @@
 package planner
 
 import (
+	"github.com/sourcenetwork/immutable"
+
 	"github.com/sourcenetwork/defradb/client"
 	"github.com/sourcenetwork/defradb/client/request"
 	"github.com/sourcenetwork/defradb/core"
@@ -46,8 +48,26 @@ type scanNode struct {
 	spans   core.Spans
 	reverse bool
 
+	// index is the secondary index used to find the documents to scan, if any.
+	index immutable.Option[client.IndexDescription]
+
+	// indicates if the secondary index yields the documents in the
+	// order requested by the host select.
+	indexIsOrdered bool
+
+	// indicates if the secondary index yields the documents sharing the
+	// same values for the fields of the host select's distinct clause together.
+	indexIsGrouped bool
+
+	// matchConditions are the full-text search conditions of the filter, against
+	// which the relevance of each document is scored.
+	matchConditions []matchCondition
+
 	filter *mapper.Filter
 
+	// fetchConflicts indicates if the concurrent values of MV register fields are requested.
+	fetchConflicts bool
+
 	scanInitialized bool
 
 	fetcher fetcher.Fetcher
@@ -64,6 +84,9 @@ func (n *scanNode) Init() error {
 	if err := n.fetcher.Init(&n.desc, n.fields, n.reverse, n.showDeleted); err != nil {
 		return err
 	}
+	if n.filter != nil {
+		n.matchConditions = getMatchConditions(n.filter.ExternalConditions)
+	}
 	return n.initScan()
 }
 
@@ -78,8 +101,50 @@ func (n *scanNode) Start() error {
 	return nil // no op
 }
 
+// useIndex makes the scan fetch the documents as described by the given secondary
+// index plan, unless spans are explicitly provided to the scan.
+func (n *scanNode) useIndex(plan indexPlan) {
+	n.index = immutable.Some(plan.index)
+	n.indexIsOrdered = plan.isOrdered
+	n.indexIsGrouped = plan.isGrouped
+	n.reverse = plan.reverse
+	n.fetcher = fetcher.NewIndexFetcher(plan.index, plan.spans)
+}
+
+// useVectorIndex makes the scan fetch the documents of the given vector index nearest to the
+// vector of the given similarity search first, unless spans are explicitly provided to the scan.
+func (n *scanNode) useVectorIndex(index client.IndexDescription, similar *mapper.Similar) {
+	n.index = immutable.Some(index)
+	n.fetcher = fetcher.NewVectorIndexFetcher(index, similar.Vector, similar.K)
+}
+
+// isSearchedByIndex returns true if the scan yields the documents nearest to the vector of
+// the host select's similarity search first, as provided by the vector index in use.
+//
+// The index is not used if spans are explicitly provided to the scan.
+func (n *scanNode) isSearchedByIndex() bool {
+	return n.index.HasValue() && n.index.Value().Vector && !n.spans.HasValue
+}
+
+// isOrderedByIndex returns true if the scan yields the documents in the order
+// requested by the host select, as provided by the secondary index in use.
+//
+// The index is not used if spans are explicitly provided to the scan.
+func (n *scanNode) isOrderedByIndex() bool {
+	return n.indexIsOrdered && !n.spans.HasValue
+}
+
+// isGroupedByIndex returns true if the scan yields the documents sharing the same
+// values for the fields of the host select's distinct clause together, as provided
+// by the secondary index in use.
+//
+// The index is not used if spans are explicitly provided to the scan.
+func (n *scanNode) isGroupedByIndex() bool {
+	return n.indexIsGrouped && !n.spans.HasValue
+}
+
 func (n *scanNode) initScan() error {
-	if !n.spans.HasValue {
+	if !n.spans.HasValue && !n.index.HasValue() {
 		start := base.MakeCollectionKey(n.desc)
 		n.spans = core.NewSpans(core.NewSpan(start, start.PrefixEnd()))
 	}
@@ -126,6 +191,20 @@ func (n *scanNode) Next() (bool, error) {
 		}
 		if passed {
 			n.execInfo.filterMatches++
+			if _, hasScore := n.documentMapping.IndexesByName[request.ScoreFieldName]; hasScore {
+				n.documentMapping.SetFirstOfName(
+					&n.currentValue,
+					request.ScoreFieldName,
+					n.score(n.currentValue),
+				)
+			}
+			if n.fetchConflicts {
+				conflicts, err := fetcher.FetchConflicts(n.p.ctx, n.p.txn, n.desc, n.currentValue.GetKey())
+				if err != nil {
+					return false, err
+				}
+				n.documentMapping.SetFirstOfName(&n.currentValue, request.ConflictsFieldName, conflicts)
+			}
 			return true, nil
 		}
 	}
@@ -156,6 +235,22 @@ func (n *scanNode) explainSpans() []map[string]any {
 	return spansExplainer
 }
 
+// explainIndex explains the index attribute.
+func (n *scanNode) explainIndex() map[string]any {
+	index := n.index.Value()
+	fieldNames := make([]string, len(index.Fields))
+	for i, field := range index.Fields {
+		fieldNames[i] = field.Name
+	}
+
+	return map[string]any{
+		"name":      index.Name,
+		"fields":    fieldNames,
+		"isOrdered": n.indexIsOrdered,
+		"isGrouped": n.indexIsGrouped,
+	}
+}
+
 func (n *scanNode) simpleExplain() (map[string]any, error) {
 	simpleExplainMap := map[string]any{}
 
@@ -173,6 +268,11 @@ func (n *scanNode) simpleExplain() (map[string]any, error) {
 	// Add the spans attribute.
 	simpleExplainMap[spansLabel] = n.explainSpans()
 
+	// Add the index attribute if a secondary index is used.
+	if n.index.HasValue() {
+		simpleExplainMap[indexLabel] = n.explainIndex()
+	}
+
 	return simpleExplainMap, nil
 }
 
@@ -209,10 +309,17 @@ func (p *Planner) Scan(parsed *mapper.Select) *scanNode {
 	} else {
 		f = new(fetcher.DocumentFetcher)
 	}
+	fetchConflicts := false
+	for _, field := range parsed.Fields {
+		if field.GetName() == request.ConflictsFieldName {
+			fetchConflicts = true
+		}
+	}
 	return &scanNode{
-		p:         p,
-		fetcher:   f,
-		docMapper: docMapper{&parsed.DocumentMapping},
+		p:              p,
+		fetcher:        f,
+		docMapper:      docMapper{&parsed.DocumentMapping},
+		fetchConflicts: fetchConflicts,
 	}
 }
 
@@ -48,8 +48,10 @@ SELECT * From TableA as A JOIN TableB as B ON a.id = b.friend_id
 type selectTopNode struct {
 	docMapper
 
+	similar    *similarNode
 	group      *groupNode
 	order      *orderNode
+	distinct   *distinctNode
 	limit      *limitNode
 	aggregates []aggregateNode
 
@@ -275,6 +277,33 @@ func (n *selectNode) initSource() ([]aggregateNode, error) {
 				spans[i] = core.NewSpan(dockeyIndexKey, dockeyIndexKey.PrefixEnd())
 			}
 			origScan.Spans(core.NewSpans(spans...))
+		} else if vectorIndex, ok := findVectorIndex(
+			sourcePlan.info.collectionDescription,
+			n.selectReq.Similar,
+		); ok && !n.selectReq.ShowDeleted {
+			// A similarity search on an indexed vector field is served by its vector index,
+			// in preference to any other index.
+			origScan.useVectorIndex(vectorIndex, n.selectReq.Similar)
+		} else if !n.selectReq.ShowDeleted {
+			// If the results are grouped the ordering and distinct clause apply to the
+			// groups, and cannot be provided by an index.  Likewise, the results of a
+			// similarity search are ordered by their distance before the requested ordering.
+			orderBy := n.selectReq.OrderBy
+			distinct := n.selectReq.Distinct
+			if n.selectReq.GroupBy != nil || n.selectReq.Similar != nil {
+				orderBy = nil
+				distinct = nil
+			}
+			plan, ok := findIndexPlan(
+				sourcePlan.info.collectionDescription,
+				origScan.filter,
+				orderBy,
+				distinct,
+				&n.selectReq.DocumentMapping,
+			)
+			if ok {
+				origScan.useIndex(plan)
+			}
 		}
 	}
 
@@ -298,6 +327,10 @@ func (n *selectNode) initFields(selectReq *mapper.Select) ([]aggregateNode, erro
 				plan, aggregateError = n.planner.Sum(f, selectReq)
 			case request.AverageFieldName:
 				plan, aggregateError = n.planner.Average(f)
+			case request.MinFieldName:
+				plan, aggregateError = n.planner.Min(f, selectReq)
+			case request.MaxFieldName:
+				plan, aggregateError = n.planner.Max(f, selectReq)
 			}
 
 			if aggregateError != nil {
@@ -431,6 +464,11 @@ func (p *Planner) SelectFromSource(
 		return nil, err
 	}
 
+	similarPlan, err := p.Similar(selectReq, selectReq.Similar)
+	if err != nil {
+		return nil, err
+	}
+
 	groupPlan, err := p.GroupBy(groupBy, selectReq, s.groupSelects)
 	if err != nil {
 		return nil, err
@@ -446,10 +484,17 @@ func (p *Planner) SelectFromSource(
 		return nil, err
 	}
 
+	distinctPlan, err := p.Distinct(selectReq, selectReq.Distinct)
+	if err != nil {
+		return nil, err
+	}
+
 	top := &selectTopNode{
 		selectNode: s,
+		similar:    similarPlan,
 		limit:      limitPlan,
 		order:      orderPlan,
+		distinct:   distinctPlan,
 		group:      groupPlan,
 		aggregates: aggregates,
 		docMapper:  docMapper{&selectReq.DocumentMapping},
@@ -475,6 +520,15 @@ func (p *Planner) Select(selectReq *mapper.Select) (planNode, error) {
 		return nil, err
 	}
 
+	similarPlan, err := p.Similar(selectReq, selectReq.Similar)
+	if err != nil {
+		return nil, err
+	}
+	if scan, ok := s.source.(*scanNode); ok && similarPlan != nil && scan.index.HasValue() &&
+		scan.index.Value().Vector {
+		similarPlan.indexedSource = scan
+	}
+
 	groupPlan, err := p.GroupBy(groupBy, selectReq, s.groupSelects)
 	if err != nil {
 		return nil, err
@@ -489,11 +543,24 @@ func (p *Planner) Select(selectReq *mapper.Select) (planNode, error) {
 	if err != nil {
 		return nil, err
 	}
+	if scan, ok := s.source.(*scanNode); ok && orderPlan != nil && scan.indexIsOrdered {
+		orderPlan.indexedSource = scan
+	}
+
+	distinctPlan, err := p.Distinct(selectReq, selectReq.Distinct)
+	if err != nil {
+		return nil, err
+	}
+	if scan, ok := s.source.(*scanNode); ok && distinctPlan != nil && scan.indexIsGrouped {
+		distinctPlan.indexedSource = scan
+	}
 
 	top := &selectTopNode{
 		selectNode: s,
+		similar:    similarPlan,
 		limit:      limitPlan,
 		order:      orderPlan,
+		distinct:   distinctPlan,
 		group:      groupPlan,
 		aggregates: aggregates,
 		docMapper:  docMapper{&selectReq.DocumentMapping},
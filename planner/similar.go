@@ -0,0 +1,235 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package planner
+
+import (
+	"container/heap"
+	"sort"
+
+	"github.com/sourcenetwork/defradb/client"
+	"github.com/sourcenetwork/defradb/client/request"
+	"github.com/sourcenetwork/defradb/core"
+	"github.com/sourcenetwork/defradb/db/base"
+	"github.com/sourcenetwork/defradb/planner/mapper"
+)
+
+// similarNode restricts the results of its source to the k documents with the values
+// of a vector field nearest to a given vector, yielding them from the nearest to the
+// furthest.
+//
+// If the vector field is indexed, the source yields the documents from the index nearest to
+// the vector first, and the search is approximate: only the first k documents yielded are
+// kept.  Otherwise the search is exact, every document yielded by the source is compared to
+// the vector.
+type similarNode struct {
+	docMapper
+
+	p    *Planner
+	plan planNode
+
+	similar *mapper.Similar
+
+	// indexedSource is the scan of the vector index yielding the documents nearest to the
+	// vector first, if any.
+	indexedSource *scanNode
+
+	// the nearest documents found, ordered from the nearest to the furthest.
+	results []similarResult
+
+	// indicates if the source has been searched for the nearest documents.
+	isSearched bool
+
+	currentIndex int
+	currentValue core.Doc
+
+	execInfo similarExecInfo
+}
+
+type similarExecInfo struct {
+	// Total number of times similarNode was executed.
+	iterations uint64
+
+	// Total number of documents compared to the vector.
+	docsCompared uint64
+}
+
+// similarResult is a document, and its distance from the vector of the search.
+type similarResult struct {
+	doc      core.Doc
+	distance float64
+}
+
+// Similar creates a new similarNode which yields the documents of the underlying plan
+// nearest to the vector of the given mapper.Similar.
+func (p *Planner) Similar(parsed *mapper.Select, n *mapper.Similar) (*similarNode, error) {
+	if n == nil {
+		return nil, nil
+	}
+
+	desc, err := p.getCollectionDesc(parsed.CollectionName)
+	if err != nil {
+		return nil, err
+	}
+	fieldDesc, ok := desc.GetField(n.Name)
+	if !ok || fieldDesc.Kind != client.FieldKind_FLOAT_VECTOR {
+		return nil, client.NewErrFieldNotExist(n.Name)
+	}
+	if len(n.Vector) != fieldDesc.Dimensions {
+		return nil, NewErrSimilarDimensionsMismatch(n.Name, fieldDesc.Dimensions, len(n.Vector))
+	}
+
+	return &similarNode{
+		p:         p,
+		similar:   n,
+		docMapper: docMapper{&parsed.DocumentMapping},
+	}, nil
+}
+
+func (n *similarNode) Kind() string {
+	return "similarNode"
+}
+
+func (n *similarNode) Init() error {
+	// reset stateful data
+	n.results = nil
+	n.isSearched = false
+	n.currentIndex = 0
+	return n.plan.Init()
+}
+
+func (n *similarNode) Start() error { return n.plan.Start() }
+
+func (n *similarNode) Spans(spans core.Spans) { n.plan.Spans(spans) }
+
+func (n *similarNode) Value() core.Doc { return n.currentValue }
+
+func (n *similarNode) Close() error {
+	n.results = nil
+	return n.plan.Close()
+}
+
+func (n *similarNode) Source() planNode { return n.plan }
+
+func (n *similarNode) Next() (bool, error) {
+	n.execInfo.iterations++
+
+	if !n.isSearched {
+		if err := n.search(); err != nil {
+			return false, err
+		}
+		n.isSearched = true
+	}
+
+	if n.currentIndex >= len(n.results) {
+		return false, nil
+	}
+
+	result := n.results[n.currentIndex]
+	n.currentIndex++
+
+	n.currentValue = result.doc
+	n.documentMapping.SetFirstOfName(&n.currentValue, request.DistanceFieldName, result.distance)
+	return true, nil
+}
+
+// search consumes the source plan, keeping the k documents nearest to the vector.
+//
+// If the source is searched by a vector index, only the first k documents are consumed.
+//
+// Documents without a value for the vector field are skipped.
+func (n *similarNode) search() error {
+	if n.similar.K == 0 {
+		return nil
+	}
+
+	isSearchedByIndex := n.indexedSource != nil && n.indexedSource.isSearchedByIndex()
+
+	nearest := &similarResultHeap{}
+	for {
+		next, err := n.plan.Next()
+		if err != nil {
+			return err
+		}
+		if !next {
+			break
+		}
+
+		doc := n.plan.Value()
+		vector, ok := doc.Fields[n.similar.Index].([]float64)
+		if !ok || len(vector) != len(n.similar.Vector) {
+			continue
+		}
+		n.execInfo.docsCompared++
+
+		distance := base.VectorDistance(n.similar.Metric, vector, n.similar.Vector)
+		if uint64(nearest.Len()) < n.similar.K {
+			heap.Push(nearest, similarResult{doc: doc.Clone(), distance: distance})
+		} else if distance < (*nearest)[0].distance {
+			(*nearest)[0] = similarResult{doc: doc.Clone(), distance: distance}
+			heap.Fix(nearest, 0)
+		}
+		if isSearchedByIndex && uint64(nearest.Len()) == n.similar.K {
+			break
+		}
+	}
+
+	n.results = *nearest
+	sort.SliceStable(n.results, func(i, j int) bool {
+		return n.results[i].distance < n.results[j].distance
+	})
+	return nil
+}
+
+func (n *similarNode) simpleExplain() (map[string]any, error) {
+	return map[string]any{
+		fieldNameLabel: n.similar.Name,
+		"k":            n.similar.K,
+		"metric":       string(n.similar.Metric),
+	}, nil
+}
+
+// Explain method returns a map containing all attributes of this node that
+// are to be explained, subscribes / opts-in this node to be an explainablePlanNode.
+func (n *similarNode) Explain(explainType request.ExplainType) (map[string]any, error) {
+	switch explainType {
+	case request.SimpleExplain:
+		return n.simpleExplain()
+
+	case request.ExecuteExplain:
+		return map[string]any{
+			"iterations":   n.execInfo.iterations,
+			"docsCompared": n.execInfo.docsCompared,
+		}, nil
+
+	default:
+		return nil, ErrUnknownExplainRequestType
+	}
+}
+
+// similarResultHeap is a max-heap of results by distance, such that the furthest of the
+// nearest results found so far may be replaced when a nearer one is found.
+type similarResultHeap []similarResult
+
+func (h similarResultHeap) Len() int           { return len(h) }
+func (h similarResultHeap) Less(i, j int) bool { return h[i].distance > h[j].distance }
+func (h similarResultHeap) Swap(i, j int)      { h[i], h[j] = h[j], h[i] }
+
+func (h *similarResultHeap) Push(x any) {
+	*h = append(*h, x.(similarResult))
+}
+
+func (h *similarResultHeap) Pop() any {
+	old := *h
+	n := len(old)
+	x := old[n-1]
+	*h = old[0 : n-1]
+	return x
+}
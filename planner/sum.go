@@ -11,11 +11,14 @@
 package planner
 
 import (
+	"math/big"
+
 	"github.com/sourcenetwork/immutable"
 	"github.com/sourcenetwork/immutable/enumerable"
 
 	"github.com/sourcenetwork/defradb/client"
 	"github.com/sourcenetwork/defradb/client/request"
+	"github.com/sourcenetwork/defradb/connor/numbers"
 	"github.com/sourcenetwork/defradb/core"
 	"github.com/sourcenetwork/defradb/planner/mapper"
 )
@@ -27,7 +30,7 @@ type sumNode struct {
 	p    *Planner
 	plan planNode
 
-	isFloat           bool
+	sumType           sumType
 	virtualFieldIndex int
 	aggregateMapping  []mapper.AggregateTarget
 
@@ -39,67 +42,109 @@ type sumExecInfo struct {
 	iterations uint64
 }
 
+// sumType is the type of the value produced by a sum.
+type sumType int
+
+const (
+	sumTypeInt sumType = iota
+	sumTypeFloat
+	sumTypeBigInt
+	sumTypeDecimal
+)
+
+// isExact returns true if sums of this type are accumulated without losing precision.
+func (t sumType) isExact() bool {
+	return t == sumTypeBigInt || t == sumTypeDecimal
+}
+
+// combine returns the type of the sum of values of this type and of the given type.
+func (t sumType) combine(other sumType) sumType {
+	switch {
+	case t == other:
+		return t
+	case t == sumTypeInt:
+		return other
+	case other == sumTypeInt:
+		return t
+	default:
+		// Floats and big integers, or either with decimals, can only be summed
+		// together as decimals.
+		return sumTypeDecimal
+	}
+}
+
+// sumTypeOfKind returns the type of the sum of values of the given field kind.
+func sumTypeOfKind(kind client.FieldKind) sumType {
+	switch kind {
+	case client.FieldKind_FLOAT_ARRAY,
+		client.FieldKind_FLOAT_VECTOR,
+		client.FieldKind_FLOAT,
+		client.FieldKind_NILLABLE_FLOAT_ARRAY:
+		return sumTypeFloat
+	case client.FieldKind_BIG_INT:
+		return sumTypeBigInt
+	case client.FieldKind_DECIMAL:
+		return sumTypeDecimal
+	default:
+		return sumTypeInt
+	}
+}
+
 func (p *Planner) Sum(
 	field *mapper.Aggregate,
 	parent *mapper.Select,
 ) (*sumNode, error) {
-	isFloat := false
+	resultType := sumTypeInt
 	for _, target := range field.AggregateTargets {
-		isTargetFloat, err := p.isValueFloat(parent, &target)
+		targetType, err := p.getSumType(parent, &target)
 		if err != nil {
 			return nil, err
 		}
-		// If one source property is a float, the result will be a float - no need to check the rest
-		if isTargetFloat {
-			isFloat = true
-			break
-		}
+		resultType = resultType.combine(targetType)
 	}
 
 	return &sumNode{
 		p:                 p,
-		isFloat:           isFloat,
+		sumType:           resultType,
 		aggregateMapping:  field.AggregateTargets,
 		virtualFieldIndex: field.Index,
 		docMapper:         docMapper{&field.DocumentMapping},
 	}, nil
 }
 
-// Returns true if the value to be summed is a float, otherwise false.
-func (p *Planner) isValueFloat(
+// Returns the type of the sum of the values to be summed.
+func (p *Planner) getSumType(
 	parent *mapper.Select,
 	source *mapper.AggregateTarget,
-) (bool, error) {
+) (sumType, error) {
 	// It is important that averages are floats even if their underlying values are ints
 	// else sum will round them down to the nearest whole number
 	if source.ChildTarget.Name == request.AverageFieldName {
-		return true, nil
+		return sumTypeFloat, nil
 	}
 
 	if !source.ChildTarget.HasValue {
 		parentDescription, err := p.getCollectionDesc(parent.CollectionName)
 		if err != nil {
-			return false, err
+			return sumTypeInt, err
 		}
 
 		fieldDescription, fieldDescriptionFound := parentDescription.GetField(source.Name)
 		if !fieldDescriptionFound {
-			return false, client.NewErrFieldNotExist(source.Name)
+			return sumTypeInt, client.NewErrFieldNotExist(source.Name)
 		}
-		return fieldDescription.Kind == client.FieldKind_FLOAT_ARRAY ||
-			fieldDescription.Kind == client.FieldKind_FLOAT ||
-			fieldDescription.Kind == client.FieldKind_NILLABLE_FLOAT_ARRAY, nil
+		return sumTypeOfKind(fieldDescription.Kind), nil
 	}
 
 	// If path length is two, we are summing a group or a child relationship
 	if source.ChildTarget.Name == request.CountFieldName {
-		// If we are summing a count, we know it is an int and can return false early
-		return false, nil
+		// If we are summing a count, we know it is an int and can return early
+		return sumTypeInt, nil
 	}
 
 	child, isChildSelect := parent.FieldAt(source.Index).AsSelect()
 	if !isChildSelect {
-		return false, ErrMissingChildSelect
+		return sumTypeInt, ErrMissingChildSelect
 	}
 
 	if _, isAggregate := request.Aggregates[source.ChildTarget.Name]; isAggregate {
@@ -108,36 +153,31 @@ func (p *Planner) isValueFloat(
 		// of N-depth aggregations (e.g. sum of sum of sum of...)
 		sourceField := child.FieldAt(source.ChildTarget.Index).(*mapper.Aggregate)
 
+		resultType := sumTypeInt
 		for _, aggregateTarget := range sourceField.AggregateTargets {
-			isFloat, err := p.isValueFloat(
+			targetType, err := p.getSumType(
 				child,
 				&aggregateTarget,
 			)
 			if err != nil {
-				return false, err
-			}
-
-			// If one source property is a float, the result will be a float - no need to check the rest
-			if isFloat {
-				return true, nil
+				return sumTypeInt, err
 			}
+			resultType = resultType.combine(targetType)
 		}
-		return false, nil
+		return resultType, nil
 	}
 
 	childCollectionDescription, err := p.getCollectionDesc(child.CollectionName)
 	if err != nil {
-		return false, err
+		return sumTypeInt, err
 	}
 
 	fieldDescription, fieldDescriptionFound := childCollectionDescription.GetField(source.ChildTarget.Name)
 	if !fieldDescriptionFound {
-		return false, client.NewErrFieldNotExist(source.ChildTarget.Name)
+		return sumTypeInt, client.NewErrFieldNotExist(source.ChildTarget.Name)
 	}
 
-	return fieldDescription.Kind == client.FieldKind_FLOAT_ARRAY ||
-		fieldDescription.Kind == client.FieldKind_FLOAT ||
-		fieldDescription.Kind == client.FieldKind_NILLABLE_FLOAT_ARRAY, nil
+	return sumTypeOfKind(fieldDescription.Kind), nil
 }
 
 func (n *sumNode) Kind() string {
@@ -215,13 +255,29 @@ func (n *sumNode) Next() (bool, error) {
 	n.currentValue = n.plan.Value()
 
 	sum := float64(0)
+	// Arbitrary precision sums are accumulated exactly, including their int64 and float64
+	// values, separately from the other sums.
+	exactSum := new(big.Rat)
+	isExact := n.sumType.isExact()
 
 	for _, source := range n.aggregateMapping {
 		child := n.currentValue.Fields[source.Index]
 		var collectionSum float64
+		var exactCollectionSum *big.Rat
 		var err error
 		switch childCollection := child.(type) {
 		case []core.Doc:
+			if isExact {
+				exactCollectionSum = sumDocsExact(childCollection, func(childItem core.Doc) *big.Rat {
+					childProperty, ok := numbers.ToRat(childItem.Fields[source.ChildTarget.Index])
+					if !ok {
+						// return nothing, cannot be summed
+						return new(big.Rat)
+					}
+					return childProperty
+				})
+				break
+			}
 			collectionSum = sumDocs(childCollection, func(childItem core.Doc) float64 {
 				childProperty := childItem.Fields[source.ChildTarget.Index]
 				switch v := childProperty.(type) {
@@ -239,6 +295,17 @@ func (n *sumNode) Next() (bool, error) {
 				}
 			})
 		case []int64:
+			if isExact {
+				exactCollectionSum, err = sumItemsExact(
+					childCollection,
+					&source,
+					lessN[int64],
+					func(childItem int64) *big.Rat {
+						return new(big.Rat).SetInt64(childItem)
+					},
+				)
+				break
+			}
 			collectionSum, err = sumItems(
 				childCollection,
 				&source,
@@ -249,6 +316,20 @@ func (n *sumNode) Next() (bool, error) {
 			)
 
 		case []immutable.Option[int64]:
+			if isExact {
+				exactCollectionSum, err = sumItemsExact(
+					childCollection,
+					&source,
+					lessO[int64],
+					func(childItem immutable.Option[int64]) *big.Rat {
+						if !childItem.HasValue() {
+							return new(big.Rat)
+						}
+						return new(big.Rat).SetInt64(childItem.Value())
+					},
+				)
+				break
+			}
 			collectionSum, err = sumItems(
 				childCollection,
 				&source,
@@ -262,6 +343,18 @@ func (n *sumNode) Next() (bool, error) {
 			)
 
 		case []float64:
+			if isExact {
+				exactCollectionSum, err = sumItemsExact(
+					childCollection,
+					&source,
+					lessN[float64],
+					func(childItem float64) *big.Rat {
+						value, _ := numbers.ToRat(childItem)
+						return value
+					},
+				)
+				break
+			}
 			collectionSum, err = sumItems(
 				childCollection,
 				&source,
@@ -272,6 +365,21 @@ func (n *sumNode) Next() (bool, error) {
 			)
 
 		case []immutable.Option[float64]:
+			if isExact {
+				exactCollectionSum, err = sumItemsExact(
+					childCollection,
+					&source,
+					lessO[float64],
+					func(childItem immutable.Option[float64]) *big.Rat {
+						if !childItem.HasValue() {
+							return new(big.Rat)
+						}
+						value, _ := numbers.ToRat(childItem.Value())
+						return value
+					},
+				)
+				break
+			}
 			collectionSum, err = sumItems(
 				childCollection,
 				&source,
@@ -288,12 +396,20 @@ func (n *sumNode) Next() (bool, error) {
 			return false, err
 		}
 		sum += collectionSum
+		if exactCollectionSum != nil {
+			exactSum.Add(exactSum, exactCollectionSum)
+		}
 	}
 
 	var typedSum any
-	if n.isFloat {
+	switch n.sumType {
+	case sumTypeDecimal:
+		typedSum = exactSum
+	case sumTypeBigInt:
+		typedSum = new(big.Int).Set(exactSum.Num())
+	case sumTypeFloat:
 		typedSum = sum
-	} else {
+	default:
 		typedSum = int64(sum)
 	}
 	n.currentValue.Fields[n.virtualFieldIndex] = typedSum
@@ -315,12 +431,26 @@ func sumDocs(docs []core.Doc, toFloat func(core.Doc) float64) float64 {
 	return sum
 }
 
-func sumItems[T any](
+// sumDocsExact sums the documents in a slice without losing precision, skipping over
+// hidden items like [sumDocs].
+func sumDocsExact(docs []core.Doc, toRat func(core.Doc) *big.Rat) *big.Rat {
+	sum := new(big.Rat)
+	for _, doc := range docs {
+		if !doc.Hidden {
+			sum.Add(sum, toRat(doc))
+		}
+	}
+
+	return sum
+}
+
+// aggregateItems returns the items of the given source to be aggregated, filtered, ordered
+// and limited as requested by the given aggregate target.
+func aggregateItems[T any](
 	source []T,
 	aggregateTarget *mapper.AggregateTarget,
 	less func(T, T) bool,
-	toFloat func(T) float64,
-) (float64, error) {
+) enumerable.Enumerable[T] {
 	items := enumerable.New(source)
 	if aggregateTarget.Filter != nil {
 		items = enumerable.Where(items, func(item T) (bool, error) {
@@ -341,25 +471,53 @@ func sumItems[T any](
 		items = enumerable.Take(items, aggregateTarget.Limit.Limit)
 	}
 
+	return items
+}
+
+func sumItems[T any](
+	source []T,
+	aggregateTarget *mapper.AggregateTarget,
+	less func(T, T) bool,
+	toFloat func(T) float64,
+) (float64, error) {
 	var sum float64 = 0
-	err := enumerable.ForEach(items, func(item T) {
+	err := enumerable.ForEach(aggregateItems(source, aggregateTarget, less), func(item T) {
 		sum += toFloat(item)
 	})
 
 	return sum, err
 }
 
+// sumItemsExact sums the items of the given source like [sumItems], without losing precision.
+func sumItemsExact[T any](
+	source []T,
+	aggregateTarget *mapper.AggregateTarget,
+	less func(T, T) bool,
+	toRat func(T) *big.Rat,
+) (*big.Rat, error) {
+	sum := new(big.Rat)
+	err := enumerable.ForEach(aggregateItems(source, aggregateTarget, less), func(item T) {
+		sum.Add(sum, toRat(item))
+	})
+
+	return sum, err
+}
+
 func (n *sumNode) SetPlan(p planNode) { n.plan = p }
 
 type number interface {
 	int64 | float64
 }
 
-func lessN[T number](a T, b T) bool {
+type ordered interface {
+	number | string
+}
+
+func lessN[T ordered](a T, b T) bool {
 	return a < b
 }
 
-func lessO[T number](a immutable.Option[T], b immutable.Option[T]) bool {
+func lessO[T ordered](a immutable.Option[T], b immutable.Option[T]) bool {
 	if !a.HasValue() {
 		return true
 	}
@@ -218,6 +218,10 @@ func (n *typeIndexJoin) Merge() bool { return true }
 //
 // The subType filter is the conditions that apply to the
 // queried sub type ie: {birthday: "June 26, 1990", ...}.
+//
+// The subType filter is matched once the sub type has been joined, so
+// that for one-to-many relations the array operators `_any`, `_all`
+// and `_none` are matched against all of the joined sub type docs.
 func splitFilterByType(filter *mapper.Filter, subType int) (*mapper.Filter, *mapper.Filter) {
 	if filter == nil {
 		return nil, nil
@@ -227,13 +231,18 @@ func splitFilterByType(filter *mapper.Filter, subType int) (*mapper.Filter, *map
 	}
 
 	keyFound, sub := removeConditionIndex(conditionKey, filter.Conditions)
-	if !keyFound {
+	// compound conditions, such as `_not`, on the sub type can only be matched once joined too
+	compoundConditions := removeCompoundConditionsOnIndex(conditionKey, filter.Conditions)
+	if !keyFound && len(compoundConditions) == 0 {
 		return filter, &mapper.Filter{}
 	}
 
 	// create new splitup filter
 	// our schema ensures that if sub exists, its of type map[string]any
-	splitF := &mapper.Filter{Conditions: map[connor.FilterKey]any{conditionKey: sub}}
+	splitF := &mapper.Filter{Conditions: compoundConditions}
+	if keyFound {
+		splitF.Conditions[conditionKey] = sub
+	}
 	return filter, splitF
 }
 
@@ -613,3 +622,47 @@ func removeConditionIndex(
 	}
 	return false, nil
 }
+
+// removeCompoundConditionsOnIndex removes the compound conditions, such as `_and` or `_not`,
+// holding conditions on the property of the given index from the given conditions, and
+// returns them.
+func removeCompoundConditionsOnIndex(
+	key *mapper.PropertyIndex,
+	filterConditions map[connor.FilterKey]any,
+) map[connor.FilterKey]any {
+	removed := map[connor.FilterKey]any{}
+	for targetKey, clause := range filterConditions {
+		if _, isOperator := targetKey.(*mapper.Operator); isOperator && hasConditionIndex(key, clause) {
+			delete(filterConditions, targetKey)
+			removed[targetKey] = clause
+		}
+	}
+	return removed
+}
+
+// hasConditionIndex returns true if the given clause holds a condition on the property
+// of the given index.
+func hasConditionIndex(key *mapper.PropertyIndex, clause any) bool {
+	switch typedClause := clause.(type) {
+	case map[connor.FilterKey]any:
+		for targetKey, innerClause := range typedClause {
+			switch typedKey := targetKey.(type) {
+			case *mapper.PropertyIndex:
+				if typedKey.Index == key.Index {
+					return true
+				}
+			case *mapper.Operator:
+				if hasConditionIndex(key, innerClause) {
+					return true
+				}
+			}
+		}
+	case []any:
+		for _, innerClause := range typedClause {
+			if hasConditionIndex(key, innerClause) {
+				return true
+			}
+		}
+	}
+	return false
+}
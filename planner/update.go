@@ -32,6 +32,13 @@ type updateNode struct {
 
 	patch string
 
+	// message is recorded in the commits made by the update, if not empty
+	message string
+
+	// isIncrement is true if the patch holds the amounts to increment counter fields by,
+	// rather than their new values.
+	isIncrement bool
+
 	isUpdating bool
 
 	results planNode
@@ -66,7 +73,12 @@ func (n *updateNode) Next() (bool, error) {
 			if err != nil {
 				return false, err
 			}
-			_, err = n.collection.UpdateWithKey(n.p.ctx, key, n.patch)
+			ctx := core.WithCommitMessage(n.p.ctx, n.message)
+			if n.isIncrement {
+				_, err = n.collection.IncrementWithKey(ctx, key, n.patch)
+			} else {
+				_, err = n.collection.UpdateWithKey(ctx, key, n.patch)
+			}
 			if err != nil {
 				return false, err
 			}
@@ -160,6 +172,7 @@ func (p *Planner) UpdateDocs(parsed *mapper.Mutation) (planNode, error) {
 		ids:        parsed.DocKeys.Value(),
 		isUpdating: true,
 		patch:      parsed.Data,
+		message:    parsed.Message,
 		docMapper:  docMapper{&parsed.DocumentMapping},
 	}
 
@@ -179,3 +192,14 @@ func (p *Planner) UpdateDocs(parsed *mapper.Mutation) (planNode, error) {
 
 	return update, nil
 }
+
+// IncrementDocs returns a plan that increments the counter fields of the targeted documents
+// by the amounts given in the mutation data.
+func (p *Planner) IncrementDocs(parsed *mapper.Mutation) (planNode, error) {
+	plan, err := p.UpdateDocs(parsed)
+	if err != nil {
+		return nil, err
+	}
+	plan.(*updateNode).isIncrement = true
+	return plan, nil
+}
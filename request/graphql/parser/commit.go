@@ -40,6 +40,18 @@ func parseCommitSelect(schema gql.Schema, parent *gql.Object, field *ast.Field)
 		} else if prop == request.FieldName {
 			raw := argument.Value.(*ast.StringValue)
 			commit.FieldName = immutable.Some(raw.Value)
+		} else if prop == request.FilterClause {
+			obj := argument.Value.(*ast.ObjectValue)
+			fieldDef := gql.GetFieldDef(schema, parent, field.Name.Value)
+			filterType, ok := getArgumentType(fieldDef, request.FilterClause)
+			if !ok {
+				return nil, ErrFilterMissingArgumentType
+			}
+			filter, err := NewFilter(obj, filterType)
+			if err != nil {
+				return nil, err
+			}
+			commit.Filter = filter
 		} else if prop == request.OrderClause {
 			obj := argument.Value.(*ast.ObjectValue)
 			cond, err := ParseConditionsInOrder(obj)
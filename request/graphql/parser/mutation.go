@@ -23,9 +23,10 @@ import (
 
 var (
 	mutationNameToType = map[string]request.MutationType{
-		"create": request.CreateObjects,
-		"update": request.UpdateObjects,
-		"delete": request.DeleteObjects,
+		"create":    request.CreateObjects,
+		"update":    request.UpdateObjects,
+		"delete":    request.DeleteObjects,
+		"increment": request.IncrementObjects,
 	}
 )
 
@@ -105,6 +106,9 @@ func parseMutation(schema gql.Schema, parent *gql.Object, field *ast.Field) (*re
 				return nil, ErrEmptyDataPayload
 			}
 			mut.Data = raw.Value
+		} else if prop == request.Message {
+			raw := argument.Value.(*ast.StringValue)
+			mut.Message = raw.Value
 		} else if prop == request.FilterClause { // parse filter
 			obj := argument.Value.(*ast.ObjectValue)
 			filterType, ok := getArgumentType(fieldDef, request.FilterClause)
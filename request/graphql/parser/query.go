@@ -174,6 +174,15 @@ func parseSelect(
 					Fields: fields,
 				},
 			)
+		case request.DistinctClause:
+			slct.Distinct = immutable.Some(parseDistinct(astValue.(*ast.ListValue)))
+		case request.SimilarClause:
+			obj := astValue.(*ast.ObjectValue)
+			similar, err := parseSimilar(obj)
+			if err != nil {
+				return nil, err
+			}
+			slct.Similar = immutable.Some(similar)
 		case request.ShowDeleted:
 			val := astValue.(*ast.BooleanValue)
 			slct.ShowDeleted = val.Value
@@ -199,6 +208,53 @@ func parseSelect(
 	return slct, err
 }
 
+// parseDistinct parses the names of the fields by which results are considered distinct.
+func parseDistinct(list *ast.ListValue) request.Distinct {
+	fields := make([]string, 0, len(list.Values))
+	for _, v := range list.Values {
+		fields = append(fields, v.GetValue().(string))
+	}
+	return request.Distinct{
+		Fields: fields,
+	}
+}
+
+// parseSimilar parses the nearest-neighbour search of a select.
+//
+// The metric defaults to cosine distance if none is provided.
+func parseSimilar(obj *ast.ObjectValue) (request.Similar, error) {
+	similar := request.Similar{
+		Metric: request.CosineMetric,
+	}
+
+	for _, field := range obj.Fields {
+		switch field.Name.Value {
+		case request.FieldName:
+			similar.Field = field.Value.GetValue().(string)
+		case "vector":
+			values := field.Value.(*ast.ListValue).Values
+			similar.Vector = make([]float64, len(values))
+			for i, value := range values {
+				element, err := strconv.ParseFloat(value.GetValue().(string), 64)
+				if err != nil {
+					return request.Similar{}, err
+				}
+				similar.Vector[i] = element
+			}
+		case "k":
+			k, err := strconv.ParseUint(field.Value.(*ast.IntValue).Value, 10, 64)
+			if err != nil {
+				return request.Similar{}, err
+			}
+			similar.K = k
+		case "metric":
+			similar.Metric = request.SimilarityMetric(field.Value.GetValue().(string))
+		}
+	}
+
+	return similar, nil
+}
+
 func parseAggregate(schema gql.Schema, parent *gql.Object, field *ast.Field, index int) (*request.Aggregate, error) {
 	targets := make([]*request.AggregateTarget, len(field.Arguments))
 
@@ -215,6 +271,7 @@ func parseAggregate(schema gql.Schema, parent *gql.Object, field *ast.Field, ind
 			var limit immutable.Option[uint64]
 			var offset immutable.Option[uint64]
 			var order immutable.Option[request.OrderBy]
+			var distinct immutable.Option[request.Distinct]
 
 			fieldArg, hasFieldArg := tryGet(argumentValue, request.FieldName)
 			if hasFieldArg {
@@ -303,6 +360,23 @@ func parseAggregate(schema gql.Schema, parent *gql.Object, field *ast.Field, ind
 				}
 			}
 
+			distinctArg, hasDistinctArg := tryGet(argumentValue, request.DistinctClause)
+			if hasDistinctArg {
+				switch distinctArgValue := distinctArg.Value.(type) {
+				case *ast.BooleanValue:
+					// For inline arrays the distinct arg will be a simple boolean, as the
+					// items themselves are compared
+					if distinctArgValue.Value {
+						distinct = immutable.Some(request.Distinct{})
+					}
+
+				case *ast.ListValue:
+					// For relations the distinct arg will be the set of fields by which the
+					// related objects are compared, as used by the host object
+					distinct = immutable.Some(parseDistinct(distinctArgValue))
+				}
+			}
+
 			targets[i] = &request.AggregateTarget{
 				HostName:  hostName,
 				ChildName: immutable.Some(childName),
@@ -310,6 +384,7 @@ func parseAggregate(schema gql.Schema, parent *gql.Object, field *ast.Field, ind
 				Limit:     limit,
 				Offset:    offset,
 				OrderBy:   order,
+				Distinct:  distinct,
 			}
 		}
 	}
@@ -14,9 +14,12 @@ import (
 	"context"
 	"fmt"
 	"sort"
+	"strconv"
+	"strings"
 
 	"github.com/sourcenetwork/defradb/client"
 	"github.com/sourcenetwork/defradb/client/request"
+	schemaTypes "github.com/sourcenetwork/defradb/request/graphql/schema/types"
 
 	"github.com/graphql-go/graphql/language/ast"
 	gqlp "github.com/graphql-go/graphql/language/parser"
@@ -47,10 +50,37 @@ func fromAst(ctx context.Context, doc *ast.Document) ([]client.CollectionDescrip
 	relationManager := NewRelationManager()
 	descriptions := []client.CollectionDescription{}
 
+	// Enums are collected first, so that the fields of objects declared before them may
+	// still hold their values.
+	enums := map[string]client.EnumDescription{}
+	for _, def := range doc.Definitions {
+		if enumDef, isEnum := def.(*ast.EnumDefinition); isEnum {
+			if _, exists := enums[enumDef.Name.Value]; exists {
+				return nil, NewErrSchemaTypeAlreadyExist(enumDef.Name.Value)
+			}
+			enums[enumDef.Name.Value] = enumFromAst(enumDef)
+		}
+	}
+
+	// Embedded object types are stored inline by the objects holding them rather than as
+	// collections, and so are also collected first.
+	embedded := map[string]*ast.ObjectDefinition{}
+	for _, def := range doc.Definitions {
+		if objDef, isObject := def.(*ast.ObjectDefinition); isObject && isEmbeddedType(objDef) {
+			if _, exists := embedded[objDef.Name.Value]; exists {
+				return nil, NewErrSchemaTypeAlreadyExist(objDef.Name.Value)
+			}
+			embedded[objDef.Name.Value] = objDef
+		}
+	}
+
 	for _, def := range doc.Definitions {
 		switch defType := def.(type) {
 		case *ast.ObjectDefinition:
-			description, err := fromAstDefinition(ctx, relationManager, defType)
+			if isEmbeddedType(defType) {
+				continue
+			}
+			description, err := fromAstDefinition(ctx, relationManager, enums, embedded, defType)
 			if err != nil {
 				return nil, err
 			}
@@ -78,6 +108,8 @@ func fromAst(ctx context.Context, doc *ast.Document) ([]client.CollectionDescrip
 func fromAstDefinition(
 	ctx context.Context,
 	relationManager *RelationManager,
+	enums map[string]client.EnumDescription,
+	embedded map[string]*ast.ObjectDefinition,
 	def *ast.ObjectDefinition,
 ) (client.CollectionDescription, error) {
 	fieldDescriptions := []client.FieldDescription{
@@ -87,14 +119,93 @@ func fromAstDefinition(
 			Typ:  client.NONE_CRDT,
 		},
 	}
+	var indexDescriptions []client.IndexDescription
+	var enumDescriptions []client.EnumDescription
+	var constraintDescriptions []client.ConstraintDescription
 
 	for _, field := range def.Fields {
-		kind, err := astTypeToKind(field.Type)
+		if embeddedDef, isEmbedded := embeddedTypeOf(embedded, field.Type); isEmbedded {
+			embeddedFields, err := embeddedFieldsFromAst(
+				def.Name.Value,
+				field.Name.Value,
+				field.Type,
+				embeddedDef,
+				enums,
+				embedded,
+				&enumDescriptions,
+				map[string]struct{}{},
+			)
+			if err != nil {
+				return client.CollectionDescription{}, err
+			}
+			fieldDescriptions = append(fieldDescriptions, embeddedFields...)
+			continue
+		}
+
+		// Non-null fields are required to hold a value, and are otherwise described as
+		// the type they wrap.
+		fieldType := field.Type
+		isRequired := false
+		if nonNull, isNonNull := fieldType.(*ast.NonNull); isNonNull {
+			fieldType = nonNull.Type
+			isRequired = true
+		}
+
+		kind, err := astTypeToKind(fieldType)
 		if err != nil {
 			return client.CollectionDescription{}, err
 		}
 
 		schema := ""
+		if named, isNamed := fieldType.(*ast.Named); isNamed {
+			if enum, isEnum := enums[named.Name.Value]; isEnum {
+				kind = client.FieldKind_ENUM
+				schema = enum.Name
+				if !containsEnum(enumDescriptions, enum.Name) {
+					enumDescriptions = append(enumDescriptions, enum)
+				}
+			}
+		}
+
+		if isRequired && kind == client.FieldKind_FOREIGN_OBJECT {
+			return client.CollectionDescription{}, NewErrNonNullForTypeNotSupported(
+				fieldType.(*ast.Named).Name.Value,
+			)
+		}
+		if isRequired && kind == client.FieldKind_FOREIGN_OBJECT_ARRAY {
+			return client.CollectionDescription{}, NewErrNonNullForTypeNotSupported(
+				fieldType.(*ast.List).Type.(*ast.Named).Name.Value,
+			)
+		}
+
+		dimensions := 0
+		if directive, exists := findDirective(field, "vector"); exists {
+			if kind != client.FieldKind_FLOAT_ARRAY {
+				return client.CollectionDescription{}, NewErrInvalidVectorField(def.Name.Value, field.Name.Value)
+			}
+			dimensions, err = vectorDimensionsFromAst(def.Name.Value, field.Name.Value, directive)
+			if err != nil {
+				return client.CollectionDescription{}, err
+			}
+			kind = client.FieldKind_FLOAT_VECTOR
+		}
+
+		var defaultValue client.DefaultValue
+		if directive, exists := findDirective(field, "default"); exists {
+			defaultValue, err = defaultValueFromAst(def.Name.Value, field.Name.Value, directive)
+			if err != nil {
+				return client.CollectionDescription{}, err
+			}
+		}
+
+		if directive, exists := findDirective(field, "constraint"); exists {
+			constraint, err := constraintFromAst(def.Name.Value, field.Name.Value, directive)
+			if err != nil {
+				return client.CollectionDescription{}, err
+			}
+			constraintDescriptions = append(constraintDescriptions, constraint)
+		}
+
 		relationName := ""
 		relationType := client.RelationType(0)
 
@@ -136,16 +247,51 @@ func fromAstDefinition(
 			}
 		}
 
+		crdtType := defaultCRDTForFieldKind[kind]
+		if directive, exists := findDirective(field, schemaTypes.CRDTLabel); exists {
+			crdtType, err = crdtTypeFromAst(def.Name.Value, field.Name.Value, directive)
+			if err != nil {
+				return client.CollectionDescription{}, err
+			}
+		}
+
 		fieldDescription := client.FieldDescription{
 			Name:         field.Name.Value,
 			Kind:         kind,
-			Typ:          defaultCRDTForFieldKind[kind],
+			Typ:          crdtType,
 			Schema:       schema,
 			RelationName: relationName,
 			RelationType: relationType,
+			Dimensions:   dimensions,
+			DefaultValue: defaultValue,
+			IsRequired:   isRequired,
 		}
 
 		fieldDescriptions = append(fieldDescriptions, fieldDescription)
+
+		for _, directive := range field.Directives {
+			if directive.Name.Value != "index" &&
+				directive.Name.Value != "unique" &&
+				directive.Name.Value != "fulltext" {
+				continue
+			}
+			index, err := fieldIndexFromAst(def.Name.Value, field.Name.Value, kind, directive)
+			if err != nil {
+				return client.CollectionDescription{}, err
+			}
+			indexDescriptions = append(indexDescriptions, index)
+		}
+	}
+
+	for _, directive := range def.Directives {
+		if directive.Name.Value != "index" && directive.Name.Value != "unique" {
+			continue
+		}
+		index, err := typeIndexFromAst(def.Name.Value, directive)
+		if err != nil {
+			return client.CollectionDescription{}, err
+		}
+		indexDescriptions = append(indexDescriptions, index)
 	}
 
 	// sort the fields lexicographically
@@ -159,15 +305,406 @@ func fromAstDefinition(
 		return fieldDescriptions[i].Name < fieldDescriptions[j].Name
 	})
 
+	sort.Slice(enumDescriptions, func(i, j int) bool {
+		return enumDescriptions[i].Name < enumDescriptions[j].Name
+	})
+
 	return client.CollectionDescription{
 		Name: def.Name.Value,
 		Schema: client.SchemaDescription{
-			Name:   def.Name.Value,
-			Fields: fieldDescriptions,
+			Name:        def.Name.Value,
+			Fields:      fieldDescriptions,
+			Enums:       enumDescriptions,
+			Constraints: constraintDescriptions,
 		},
+		Indexes: indexDescriptions,
 	}, nil
 }
 
+// enumFromAst builds the description of the given enum definition.
+func enumFromAst(def *ast.EnumDefinition) client.EnumDescription {
+	values := make([]string, len(def.Values))
+	for i, value := range def.Values {
+		values[i] = value.Name.Value
+	}
+	return client.EnumDescription{
+		Name:   def.Name.Value,
+		Values: values,
+	}
+}
+
+// isEmbeddedType returns true if the given object definition is declared with the @embedded
+// directive, and so is an embedded object type rather than a collection.
+func isEmbeddedType(def *ast.ObjectDefinition) bool {
+	for _, directive := range def.Directives {
+		if directive.Name.Value == "embedded" {
+			return true
+		}
+	}
+	return false
+}
+
+// embeddedTypeOf returns the definition of the embedded object type named by the given
+// field type, including within lists, if any.
+func embeddedTypeOf(embedded map[string]*ast.ObjectDefinition, t ast.Type) (*ast.ObjectDefinition, bool) {
+	switch typeVal := t.(type) {
+	case *ast.Named:
+		def, isEmbedded := embedded[typeVal.Name.Value]
+		return def, isEmbedded
+	case *ast.List:
+		return embeddedTypeOf(embedded, typeVal.Type)
+	case *ast.NonNull:
+		return embeddedTypeOf(embedded, typeVal.Type)
+	default:
+		return nil, false
+	}
+}
+
+// embeddedFieldsFromAst builds the descriptions of the embedded object field of the given
+// name and of the fields of the object it holds, which are named by their path from the
+// host object, e.g. `address.city`.
+//
+// Embedded objects may hold other embedded objects, but not lists of them, relations, or
+// themselves.
+func embeddedFieldsFromAst(
+	hostName string,
+	fieldName string,
+	fieldType ast.Type,
+	def *ast.ObjectDefinition,
+	enums map[string]client.EnumDescription,
+	embedded map[string]*ast.ObjectDefinition,
+	enumDescriptions *[]client.EnumDescription,
+	visiting map[string]struct{},
+) ([]client.FieldDescription, error) {
+	if _, isNamed := fieldType.(*ast.Named); !isNamed {
+		return nil, NewErrInvalidEmbeddedField(hostName, fieldName)
+	}
+	if _, isVisiting := visiting[def.Name.Value]; isVisiting {
+		return nil, NewErrInvalidEmbeddedField(hostName, fieldName)
+	}
+	visiting[def.Name.Value] = struct{}{}
+	defer delete(visiting, def.Name.Value)
+
+	fieldDescriptions := []client.FieldDescription{
+		{
+			Name:   fieldName,
+			Kind:   client.FieldKind_EMBEDDED_OBJECT,
+			Typ:    defaultCRDTForFieldKind[client.FieldKind_EMBEDDED_OBJECT],
+			Schema: def.Name.Value,
+		},
+	}
+
+	for _, field := range def.Fields {
+		name := fieldName + client.EmbeddedFieldSeparator + field.Name.Value
+
+		if embeddedDef, isEmbedded := embeddedTypeOf(embedded, field.Type); isEmbedded {
+			embeddedFields, err := embeddedFieldsFromAst(
+				hostName,
+				name,
+				field.Type,
+				embeddedDef,
+				enums,
+				embedded,
+				enumDescriptions,
+				visiting,
+			)
+			if err != nil {
+				return nil, err
+			}
+			fieldDescriptions = append(fieldDescriptions, embeddedFields...)
+			continue
+		}
+
+		kind, err := astTypeToKind(field.Type)
+		if err != nil {
+			return nil, err
+		}
+
+		schema := ""
+		if named, isNamed := field.Type.(*ast.Named); isNamed {
+			if enum, isEnum := enums[named.Name.Value]; isEnum {
+				kind = client.FieldKind_ENUM
+				schema = enum.Name
+				if !containsEnum(*enumDescriptions, enum.Name) {
+					*enumDescriptions = append(*enumDescriptions, enum)
+				}
+			}
+		}
+
+		if kind == client.FieldKind_FOREIGN_OBJECT || kind == client.FieldKind_FOREIGN_OBJECT_ARRAY {
+			return nil, NewErrInvalidEmbeddedField(hostName, name)
+		}
+
+		crdtType := defaultCRDTForFieldKind[kind]
+		if directive, exists := findDirective(field, schemaTypes.CRDTLabel); exists {
+			crdtType, err = crdtTypeFromAst(def.Name.Value, field.Name.Value, directive)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		fieldDescriptions = append(fieldDescriptions, client.FieldDescription{
+			Name:   name,
+			Kind:   kind,
+			Typ:    crdtType,
+			Schema: schema,
+		})
+	}
+
+	return fieldDescriptions, nil
+}
+
+func containsEnum(enums []client.EnumDescription, name string) bool {
+	for _, enum := range enums {
+		if enum.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// fieldIndexFromAst builds the description of the secondary index declared on the given field
+// by an @index, @unique or @fulltext directive.
+//
+// An @index directive on a vector field declares a vector index, measuring the distance between
+// vectors by the given `metric` argument, or by their cosine distance if none is provided.
+//
+// If no name is provided, one will be generated from the names of the host object and the field.
+func fieldIndexFromAst(
+	hostName string,
+	fieldName string,
+	kind client.FieldKind,
+	directive *ast.Directive,
+) (client.IndexDescription, error) {
+	index := client.IndexDescription{
+		Name: fmt.Sprintf("%s_%s", strings.ToLower(hostName), fieldName),
+		Fields: []client.IndexedFieldDescription{
+			{
+				Name: fieldName,
+			},
+		},
+		Unique:   directive.Name.Value == "unique",
+		FullText: directive.Name.Value == "fulltext",
+		Vector:   directive.Name.Value == "index" && kind == client.FieldKind_FLOAT_VECTOR,
+	}
+	if index.Vector {
+		index.Metric = string(request.CosineMetric)
+	}
+
+	for _, argument := range directive.Arguments {
+		switch argument.Name.Value {
+		case "name":
+			name, isString := argument.Value.GetValue().(string)
+			if !isString {
+				return client.IndexDescription{}, client.NewErrUnexpectedType[string](
+					"Index name",
+					argument.Value.GetValue(),
+				)
+			}
+			index.Name = name
+
+		case "metric":
+			metric, isString := argument.Value.GetValue().(string)
+			if !isString {
+				return client.IndexDescription{}, client.NewErrUnexpectedType[string](
+					"Index metric",
+					argument.Value.GetValue(),
+				)
+			}
+			index.Metric = metric
+		}
+	}
+
+	return index, nil
+}
+
+// typeIndexFromAst builds the description of the secondary index declared on the given host
+// object by an @index or @unique directive.  The indexed fields are taken from the `fields`
+// argument, in the order in which they are declared.
+//
+// If no name is provided, one will be generated from the names of the host object and the fields.
+func typeIndexFromAst(
+	hostName string,
+	directive *ast.Directive,
+) (client.IndexDescription, error) {
+	index := client.IndexDescription{
+		Unique: directive.Name.Value == "unique",
+	}
+
+	for _, argument := range directive.Arguments {
+		switch argument.Name.Value {
+		case "name":
+			name, isString := argument.Value.GetValue().(string)
+			if !isString {
+				return client.IndexDescription{}, client.NewErrUnexpectedType[string](
+					"Index name",
+					argument.Value.GetValue(),
+				)
+			}
+			index.Name = name
+
+		case "fields":
+			fieldValues, isList := argument.Value.GetValue().([]ast.Value)
+			if !isList {
+				return client.IndexDescription{}, client.NewErrUnexpectedType[[]string](
+					"Index fields",
+					argument.Value.GetValue(),
+				)
+			}
+			for _, fieldValue := range fieldValues {
+				fieldName, isString := fieldValue.GetValue().(string)
+				if !isString {
+					return client.IndexDescription{}, client.NewErrUnexpectedType[string](
+						"Index field",
+						fieldValue.GetValue(),
+					)
+				}
+				index.Fields = append(index.Fields, client.IndexedFieldDescription{Name: fieldName})
+			}
+		}
+	}
+
+	if index.Name == "" {
+		nameParts := []string{strings.ToLower(hostName)}
+		for _, field := range index.Fields {
+			nameParts = append(nameParts, field.Name)
+		}
+		index.Name = strings.Join(nameParts, "_")
+	}
+
+	return index, nil
+}
+
+// vectorDimensionsFromAst returns the number of dimensions declared by the given @vector directive.
+func vectorDimensionsFromAst(
+	hostName string,
+	fieldName string,
+	directive *ast.Directive,
+) (int, error) {
+	for _, argument := range directive.Arguments {
+		if argument.Name.Value != "dimensions" {
+			continue
+		}
+		value, isInt := argument.Value.(*ast.IntValue)
+		if !isInt {
+			return 0, client.NewErrUnexpectedType[int]("Vector dimensions", argument.Value.GetValue())
+		}
+		dimensions, err := strconv.Atoi(value.Value)
+		if err != nil {
+			return 0, err
+		}
+		if dimensions < 1 {
+			return 0, NewErrInvalidVectorField(hostName, fieldName)
+		}
+		return dimensions, nil
+	}
+
+	return 0, NewErrInvalidVectorField(hostName, fieldName)
+}
+
+// defaultValueFromAst returns the default value declared by the given @default directive.
+func defaultValueFromAst(
+	hostName string,
+	fieldName string,
+	directive *ast.Directive,
+) (client.DefaultValue, error) {
+	for _, argument := range directive.Arguments {
+		if argument.Name.Value != "value" {
+			continue
+		}
+		value := schemaTypes.JSONScalarType.ParseLiteral(argument.Value)
+		if value == nil {
+			return "", NewErrInvalidDefaultValue(hostName, fieldName)
+		}
+		return client.NewDefaultValue(value)
+	}
+
+	return "", NewErrInvalidDefaultValue(hostName, fieldName)
+}
+
+// constraintFromAst returns the constraint declared by the given @constraint directive.
+func constraintFromAst(
+	hostName string,
+	fieldName string,
+	directive *ast.Directive,
+) (client.ConstraintDescription, error) {
+	constraint := client.ConstraintDescription{
+		Field: fieldName,
+	}
+	for _, argument := range directive.Arguments {
+		switch argument.Name.Value {
+		case "min", "max":
+			var value string
+			switch argValue := argument.Value.(type) {
+			case *ast.IntValue:
+				value = argValue.Value
+			case *ast.FloatValue:
+				value = argValue.Value
+			default:
+				return client.ConstraintDescription{}, NewErrInvalidConstraint(hostName, fieldName)
+			}
+			bound, err := strconv.ParseFloat(value, 64)
+			if err != nil {
+				return client.ConstraintDescription{}, err
+			}
+			if argument.Name.Value == "min" {
+				constraint.Min = &bound
+			} else {
+				constraint.Max = &bound
+			}
+
+		case "minLength", "maxLength":
+			value, isInt := argument.Value.(*ast.IntValue)
+			if !isInt {
+				return client.ConstraintDescription{}, NewErrInvalidConstraint(hostName, fieldName)
+			}
+			length, err := strconv.Atoi(value.Value)
+			if err != nil {
+				return client.ConstraintDescription{}, err
+			}
+			if argument.Name.Value == "minLength" {
+				constraint.MinLength = &length
+			} else {
+				constraint.MaxLength = &length
+			}
+
+		case "pattern":
+			value, isString := argument.Value.(*ast.StringValue)
+			if !isString {
+				return client.ConstraintDescription{}, NewErrInvalidConstraint(hostName, fieldName)
+			}
+			constraint.Pattern = value.Value
+
+		default:
+			return client.ConstraintDescription{}, NewErrInvalidConstraint(hostName, fieldName)
+		}
+	}
+	return constraint, nil
+}
+
+// crdtTypeFromAst returns the CRDT type given by the given @crdt directive.
+func crdtTypeFromAst(hostName string, fieldName string, directive *ast.Directive) (client.CType, error) {
+	if len(directive.Arguments) != 1 || directive.Arguments[0].Name.Value != schemaTypes.CRDTArgNameType {
+		return client.NONE_CRDT, NewErrInvalidCRDTType(hostName, fieldName)
+	}
+
+	var name string
+	switch value := directive.Arguments[0].Value.(type) {
+	case *ast.EnumValue:
+		name = value.Value
+	case *ast.StringValue:
+		name = value.Value
+	default:
+		return client.NONE_CRDT, NewErrInvalidCRDTType(hostName, fieldName)
+	}
+
+	crdtType, isKnown := schemaTypes.CRDTEnum.ParseValue(name).(client.CType)
+	if !isKnown {
+		return client.NONE_CRDT, NewErrInvalidCRDTType(hostName, fieldName)
+	}
+	return crdtType, nil
+}
+
 func astTypeToKind(t ast.Type) (client.FieldKind, error) {
 	const (
 		typeID       string = "ID"
@@ -176,6 +713,10 @@ func astTypeToKind(t ast.Type) (client.FieldKind, error) {
 		typeFloat    string = "Float"
 		typeDateTime string = "DateTime"
 		typeString   string = "String"
+		typeJSON     string = "JSON"
+		typeBlob     string = "Blob"
+		typeDecimal  string = "Decimal"
+		typeBigInt   string = "BigInt"
 	)
 
 	switch astTypeVal := t.(type) {
@@ -224,6 +765,14 @@ func astTypeToKind(t ast.Type) (client.FieldKind, error) {
 			return client.FieldKind_DATETIME, nil
 		case typeString:
 			return client.FieldKind_STRING, nil
+		case typeJSON:
+			return client.FieldKind_JSON, nil
+		case typeBlob:
+			return client.FieldKind_BLOB, nil
+		case typeDecimal:
+			return client.FieldKind_DECIMAL, nil
+		case typeBigInt:
+			return client.FieldKind_BIG_INT, nil
 		default:
 			return client.FieldKind_FOREIGN_OBJECT, nil
 		}
@@ -14,6 +14,7 @@ import (
 	gql "github.com/graphql-go/graphql"
 
 	"github.com/sourcenetwork/defradb/client"
+	schemaTypes "github.com/sourcenetwork/defradb/request/graphql/schema/types"
 )
 
 var (
@@ -32,7 +33,6 @@ var (
 		&gql.Object{}: client.FieldKind_FOREIGN_OBJECT,
 		&gql.List{}:   client.FieldKind_FOREIGN_OBJECT_ARRAY,
 		// More custom ones to come
-		// - JSON
 		// - ByteArray
 		// - Counters
 	}
@@ -48,10 +48,15 @@ var (
 		client.FieldKind_FLOAT:                 gql.Float,
 		client.FieldKind_FLOAT_ARRAY:           gql.NewList(gql.NewNonNull(gql.Float)),
 		client.FieldKind_NILLABLE_FLOAT_ARRAY:  gql.NewList(gql.Float),
+		client.FieldKind_FLOAT_VECTOR:          gql.NewList(gql.NewNonNull(gql.Float)),
 		client.FieldKind_DATETIME:              gql.DateTime,
 		client.FieldKind_STRING:                gql.String,
 		client.FieldKind_STRING_ARRAY:          gql.NewList(gql.NewNonNull(gql.String)),
 		client.FieldKind_NILLABLE_STRING_ARRAY: gql.NewList(gql.String),
+		client.FieldKind_JSON:                  schemaTypes.JSONScalarType,
+		client.FieldKind_BLOB:                  gql.String,
+		client.FieldKind_DECIMAL:               schemaTypes.DecimalScalarType,
+		client.FieldKind_BIG_INT:               schemaTypes.BigIntScalarType,
 	}
 
 	// This map is fine to use
@@ -66,10 +71,17 @@ var (
 		client.FieldKind_FLOAT:                 client.LWW_REGISTER,
 		client.FieldKind_FLOAT_ARRAY:           client.LWW_REGISTER,
 		client.FieldKind_NILLABLE_FLOAT_ARRAY:  client.LWW_REGISTER,
+		client.FieldKind_FLOAT_VECTOR:          client.LWW_REGISTER,
 		client.FieldKind_DATETIME:              client.LWW_REGISTER,
 		client.FieldKind_STRING:                client.LWW_REGISTER,
 		client.FieldKind_STRING_ARRAY:          client.LWW_REGISTER,
 		client.FieldKind_NILLABLE_STRING_ARRAY: client.LWW_REGISTER,
+		client.FieldKind_JSON:                  client.LWW_REGISTER,
+		client.FieldKind_BLOB:                  client.LWW_REGISTER,
+		client.FieldKind_DECIMAL:               client.LWW_REGISTER,
+		client.FieldKind_BIG_INT:               client.LWW_REGISTER,
+		client.FieldKind_ENUM:                  client.LWW_REGISTER,
+		client.FieldKind_EMBEDDED_OBJECT:       client.LWW_REGISTER,
 		client.FieldKind_FOREIGN_OBJECT:        client.NONE_CRDT,
 		client.FieldKind_FOREIGN_OBJECT_ARRAY:  client.NONE_CRDT,
 	}
@@ -118,6 +130,9 @@ An optional value that specifies as to whether deleted documents may be
 `
 	createDocumentDescription string = `
 Creates a single document of this type using the data provided.
+`
+	mutationMessageArgDescription string = `
+An optional message describing the mutation, recorded in the commits it makes.
 `
 	createDataArgDescription string = `
 The json representation of the document you wish to create. Required.
@@ -145,6 +160,31 @@ An optional filter for this update that will limit the update to the documents
 	updateDataArgDescription string = `
 The json representation of the fields to update and their new values. Required.
  Fields not explicitly mentioned here will not be updated.
+`
+	incrementDocumentsDescription string = `
+Increments the counter fields of documents in this collection by the amounts
+ provided. Only documents matching any provided criteria will be incremented, if
+ no criteria are provided the increments will be applied to all documents in the
+ collection.
+`
+	incrementIDArgDescription string = `
+An optional dockey value that will limit the increment to the document with
+ a matching dockey. If no matching document is found, the operation will
+ succeed, but no documents will be incremented.
+`
+	incrementIDsArgDescription string = `
+An optional set of dockey values that will limit the increment to documents
+ with a matching dockey. If no matching documents are found, the operation will
+ succeed, but no documents will be incremented.
+`
+	incrementFilterArgDescription string = `
+An optional filter for this increment that will limit the increment to the
+ documents matching the given criteria. If no matching documents are found, the
+ operation will succeed, but no documents will be incremented.
+`
+	incrementDataArgDescription string = `
+The json representation of the counter fields to increment and the amounts to
+ increment them by, which may be negative to decrement them. Required.
 `
 	deleteDocumentsDescription string = `
 Deletes documents in this collection matching any provided criteria. If no
@@ -180,5 +220,21 @@ Indicates as to whether or not this document has been deleted.
 `
 	versionFieldDescription string = `
 Returns the head commit for this document.
+`
+	scoreFieldDescription string = `
+The relevance of this document to the full-text search ('_match') conditions of the
+ request, higher is more relevant. Null if there are no such conditions.
+`
+	distanceFieldDescription string = `
+The distance of this document from the vector of the nearest-neighbour search ('_similar')
+ of the request, lower is more similar. Null if there is no such search.
+`
+	conflictsFieldDescription string = `
+The values written concurrently to the mvregister fields of this document, keyed by field
+ name, for each field holding more than one value. Each value is given with the cid of the
+ commit writing it, newest first. A later write to a field replaces all of its values.
+`
+	defaultValueFieldDescription string = `
+Defaults to %s if no value is given on create.
 `
 )
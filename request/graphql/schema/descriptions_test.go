@@ -571,6 +571,473 @@ func TestSingleSimpleType(t *testing.T) {
 				},
 			},
 		},
+		{
+			description: "Simple type with indexed fields",
+			sdl: `
+			type user {
+				name: String @index
+				age: Int @index(name: "user_age_index")
+				verified: Boolean
+			}
+			`,
+			targetDescs: []client.CollectionDescription{
+				{
+					Name: "user",
+					Schema: client.SchemaDescription{
+						Name: "user",
+						Fields: []client.FieldDescription{
+							{
+								Name: "_key",
+								Kind: client.FieldKind_DocKey,
+								Typ:  client.NONE_CRDT,
+							},
+							{
+								Name: "age",
+								Kind: client.FieldKind_INT,
+								Typ:  client.LWW_REGISTER,
+							},
+							{
+								Name: "name",
+								Kind: client.FieldKind_STRING,
+								Typ:  client.LWW_REGISTER,
+							},
+							{
+								Name: "verified",
+								Kind: client.FieldKind_BOOL,
+								Typ:  client.LWW_REGISTER,
+							},
+						},
+					},
+					Indexes: []client.IndexDescription{
+						{
+							Name: "user_name",
+							Fields: []client.IndexedFieldDescription{
+								{
+									Name: "name",
+								},
+							},
+						},
+						{
+							Name: "user_age_index",
+							Fields: []client.IndexedFieldDescription{
+								{
+									Name: "age",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			description: "Simple type with composite indexes",
+			sdl: `
+			type user @index(fields: ["name", "age"]) @index(name: "user_by_age", fields: ["age", "verified"]) {
+				name: String
+				age: Int
+				verified: Boolean
+			}
+			`,
+			targetDescs: []client.CollectionDescription{
+				{
+					Name: "user",
+					Schema: client.SchemaDescription{
+						Name: "user",
+						Fields: []client.FieldDescription{
+							{
+								Name: "_key",
+								Kind: client.FieldKind_DocKey,
+								Typ:  client.NONE_CRDT,
+							},
+							{
+								Name: "age",
+								Kind: client.FieldKind_INT,
+								Typ:  client.LWW_REGISTER,
+							},
+							{
+								Name: "name",
+								Kind: client.FieldKind_STRING,
+								Typ:  client.LWW_REGISTER,
+							},
+							{
+								Name: "verified",
+								Kind: client.FieldKind_BOOL,
+								Typ:  client.LWW_REGISTER,
+							},
+						},
+					},
+					Indexes: []client.IndexDescription{
+						{
+							Name: "user_name_age",
+							Fields: []client.IndexedFieldDescription{
+								{
+									Name: "name",
+								},
+								{
+									Name: "age",
+								},
+							},
+						},
+						{
+							Name: "user_by_age",
+							Fields: []client.IndexedFieldDescription{
+								{
+									Name: "age",
+								},
+								{
+									Name: "verified",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			description: "Simple type with unique fields",
+			sdl: `
+			type user @unique(fields: ["name", "age"]) {
+				name: String
+				age: Int
+				email: String @unique
+			}
+			`,
+			targetDescs: []client.CollectionDescription{
+				{
+					Name: "user",
+					Schema: client.SchemaDescription{
+						Name: "user",
+						Fields: []client.FieldDescription{
+							{
+								Name: "_key",
+								Kind: client.FieldKind_DocKey,
+								Typ:  client.NONE_CRDT,
+							},
+							{
+								Name: "age",
+								Kind: client.FieldKind_INT,
+								Typ:  client.LWW_REGISTER,
+							},
+							{
+								Name: "email",
+								Kind: client.FieldKind_STRING,
+								Typ:  client.LWW_REGISTER,
+							},
+							{
+								Name: "name",
+								Kind: client.FieldKind_STRING,
+								Typ:  client.LWW_REGISTER,
+							},
+						},
+					},
+					Indexes: []client.IndexDescription{
+						{
+							Name: "user_email",
+							Fields: []client.IndexedFieldDescription{
+								{
+									Name: "email",
+								},
+							},
+							Unique: true,
+						},
+						{
+							Name: "user_name_age",
+							Fields: []client.IndexedFieldDescription{
+								{
+									Name: "name",
+								},
+								{
+									Name: "age",
+								},
+							},
+							Unique: true,
+						},
+					},
+				},
+			},
+		},
+		{
+			description: "Single type with enum field",
+			sdl: `
+			type ticket {
+				title: String
+				status: Status
+			}
+
+			enum Status {
+				OPEN
+				CLOSED
+			}
+			`,
+			targetDescs: []client.CollectionDescription{
+				{
+					Name: "ticket",
+					Schema: client.SchemaDescription{
+						Name: "ticket",
+						Fields: []client.FieldDescription{
+							{
+								Name: "_key",
+								Kind: client.FieldKind_DocKey,
+								Typ:  client.NONE_CRDT,
+							},
+							{
+								Name:   "status",
+								Kind:   client.FieldKind_ENUM,
+								Typ:    client.LWW_REGISTER,
+								Schema: "Status",
+							},
+							{
+								Name: "title",
+								Kind: client.FieldKind_STRING,
+								Typ:  client.LWW_REGISTER,
+							},
+						},
+						Enums: []client.EnumDescription{
+							{
+								Name:   "Status",
+								Values: []string{"OPEN", "CLOSED"},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			description: "Single type with embedded object field",
+			sdl: `
+			type user {
+				name: String
+				address: Address
+			}
+
+			type Address @embedded {
+				city: String
+				geo: Geo
+			}
+
+			type Geo @embedded {
+				lat: Float
+			}
+			`,
+			targetDescs: []client.CollectionDescription{
+				{
+					Name: "user",
+					Schema: client.SchemaDescription{
+						Name: "user",
+						Fields: []client.FieldDescription{
+							{
+								Name: "_key",
+								Kind: client.FieldKind_DocKey,
+								Typ:  client.NONE_CRDT,
+							},
+							{
+								Name:   "address",
+								Kind:   client.FieldKind_EMBEDDED_OBJECT,
+								Typ:    client.LWW_REGISTER,
+								Schema: "Address",
+							},
+							{
+								Name: "address.city",
+								Kind: client.FieldKind_STRING,
+								Typ:  client.LWW_REGISTER,
+							},
+							{
+								Name:   "address.geo",
+								Kind:   client.FieldKind_EMBEDDED_OBJECT,
+								Typ:    client.LWW_REGISTER,
+								Schema: "Geo",
+							},
+							{
+								Name: "address.geo.lat",
+								Kind: client.FieldKind_FLOAT,
+								Typ:  client.LWW_REGISTER,
+							},
+							{
+								Name: "name",
+								Kind: client.FieldKind_STRING,
+								Typ:  client.LWW_REGISTER,
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			description: "Single type with default values",
+			sdl: `
+			type user {
+				name: String @default(value: "anonymous")
+				age: Int @default(value: 18)
+			}
+			`,
+			targetDescs: []client.CollectionDescription{
+				{
+					Name: "user",
+					Schema: client.SchemaDescription{
+						Name: "user",
+						Fields: []client.FieldDescription{
+							{
+								Name: "_key",
+								Kind: client.FieldKind_DocKey,
+								Typ:  client.NONE_CRDT,
+							},
+							{
+								Name:         "age",
+								Kind:         client.FieldKind_INT,
+								Typ:          client.LWW_REGISTER,
+								DefaultValue: "18",
+							},
+							{
+								Name:         "name",
+								Kind:         client.FieldKind_STRING,
+								Typ:          client.LWW_REGISTER,
+								DefaultValue: `"anonymous"`,
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			description: "Single type with required fields",
+			sdl: `
+			type user {
+				name: String!
+				tags: [String!]!
+			}
+			`,
+			targetDescs: []client.CollectionDescription{
+				{
+					Name: "user",
+					Schema: client.SchemaDescription{
+						Name: "user",
+						Fields: []client.FieldDescription{
+							{
+								Name: "_key",
+								Kind: client.FieldKind_DocKey,
+								Typ:  client.NONE_CRDT,
+							},
+							{
+								Name:       "name",
+								Kind:       client.FieldKind_STRING,
+								Typ:        client.LWW_REGISTER,
+								IsRequired: true,
+							},
+							{
+								Name:       "tags",
+								Kind:       client.FieldKind_STRING_ARRAY,
+								Typ:        client.LWW_REGISTER,
+								IsRequired: true,
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			description: "Single type with constraints",
+			sdl: `
+			type user {
+				name: String @constraint(minLength: 1, pattern: "^[a-z]+$")
+				age: Int @constraint(min: 0, max: 150.5)
+			}
+			`,
+			targetDescs: []client.CollectionDescription{
+				{
+					Name: "user",
+					Schema: client.SchemaDescription{
+						Name: "user",
+						Fields: []client.FieldDescription{
+							{
+								Name: "_key",
+								Kind: client.FieldKind_DocKey,
+								Typ:  client.NONE_CRDT,
+							},
+							{
+								Name: "age",
+								Kind: client.FieldKind_INT,
+								Typ:  client.LWW_REGISTER,
+							},
+							{
+								Name: "name",
+								Kind: client.FieldKind_STRING,
+								Typ:  client.LWW_REGISTER,
+							},
+						},
+						Constraints: []client.ConstraintDescription{
+							{
+								Field:     "name",
+								MinLength: ptrTo(1),
+								Pattern:   "^[a-z]+$",
+							},
+							{
+								Field: "age",
+								Min:   ptrTo(0.0),
+								Max:   ptrTo(150.5),
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			description: "Single type with CRDT types",
+			sdl: `
+			type user {
+				likes: Int @crdt(type: pncounter)
+				name: String @crdt(type: lww)
+				tags: [String!] @crdt(type: orset)
+				body: String @crdt(type: text)
+				status: String @crdt(type: mvregister)
+				edited: DateTime @crdt(type: lwwhlc)
+			}
+			`,
+			targetDescs: []client.CollectionDescription{
+				{
+					Name: "user",
+					Schema: client.SchemaDescription{
+						Name: "user",
+						Fields: []client.FieldDescription{
+							{
+								Name: "_key",
+								Kind: client.FieldKind_DocKey,
+								Typ:  client.NONE_CRDT,
+							},
+							{
+								Name: "body",
+								Kind: client.FieldKind_STRING,
+								Typ:  client.RGA_TEXT,
+							},
+							{
+								Name: "edited",
+								Kind: client.FieldKind_DATETIME,
+								Typ:  client.LWW_HLC_REGISTER,
+							},
+							{
+								Name: "likes",
+								Kind: client.FieldKind_INT,
+								Typ:  client.PN_COUNTER,
+							},
+							{
+								Name: "name",
+								Kind: client.FieldKind_STRING,
+								Typ:  client.LWW_REGISTER,
+							},
+							{
+								Name: "status",
+								Kind: client.FieldKind_STRING,
+								Typ:  client.MV_REGISTER,
+							},
+							{
+								Name: "tags",
+								Kind: client.FieldKind_STRING_ARRAY,
+								Typ:  client.OR_SET,
+							},
+						},
+					},
+				},
+			},
+		},
 	}
 
 	for _, test := range cases {
@@ -578,6 +1045,73 @@ func TestSingleSimpleType(t *testing.T) {
 	}
 }
 
+func TestEmbeddedObjectListErrors(t *testing.T) {
+	_, err := FromString(context.Background(), `
+		type user {
+			addresses: [Address]
+		}
+
+		type Address @embedded {
+			city: String
+		}
+	`)
+	assert.ErrorIs(t, err, ErrInvalidEmbeddedField)
+}
+
+func TestEmbeddedObjectHoldingItselfErrors(t *testing.T) {
+	_, err := FromString(context.Background(), `
+		type user {
+			node: Node
+		}
+
+		type Node @embedded {
+			next: Node
+		}
+	`)
+	assert.ErrorIs(t, err, ErrInvalidEmbeddedField)
+}
+
+func TestDefaultValueWithoutValueErrors(t *testing.T) {
+	_, err := FromString(context.Background(), `
+		type user {
+			name: String @default
+		}
+	`)
+	assert.ErrorIs(t, err, ErrInvalidDefaultValue)
+}
+
+func TestConstraintWithUnknownArgumentErrors(t *testing.T) {
+	_, err := FromString(context.Background(), `
+		type user {
+			name: String @constraint(length: 2)
+		}
+	`)
+	assert.ErrorIs(t, err, ErrInvalidConstraint)
+}
+
+func TestCRDTWithUnknownTypeErrors(t *testing.T) {
+	_, err := FromString(context.Background(), `
+		type user {
+			likes: Int @crdt(type: gcounter)
+		}
+	`)
+	assert.ErrorIs(t, err, ErrInvalidCRDTType)
+}
+
+func TestCRDTWithStringTypeName(t *testing.T) {
+	descs, err := FromString(context.Background(), `
+		type user {
+			likes: Int @crdt(type: "pncounter")
+		}
+	`)
+	assert.NoError(t, err)
+	assert.Equal(t, client.PN_COUNTER, descs[0].Schema.Fields[1].Typ)
+}
+
+func ptrTo[T any](value T) *T {
+	return &value
+}
+
 func runCreateDescriptionTest(t *testing.T, testcase descriptionTestCase) {
 	ctx := context.Background()
 
@@ -22,6 +22,11 @@ const (
 	errTypeNotFound               string = "no type found for given name"
 	errRelationNotFound           string = "no relation found"
 	errNonNullForTypeNotSupported string = "NonNull variants for type are not supported"
+	errInvalidVectorField         string = "vector fields must be of type [Float!] with a positive number of dimensions"
+	errInvalidConstraint          string = "@constraint directives may only give min, max, minLength, maxLength and pattern"
+	errInvalidDefaultValue        string = "@default directives must provide a non-null value"
+	errInvalidEmbeddedField       string = "embedded object fields may not be lists, nor hold relations or themselves"
+	errInvalidCRDTType            string = "@crdt directives must give the type of a known CRDT"
 )
 
 var (
@@ -34,6 +39,11 @@ var (
 	ErrTypeNotFound               = errors.New(errTypeNotFound)
 	ErrRelationNotFound           = errors.New(errRelationNotFound)
 	ErrNonNullForTypeNotSupported = errors.New(errNonNullForTypeNotSupported)
+	ErrInvalidVectorField         = errors.New(errInvalidVectorField)
+	ErrInvalidEmbeddedField       = errors.New(errInvalidEmbeddedField)
+	ErrInvalidDefaultValue        = errors.New(errInvalidDefaultValue)
+	ErrInvalidConstraint          = errors.New(errInvalidConstraint)
+	ErrInvalidCRDTType            = errors.New(errInvalidCRDTType)
 	ErrRelationMutlipleTypes      = errors.New("relation type can only be either One or Many, not both")
 	ErrRelationMissingTypes       = errors.New("relation is missing its defined types and fields")
 	ErrRelationInvalidType        = errors.New("relation has an invalid type to be finalize")
@@ -110,3 +120,43 @@ func NewErrRelationNotFound(relationName string) error {
 		errors.NewKV("RelationName", relationName),
 	)
 }
+
+func NewErrInvalidVectorField(objectName, fieldName string) error {
+	return errors.New(
+		errInvalidVectorField,
+		errors.NewKV("Object", objectName),
+		errors.NewKV("Field", fieldName),
+	)
+}
+
+func NewErrInvalidEmbeddedField(objectName, fieldName string) error {
+	return errors.New(
+		errInvalidEmbeddedField,
+		errors.NewKV("Object", objectName),
+		errors.NewKV("Field", fieldName),
+	)
+}
+
+func NewErrInvalidDefaultValue(objectName, fieldName string) error {
+	return errors.New(
+		errInvalidDefaultValue,
+		errors.NewKV("Object", objectName),
+		errors.NewKV("Field", fieldName),
+	)
+}
+
+func NewErrInvalidConstraint(objectName, fieldName string) error {
+	return errors.New(
+		errInvalidConstraint,
+		errors.NewKV("Object", objectName),
+		errors.NewKV("Field", fieldName),
+	)
+}
+
+func NewErrInvalidCRDTType(objectName, fieldName string) error {
+	return errors.New(
+		errInvalidCRDTType,
+		errors.NewKV("Object", objectName),
+		errors.NewKV("Field", fieldName),
+	)
+}
@@ -33,6 +33,14 @@ type Generator struct {
 	manager  *SchemaManager
 
 	expandedFields map[string]bool
+
+	// embeddedTypes contains the names of the embedded object types, which are held
+	// inline by their host objects and so have no query arguments of their own.
+	embeddedTypes map[string]struct{}
+
+	// counterTypes contains the names of the types with PN counter fields, which may be
+	// incremented by their increment mutation.
+	counterTypes map[string]struct{}
 }
 
 // NewGenerator creates a new instance of the Generator
@@ -41,6 +49,8 @@ func (m *SchemaManager) NewGenerator() *Generator {
 	m.Generator = &Generator{
 		manager:        m,
 		expandedFields: make(map[string]bool),
+		embeddedTypes:  make(map[string]struct{}),
+		counterTypes:   make(map[string]struct{}),
 	}
 	return m.Generator
 }
@@ -199,6 +209,10 @@ func (g *Generator) expandInputArgument(obj *gql.Object) error {
 		fieldKey := obj.Name() + f
 		switch t := def.Type.(type) {
 		case *gql.Object:
+			if _, isEmbedded := g.embeddedTypes[t.Name()]; isEmbedded {
+				// Embedded objects are held inline and may not be filtered themselves.
+				continue
+			}
 			if _, complete := g.expandedFields[fieldKey]; complete {
 				continue
 			}
@@ -332,6 +346,10 @@ func (g *Generator) createExpandedFieldList(
 				gql.NewList(gql.NewNonNull(g.manager.schema.TypeMap()[typeName+"Fields"])),
 				schemaTypes.GroupByArgDescription,
 			),
+			request.DistinctClause: schemaTypes.NewArgConfig(
+				gql.NewList(gql.NewNonNull(g.manager.schema.TypeMap()[typeName+"Fields"])),
+				schemaTypes.DistinctArgDescription,
+			),
 			"order": schemaTypes.NewArgConfig(
 				g.manager.schema.TypeMap()[typeName+"OrderArg"],
 				schemaTypes.OrderArgDescription,
@@ -341,6 +359,13 @@ func (g *Generator) createExpandedFieldList(
 		},
 	}
 
+	if similarArg, isSearchable := g.manager.schema.TypeMap()[typeName+"SimilarArg"]; isSearchable {
+		field.Args[request.SimilarClause] = schemaTypes.NewArgConfig(
+			similarArg,
+			schemaTypes.SimilarArgDescription,
+		)
+	}
+
 	return field, nil
 }
 
@@ -360,6 +385,16 @@ func (g *Generator) buildTypes(
 	// get all the defined types from the AST
 	objs := make([]*gql.Object, 0)
 
+	err := g.buildEnumTypes(collections)
+	if err != nil {
+		return nil, err
+	}
+
+	err = g.buildEmbeddedTypes(collections)
+	if err != nil {
+		return nil, err
+	}
+
 	for _, c := range collections {
 		// Copy the loop variable before usage within the loop or it
 		// will be reassigned before the thunk is run
@@ -371,6 +406,12 @@ func (g *Generator) buildTypes(
 			return nil, NewErrSchemaTypeAlreadyExist(collection.Name)
 		}
 
+		for _, field := range fieldDescriptions {
+			if field.Typ == client.PN_COUNTER {
+				g.counterTypes[collection.Name] = struct{}{}
+			}
+		}
+
 		objconf := gql.ObjectConfig{
 			Name: collection.Name,
 		}
@@ -395,32 +436,26 @@ func (g *Generator) buildTypes(
 					// description)
 					continue
 				}
+				if field.IsEmbeddedField() {
+					// The fields of embedded objects are held by the embedded object types.
+					continue
+				}
 
-				var ttype gql.Type
-				if field.Kind == client.FieldKind_FOREIGN_OBJECT {
-					var ok bool
-					ttype, ok = g.manager.schema.TypeMap()[field.Schema]
-					if !ok {
-						return nil, NewErrTypeNotFound(field.Schema)
-					}
-				} else if field.Kind == client.FieldKind_FOREIGN_OBJECT_ARRAY {
-					t, ok := g.manager.schema.TypeMap()[field.Schema]
-					if !ok {
-						return nil, NewErrTypeNotFound(field.Schema)
-					}
-					ttype = gql.NewList(t)
-				} else {
-					var ok bool
-					ttype, ok = fieldKindToGQLType[field.Kind]
-					if !ok {
-						return nil, NewErrTypeNotFound(fmt.Sprint(field.Kind))
-					}
+				ttype, err := g.fieldType(field)
+				if err != nil {
+					return nil, err
+				}
+				if field.IsRequired {
+					ttype = gql.NewNonNull(ttype)
 				}
 
 				fields[field.Name] = &gql.Field{
 					Name: field.Name,
 					Type: ttype,
 				}
+				if field.DefaultValue.HasValue() {
+					fields[field.Name].Description = fmt.Sprintf(defaultValueFieldDescription, field.DefaultValue)
+				}
 			}
 
 			// add _version field
@@ -435,6 +470,30 @@ func (g *Generator) buildTypes(
 				Type:        gql.Boolean,
 			}
 
+			// add _score field to types that may be searched by full-text
+			if collection.HasFullTextIndex() {
+				fields[request.ScoreFieldName] = &gql.Field{
+					Description: scoreFieldDescription,
+					Type:        gql.Float,
+				}
+			}
+
+			// add _distance field to types that may be searched by vector similarity
+			if collection.HasVectorField() {
+				fields[request.DistanceFieldName] = &gql.Field{
+					Description: distanceFieldDescription,
+					Type:        gql.Float,
+				}
+			}
+
+			// add _conflicts field to types that may hold concurrent values
+			if collection.HasMVRegisterField() {
+				fields[request.ConflictsFieldName] = &gql.Field{
+					Description: conflictsFieldDescription,
+					Type:        schemaTypes.JSONScalarType,
+				}
+			}
+
 			gqlType, ok := g.manager.schema.TypeMap()[collection.Name]
 			if !ok {
 				return nil, NewErrObjectNotFoundDuringThunk(collection.Name)
@@ -455,6 +514,11 @@ func (g *Generator) buildTypes(
 
 		g.manager.schema.TypeMap()[obj.Name()] = obj
 		g.typeDefs = append(g.typeDefs, obj)
+
+		if collection.HasVectorField() {
+			similarArg := g.genTypeSimilarArgInput(collection)
+			g.manager.schema.TypeMap()[similarArg.Name()] = similarArg
+		}
 	}
 
 	return objs, nil
@@ -463,6 +527,7 @@ func (g *Generator) buildTypes(
 func (g *Generator) genAggregateFields(ctx context.Context) error {
 	topLevelCountInputs := map[string]*gql.InputObject{}
 	topLevelNumericAggInputs := map[string]*gql.InputObject{}
+	topLevelComparableAggInputs := map[string]*gql.InputObject{}
 
 	for _, t := range g.typeDefs {
 		numArg := g.genNumericAggregateBaseArgInputs(t)
@@ -481,6 +546,22 @@ func (g *Generator) genAggregateFields(ctx context.Context) error {
 			}
 		}
 
+		comparableArg := g.genComparableAggregateBaseArgInputs(t)
+		topLevelComparableAggInputs[t.Name()] = comparableArg
+		// All base types need to be appended to the schema before calling genMinMaxFieldConfig
+		err = g.appendIfNotExists(comparableArg)
+		if err != nil {
+			return err
+		}
+
+		comparableInlineArrayInputs := g.genComparableInlineArraySelectorObject(t)
+		for _, obj := range comparableInlineArrayInputs {
+			err = g.appendIfNotExists(obj)
+			if err != nil {
+				return err
+			}
+		}
+
 		obj := g.genCountBaseArgInputs(t)
 		topLevelCountInputs[t.Name()] = obj
 		err = g.appendIfNotExists(obj)
@@ -515,6 +596,12 @@ func (g *Generator) genAggregateFields(ctx context.Context) error {
 			return err
 		}
 		t.AddFieldConfig(averageField.Name, &averageField)
+
+		minField := g.genMinMaxFieldConfig(t, request.MinFieldName, schemaTypes.MinFieldDescription)
+		t.AddFieldConfig(minField.Name, &minField)
+
+		maxField := g.genMinMaxFieldConfig(t, request.MaxFieldName, schemaTypes.MaxFieldDescription)
+		t.AddFieldConfig(maxField.Name, &maxField)
 	}
 
 	queryType := g.manager.schema.QueryType()
@@ -526,6 +613,10 @@ func (g *Generator) genAggregateFields(ctx context.Context) error {
 		queryType.AddFieldConfig(topLevelAgg.Name, topLevelAgg)
 	}
 
+	for _, topLevelAgg := range genTopLevelComparableAggregates(topLevelComparableAggInputs) {
+		queryType.AddFieldConfig(topLevelAgg.Name, topLevelAgg)
+	}
+
 	return nil
 }
 
@@ -567,6 +658,29 @@ func genTopLevelNumericAggregates(topLevelNumericAggInputs map[string]*gql.Input
 	return []*gql.Field{&topLevelSumField, &topLevelAverageField}
 }
 
+func genTopLevelComparableAggregates(topLevelComparableAggInputs map[string]*gql.InputObject) []*gql.Field {
+	topLevelMinField := gql.Field{
+		Name:        request.MinFieldName,
+		Description: schemaTypes.MinFieldDescription,
+		Type:        schemaTypes.JSONScalarType,
+		Args:        gql.FieldConfigArgument{},
+	}
+
+	topLevelMaxField := gql.Field{
+		Name:        request.MaxFieldName,
+		Description: schemaTypes.MaxFieldDescription,
+		Type:        schemaTypes.JSONScalarType,
+		Args:        gql.FieldConfigArgument{},
+	}
+
+	for name, inputObject := range topLevelComparableAggInputs {
+		topLevelMinField.Args[name] = schemaTypes.NewArgConfig(inputObject, inputObject.Description())
+		topLevelMaxField.Args[name] = schemaTypes.NewArgConfig(inputObject, inputObject.Description())
+	}
+
+	return []*gql.Field{&topLevelMinField, &topLevelMaxField}
+}
+
 func (g *Generator) genCountFieldConfig(obj *gql.Object) (gql.Field, error) {
 	childTypesByFieldName := map[string]gql.Type{}
 
@@ -684,6 +798,50 @@ func (g *Generator) genAverageFieldConfig(obj *gql.Object) (gql.Field, error) {
 	return field, nil
 }
 
+// genMinMaxFieldConfig generates the min or max aggregate field of the given name for the
+// given object.
+//
+// The values of the aggregate may be of any comparable type, and so are returned as JSON.
+func (g *Generator) genMinMaxFieldConfig(obj *gql.Object, name string, description string) gql.Field {
+	childTypesByFieldName := map[string]gql.Type{}
+
+	for _, field := range obj.Fields() {
+		// we can only compare list items
+		listType, isList := field.Type.(*gql.List)
+		if !isList {
+			continue
+		}
+
+		var inputObjectName string
+		if isComparableArray(listType) {
+			inputObjectName = genComparableInlineArraySelectorName(obj.Name(), field.Name)
+		} else {
+			inputObjectName = genComparableObjectSelectorName(field.Type.Name())
+		}
+
+		subComparableType, isSubTypeComparable := g.manager.schema.TypeMap()[inputObjectName]
+		// If the item is not in the type map, it must contain no comparable
+		//  fields (e.g. no Int/Strings)
+		if !isSubTypeComparable {
+			continue
+		}
+		childTypesByFieldName[field.Name] = subComparableType
+	}
+
+	field := gql.Field{
+		Name:        name,
+		Description: description,
+		Type:        schemaTypes.JSONScalarType,
+		Args:        gql.FieldConfigArgument{},
+	}
+
+	for name, inputObject := range childTypesByFieldName {
+		field.Args[name] = schemaTypes.NewArgConfig(inputObject, inputObject.Description())
+	}
+
+	return field
+}
+
 func (g *Generator) genNumericInlineArraySelectorObject(obj *gql.Object) []*gql.InputObject {
 	objects := []*gql.InputObject{}
 	for _, field := range obj.Fields() {
@@ -720,6 +878,50 @@ func (g *Generator) genNumericInlineArraySelectorObject(obj *gql.Object) []*gql.
 	return objects
 }
 
+func (g *Generator) genComparableInlineArraySelectorObject(obj *gql.Object) []*gql.InputObject {
+	objects := []*gql.InputObject{}
+	for _, field := range obj.Fields() {
+		// we can only act on list items
+		listType, isList := field.Type.(*gql.List)
+		if !isList {
+			continue
+		}
+
+		if isComparableArray(listType) {
+			// If it is an inline scalar array then we require an empty
+			//  object as an argument due to the lack of union input types
+			selectorObject := gql.NewInputObject(gql.InputObjectConfig{
+				Name: genComparableInlineArraySelectorName(obj.Name(), field.Name),
+				Fields: gql.InputObjectConfigFieldMap{
+					request.LimitClause: &gql.InputObjectFieldConfig{
+						Type:        gql.Int,
+						Description: schemaTypes.LimitArgDescription,
+					},
+					request.OffsetClause: &gql.InputObjectFieldConfig{
+						Type:        gql.Int,
+						Description: schemaTypes.OffsetArgDescription,
+					},
+					request.OrderClause: &gql.InputObjectFieldConfig{
+						Type:        g.manager.schema.TypeMap()["Ordering"],
+						Description: schemaTypes.OrderArgDescription,
+					},
+				},
+			})
+
+			objects = append(objects, selectorObject)
+		}
+	}
+	return objects
+}
+
+func genComparableObjectSelectorName(hostName string) string {
+	return fmt.Sprintf("%s__%s", hostName, "ComparableSelector")
+}
+
+func genComparableInlineArraySelectorName(hostName string, fieldName string) string {
+	return fmt.Sprintf("%s__%s__%s", hostName, fieldName, "ComparableSelector")
+}
+
 func genNumericObjectSelectorName(hostName string) string {
 	return fmt.Sprintf("%s__%s", hostName, "NumericSelector")
 }
@@ -729,9 +931,8 @@ func genNumericInlineArraySelectorName(hostName string, fieldName string) string
 }
 
 func (g *Generator) genCountBaseArgInputs(obj *gql.Object) *gql.InputObject {
-	countableObject := gql.NewInputObject(gql.InputObjectConfig{
-		Name: genObjectCountName(obj.Name()),
-		Fields: gql.InputObjectConfigFieldMap{
+	var fieldThunk gql.InputObjectConfigFieldMapThunk = func() (gql.InputObjectConfigFieldMap, error) {
+		fields := gql.InputObjectConfigFieldMap{
 			request.LimitClause: &gql.InputObjectFieldConfig{
 				Type:        gql.Int,
 				Description: schemaTypes.LimitArgDescription,
@@ -740,7 +941,21 @@ func (g *Generator) genCountBaseArgInputs(obj *gql.Object) *gql.InputObject {
 				Type:        gql.Int,
 				Description: schemaTypes.OffsetArgDescription,
 			},
-		},
+		}
+
+		if fieldsEnum, enumExists := g.manager.schema.TypeMap()[genTypeName(obj, "Fields")]; enumExists {
+			fields[request.DistinctClause] = &gql.InputObjectFieldConfig{
+				Type:        gql.NewList(gql.NewNonNull(fieldsEnum)),
+				Description: schemaTypes.CountDistinctArgDescription,
+			}
+		}
+
+		return fields, nil
+	}
+
+	countableObject := gql.NewInputObject(gql.InputObjectConfig{
+		Name:   genObjectCountName(obj.Name()),
+		Fields: fieldThunk,
 	})
 
 	return countableObject
@@ -750,25 +965,35 @@ func (g *Generator) genCountInlineArrayInputs(obj *gql.Object) []*gql.InputObjec
 	objects := []*gql.InputObject{}
 	for _, field := range obj.Fields() {
 		// we can only act on list items
-		_, isList := field.Type.(*gql.List)
+		list, isList := field.Type.(*gql.List)
 		if !isList {
 			continue
 		}
 
+		fields := gql.InputObjectConfigFieldMap{
+			request.LimitClause: &gql.InputObjectFieldConfig{
+				Type:        gql.Int,
+				Description: schemaTypes.LimitArgDescription,
+			},
+			request.OffsetClause: &gql.InputObjectFieldConfig{
+				Type:        gql.Int,
+				Description: schemaTypes.OffsetArgDescription,
+			},
+		}
+
+		if gql.IsLeafType(list.OfType) {
+			// Only the items of inline scalar arrays may be compared with one another
+			fields[request.DistinctClause] = &gql.InputObjectFieldConfig{
+				Type:        gql.Boolean,
+				Description: schemaTypes.CountDistinctItemsArgDescription,
+			}
+		}
+
 		// If it is an inline scalar array then we require an empty
 		//  object as an argument due to the lack of union input types
 		selectorObject := gql.NewInputObject(gql.InputObjectConfig{
-			Name: genNumericInlineArrayCountName(obj.Name(), field.Name),
-			Fields: gql.InputObjectConfigFieldMap{
-				request.LimitClause: &gql.InputObjectFieldConfig{
-					Type:        gql.Int,
-					Description: schemaTypes.LimitArgDescription,
-				},
-				request.OffsetClause: &gql.InputObjectFieldConfig{
-					Type:        gql.Int,
-					Description: schemaTypes.OffsetArgDescription,
-				},
-			},
+			Name:   genNumericInlineArrayCountName(obj.Name(), field.Name),
+			Fields: fields,
 		})
 
 		objects = append(objects, selectorObject)
@@ -776,6 +1001,16 @@ func (g *Generator) genCountInlineArrayInputs(obj *gql.Object) []*gql.InputObjec
 	return objects
 }
 
+// genListOperatorBlockName returns the name of the filter block for the given inline array.
+func genListOperatorBlockName(list *gql.List) string {
+	if notNull, isNotNull := list.OfType.(*gql.NonNull); isNotNull {
+		// GQL does not support '!' in type names, and so we have to manipulate the
+		// underlying name like this if it is a nullable type.
+		return fmt.Sprintf("NotNull%sListOperatorBlock", notNull.OfType.Name())
+	}
+	return genTypeName(list.OfType, "ListOperatorBlock")
+}
+
 func genNumericInlineArrayCountName(hostName string, fieldName string) string {
 	return fmt.Sprintf("%s__%s__%s", hostName, fieldName, "CountSelector")
 }
@@ -798,7 +1033,8 @@ func (g *Generator) genNumericAggregateBaseArgInputs(obj *gql.Object) *gql.Input
 			hasSumableFields := false
 			// generate basic filter operator blocks for all the sumable types
 			for _, field := range obj.Fields() {
-				if field.Type == gql.Float || field.Type == gql.Int {
+				if field.Type == gql.Float || field.Type == gql.Int ||
+					field.Type == schemaTypes.DecimalScalarType || field.Type == schemaTypes.BigIntScalarType {
 					hasSumableFields = true
 					fieldsEnumCfg.Values[field.Name] = &gql.EnumValueConfig{Value: field.Name}
 					continue
@@ -855,6 +1091,83 @@ func (g *Generator) genNumericAggregateBaseArgInputs(obj *gql.Object) *gql.Input
 	})
 }
 
+// Generates the base (comparable-only) aggregate input object-type for the give gql object,
+// declaring which fields are available for min and max aggregation.
+func (g *Generator) genComparableAggregateBaseArgInputs(obj *gql.Object) *gql.InputObject {
+	var fieldThunk gql.InputObjectConfigFieldMapThunk = func() (gql.InputObjectConfigFieldMap, error) {
+		fieldsEnum, enumExists := g.manager.schema.TypeMap()[genTypeName(obj, "ComparableFieldsArg")]
+		if !enumExists {
+			fieldsEnumCfg := gql.EnumConfig{
+				Name:   genTypeName(obj, "ComparableFieldsArg"),
+				Values: gql.EnumValueConfigMap{},
+			}
+
+			hasComparableFields := false
+			// generate basic filter operator blocks for all the comparable types
+			for _, field := range obj.Fields() {
+				if isComparableType(field.Type) {
+					hasComparableFields = true
+					fieldsEnumCfg.Values[field.Name] = &gql.EnumValueConfig{Value: field.Name}
+					continue
+				}
+
+				if list, isList := field.Type.(*gql.List); isList {
+					hasComparableFields = true
+					if isComparableArray(list) {
+						fieldsEnumCfg.Values[field.Name] = &gql.EnumValueConfig{Value: field.Name}
+					} else {
+						// If it is a related list, we need to add count in here so that we can compare it
+						fieldsEnumCfg.Values[request.CountFieldName] = &gql.EnumValueConfig{Value: request.CountFieldName}
+					}
+				}
+			}
+			// A child aggregate will always be aggregatable, as it can be present via an inner grouping
+			for _, aggregate := range []string{
+				request.SumFieldName,
+				request.AverageFieldName,
+				request.MinFieldName,
+				request.MaxFieldName,
+			} {
+				fieldsEnumCfg.Values[aggregate] = &gql.EnumValueConfig{Value: aggregate}
+			}
+
+			if !hasComparableFields {
+				return nil, nil
+			}
+
+			fieldsEnum = gql.NewEnum(fieldsEnumCfg)
+
+			err := g.manager.schema.AppendType(fieldsEnum)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		return gql.InputObjectConfigFieldMap{
+			"field": &gql.InputObjectFieldConfig{
+				Type: gql.NewNonNull(fieldsEnum),
+			},
+			request.LimitClause: &gql.InputObjectFieldConfig{
+				Type:        gql.Int,
+				Description: schemaTypes.LimitArgDescription,
+			},
+			request.OffsetClause: &gql.InputObjectFieldConfig{
+				Type:        gql.Int,
+				Description: schemaTypes.OffsetArgDescription,
+			},
+			request.OrderClause: &gql.InputObjectFieldConfig{
+				Type:        g.manager.schema.TypeMap()[genTypeName(obj, "OrderArg")],
+				Description: schemaTypes.OrderArgDescription,
+			},
+		}, nil
+	}
+
+	return gql.NewInputObject(gql.InputObjectConfig{
+		Name:   genComparableObjectSelectorName(obj.Name()),
+		Fields: fieldThunk,
+	})
+}
+
 func appendCommitChildGroupField() {
 	schemaTypes.CommitObject.Fields()[request.GroupFieldName] = &gql.FieldDefinition{
 		Name:        request.GroupFieldName,
@@ -876,6 +1189,9 @@ func (g *Generator) GenerateQueryInputForGQLType(
 	types := queryInputTypeConfig{}
 	types.filter = g.genTypeFilterArgInput(obj)
 
+	listFilter := g.genTypeListFilterArgInput(obj, types.filter)
+	g.manager.schema.TypeMap()[listFilter.Name()] = listFilter
+
 	// @todo: Don't add sub fields to filter/order for object list types
 	types.groupBy = g.genTypeFieldsEnum(obj)
 	types.order = g.genTypeOrderArgInput(obj)
@@ -918,7 +1234,16 @@ func (g *Generator) genTypeMutationFields(
 	if err != nil {
 		return nil, err
 	}
-	return []*gql.Field{create, update, delete}, nil
+	fields := []*gql.Field{create, update, delete}
+
+	if _, hasCounters := g.counterTypes[obj.Name()]; hasCounters {
+		increment, err := g.genTypeMutationIncrementField(obj, filterInput)
+		if err != nil {
+			return nil, err
+		}
+		fields = append(fields, increment)
+	}
+	return fields, nil
 }
 
 func (g *Generator) genTypeMutationCreateField(obj *gql.Object) (*gql.Field, error) {
@@ -927,7 +1252,8 @@ func (g *Generator) genTypeMutationCreateField(obj *gql.Object) (*gql.Field, err
 		Description: createDocumentDescription,
 		Type:        obj,
 		Args: gql.FieldConfigArgument{
-			"data": schemaTypes.NewArgConfig(gql.String, createDataArgDescription),
+			"data":    schemaTypes.NewArgConfig(gql.String, createDataArgDescription),
+			"message": schemaTypes.NewArgConfig(gql.String, mutationMessageArgDescription),
 		},
 	}
 	return field, nil
@@ -942,10 +1268,30 @@ func (g *Generator) genTypeMutationUpdateField(
 		Description: updateDocumentsDescription,
 		Type:        gql.NewList(obj),
 		Args: gql.FieldConfigArgument{
-			"id":     schemaTypes.NewArgConfig(gql.ID, updateIDArgDescription),
-			"ids":    schemaTypes.NewArgConfig(gql.NewList(gql.ID), updateIDsArgDescription),
-			"filter": schemaTypes.NewArgConfig(filter, updateFilterArgDescription),
-			"data":   schemaTypes.NewArgConfig(gql.String, updateDataArgDescription),
+			"id":      schemaTypes.NewArgConfig(gql.ID, updateIDArgDescription),
+			"ids":     schemaTypes.NewArgConfig(gql.NewList(gql.ID), updateIDsArgDescription),
+			"filter":  schemaTypes.NewArgConfig(filter, updateFilterArgDescription),
+			"data":    schemaTypes.NewArgConfig(gql.String, updateDataArgDescription),
+			"message": schemaTypes.NewArgConfig(gql.String, mutationMessageArgDescription),
+		},
+	}
+	return field, nil
+}
+
+func (g *Generator) genTypeMutationIncrementField(
+	obj *gql.Object,
+	filter *gql.InputObject,
+) (*gql.Field, error) {
+	field := &gql.Field{
+		Name:        "increment_" + obj.Name(),
+		Description: incrementDocumentsDescription,
+		Type:        gql.NewList(obj),
+		Args: gql.FieldConfigArgument{
+			"id":      schemaTypes.NewArgConfig(gql.ID, incrementIDArgDescription),
+			"ids":     schemaTypes.NewArgConfig(gql.NewList(gql.ID), incrementIDsArgDescription),
+			"filter":  schemaTypes.NewArgConfig(filter, incrementFilterArgDescription),
+			"data":    schemaTypes.NewArgConfig(gql.String, incrementDataArgDescription),
+			"message": schemaTypes.NewArgConfig(gql.String, mutationMessageArgDescription),
 		},
 	}
 	return field, nil
@@ -960,9 +1306,10 @@ func (g *Generator) genTypeMutationDeleteField(
 		Description: deleteDocumentsDescription,
 		Type:        gql.NewList(obj),
 		Args: gql.FieldConfigArgument{
-			"id":     schemaTypes.NewArgConfig(gql.ID, deleteIDArgDescription),
-			"ids":    schemaTypes.NewArgConfig(gql.NewList(gql.ID), deleteIDsArgDescription),
-			"filter": schemaTypes.NewArgConfig(filter, deleteFilterArgDescription),
+			"id":      schemaTypes.NewArgConfig(gql.ID, deleteIDArgDescription),
+			"ids":     schemaTypes.NewArgConfig(gql.NewList(gql.ID), deleteIDsArgDescription),
+			"filter":  schemaTypes.NewArgConfig(filter, deleteFilterArgDescription),
+			"message": schemaTypes.NewArgConfig(gql.String, mutationMessageArgDescription),
 		},
 	}
 	return field, nil
@@ -1013,11 +1360,11 @@ func (g *Generator) genTypeFilterArgInput(obj *gql.Object) *gql.InputObject {
 				}
 				// scalars (leafs)
 				if gql.IsLeafType(field.Type) {
-					if _, isList := field.Type.(*gql.List); isList {
-						// Filtering by inline array value is currently not supported
-						continue
+					operatorBlockName := field.Type.Name() + "OperatorBlock"
+					if list, isList := field.Type.(*gql.List); isList {
+						operatorBlockName = genListOperatorBlockName(list)
 					}
-					operatorType, isFilterable := g.manager.schema.TypeMap()[field.Type.Name()+"OperatorBlock"]
+					operatorType, isFilterable := g.manager.schema.TypeMap()[operatorBlockName]
 					if !isFilterable {
 						continue
 					}
@@ -1025,7 +1372,11 @@ func (g *Generator) genTypeFilterArgInput(obj *gql.Object) *gql.InputObject {
 						Type: operatorType,
 					}
 				} else { // objects (relations)
-					filterType, isFilterable := g.manager.schema.TypeMap()[genTypeName(field.Type, "FilterArg")]
+					filterTypeName := genTypeName(field.Type, "FilterArg")
+					if _, isList := field.Type.(*gql.List); isList {
+						filterTypeName = genTypeName(field.Type, "ListFilterArg")
+					}
+					filterType, isFilterable := g.manager.schema.TypeMap()[filterTypeName]
 					if !isFilterable {
 						filterType = &gql.InputObjectField{}
 					}
@@ -1045,6 +1396,42 @@ func (g *Generator) genTypeFilterArgInput(obj *gql.Object) *gql.InputObject {
 	return selfRefType
 }
 
+// genTypeListFilterArgInput generates the filter input for one-to-many relations to the given
+// object, which extends the filter of the object with operators matching against the
+// related objects as a whole.
+func (g *Generator) genTypeListFilterArgInput(obj *gql.Object, filter *gql.InputObject) *gql.InputObject {
+	inputCfg := gql.InputObjectConfig{
+		Name: genTypeName(obj, "ListFilterArg"),
+	}
+	var fieldThunk gql.InputObjectConfigFieldMapThunk = func() (gql.InputObjectConfigFieldMap, error) {
+		fields := gql.InputObjectConfigFieldMap{}
+		for name, field := range filter.Fields() {
+			fields[name] = &gql.InputObjectFieldConfig{
+				Description: field.Description(),
+				Type:        field.Type,
+			}
+		}
+
+		fields["_any"] = &gql.InputObjectFieldConfig{
+			Description: schemaTypes.AnyOperatorDescription,
+			Type:        filter,
+		}
+		fields["_all"] = &gql.InputObjectFieldConfig{
+			Description: schemaTypes.AllOperatorDescription,
+			Type:        filter,
+		}
+		fields["_none"] = &gql.InputObjectFieldConfig{
+			Description: schemaTypes.NoneOperatorDescription,
+			Type:        filter,
+		}
+
+		return fields, nil
+	}
+
+	inputCfg.Fields = fieldThunk
+	return gql.NewInputObject(inputCfg)
+}
+
 func (g *Generator) genLeafFilterArgInput(obj gql.Type) *gql.InputObject {
 	var selfRefType *gql.InputObject
 
@@ -1070,6 +1457,9 @@ func (g *Generator) genLeafFilterArgInput(obj gql.Type) *gql.InputObject {
 
 		fields["_and"] = compoundListType
 		fields["_or"] = compoundListType
+		fields["_not"] = &gql.InputObjectFieldConfig{
+			Type: selfRefType,
+		}
 
 		operatorBlockName := fmt.Sprintf("%s%s", filterTypeName, "OperatorBlock")
 		operatorType, hasOperatorType := g.manager.schema.TypeMap()[operatorBlockName]
@@ -1107,7 +1497,10 @@ func (g *Generator) genTypeOrderArgInput(obj *gql.Object) *gql.InputObject {
 			fields := gql.InputObjectConfigFieldMap{}
 
 			for f, field := range obj.Fields() {
-				if _, ok := request.ReservedFields[f]; ok && f != request.KeyFieldName {
+				if _, ok := request.ReservedFields[f]; ok &&
+					f != request.KeyFieldName &&
+					f != request.ScoreFieldName &&
+					f != request.DistanceFieldName {
 					continue
 				}
 				typeMap := g.manager.schema.TypeMap()
@@ -1137,6 +1530,196 @@ func (g *Generator) genTypeOrderArgInput(obj *gql.Object) *gql.InputObject {
 	return gql.NewInputObject(inputCfg)
 }
 
+// buildEnumTypes builds the enum types, and their filter operator blocks, of the enums of
+// the given collections.
+//
+// Enums of the same name held by different collections are built as a single type with
+// the values of them all, each collection only accepting its own values on write.
+func (g *Generator) buildEnumTypes(collections []client.CollectionDescription) error {
+	enumNames := []string{}
+	enumValues := map[string][]string{}
+	for _, collection := range collections {
+		for _, enum := range collection.Schema.Enums {
+			values, exists := enumValues[enum.Name]
+			if !exists {
+				enumNames = append(enumNames, enum.Name)
+			}
+			for _, value := range enum.Values {
+				if !containsString(values, value) {
+					values = append(values, value)
+				}
+			}
+			enumValues[enum.Name] = values
+		}
+	}
+
+	for _, name := range enumNames {
+		if _, ok := g.manager.schema.TypeMap()[name]; ok {
+			return NewErrSchemaTypeAlreadyExist(name)
+		}
+
+		enumCfg := gql.EnumConfig{
+			Name:   name,
+			Values: gql.EnumValueConfigMap{},
+		}
+		for _, value := range enumValues[name] {
+			enumCfg.Values[value] = &gql.EnumValueConfig{Value: value}
+		}
+
+		enum := gql.NewEnum(enumCfg)
+		g.manager.schema.TypeMap()[enum.Name()] = enum
+
+		operatorBlock := schemaTypes.NewEnumOperatorBlock(enum)
+		g.manager.schema.TypeMap()[operatorBlock.Name()] = operatorBlock
+	}
+
+	return nil
+}
+
+// fieldType returns the GQL type of the given field.
+func (g *Generator) fieldType(field client.FieldDescription) (gql.Type, error) {
+	switch field.Kind {
+	case client.FieldKind_FOREIGN_OBJECT, client.FieldKind_ENUM, client.FieldKind_EMBEDDED_OBJECT:
+		t, ok := g.manager.schema.TypeMap()[field.Schema]
+		if !ok {
+			return nil, NewErrTypeNotFound(field.Schema)
+		}
+		return t, nil
+
+	case client.FieldKind_FOREIGN_OBJECT_ARRAY:
+		t, ok := g.manager.schema.TypeMap()[field.Schema]
+		if !ok {
+			return nil, NewErrTypeNotFound(field.Schema)
+		}
+		return gql.NewList(t), nil
+
+	default:
+		t, ok := fieldKindToGQLType[field.Kind]
+		if !ok {
+			return nil, NewErrTypeNotFound(fmt.Sprint(field.Kind))
+		}
+		return t, nil
+	}
+}
+
+// buildEmbeddedTypes builds the embedded object types, and their filter and order
+// arguments, of the embedded object fields of the given collections.
+//
+// Embedded objects of the same type held by different fields are built as a single type
+// with the fields of them all.
+func (g *Generator) buildEmbeddedTypes(collections []client.CollectionDescription) error {
+	typeNames := []string{}
+	typeFields := map[string][]client.FieldDescription{}
+	for _, collection := range collections {
+		for _, field := range collection.Schema.Fields {
+			if !field.IsEmbedded() {
+				continue
+			}
+			fields, exists := typeFields[field.Schema]
+			if !exists {
+				typeNames = append(typeNames, field.Schema)
+			}
+			for _, embeddedField := range collection.Schema.GetEmbeddedFields(field.Name) {
+				// The fields of the type are named relative to the embedded object.
+				embeddedField.Name = embeddedField.Name[len(field.Name)+len(client.EmbeddedFieldSeparator):]
+				if !containsField(fields, embeddedField.Name) {
+					fields = append(fields, embeddedField)
+				}
+			}
+			typeFields[field.Schema] = fields
+		}
+	}
+
+	for _, n := range typeNames {
+		// Copy the loop variable before usage within the loop or it
+		// will be reassigned before the thunk is run
+		name := n
+		if _, ok := g.manager.schema.TypeMap()[name]; ok {
+			return NewErrSchemaTypeAlreadyExist(name)
+		}
+
+		obj := gql.NewObject(gql.ObjectConfig{
+			Name: name,
+			Fields: (gql.FieldsThunk)(func() (gql.Fields, error) {
+				fields := gql.Fields{}
+				for _, field := range typeFields[name] {
+					ttype, err := g.fieldType(field)
+					if err != nil {
+						return nil, err
+					}
+					fields[field.Name] = &gql.Field{
+						Name: field.Name,
+						Type: ttype,
+					}
+				}
+				return fields, nil
+			}),
+		})
+		g.manager.schema.TypeMap()[obj.Name()] = obj
+		g.embeddedTypes[obj.Name()] = struct{}{}
+
+		filterArg := g.genTypeFilterArgInput(obj)
+		g.manager.schema.TypeMap()[filterArg.Name()] = filterArg
+
+		orderArg := g.genTypeOrderArgInput(obj)
+		g.manager.schema.TypeMap()[orderArg.Name()] = orderArg
+	}
+
+	return nil
+}
+
+func containsField(fields []client.FieldDescription, name string) bool {
+	for _, field := range fields {
+		if field.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// genTypeSimilarArgInput generates the input of the nearest-neighbour search argument of
+// the given collection, which may only search by its vector fields.
+func (g *Generator) genTypeSimilarArgInput(collection client.CollectionDescription) *gql.InputObject {
+	fieldsEnumCfg := gql.EnumConfig{
+		Name:   collection.Name + "VectorFields",
+		Values: gql.EnumValueConfigMap{},
+	}
+	for _, field := range collection.Schema.Fields {
+		if field.Kind == client.FieldKind_FLOAT_VECTOR {
+			fieldsEnumCfg.Values[field.Name] = &gql.EnumValueConfig{Value: field.Name}
+		}
+	}
+	fieldsEnum := gql.NewEnum(fieldsEnumCfg)
+	g.manager.schema.TypeMap()[fieldsEnum.Name()] = fieldsEnum
+
+	return gql.NewInputObject(gql.InputObjectConfig{
+		Name: collection.Name + "SimilarArg",
+		Fields: gql.InputObjectConfigFieldMap{
+			request.FieldName: &gql.InputObjectFieldConfig{
+				Type: gql.NewNonNull(fieldsEnum),
+			},
+			"vector": &gql.InputObjectFieldConfig{
+				Type: gql.NewNonNull(gql.NewList(gql.NewNonNull(gql.Float))),
+			},
+			"k": &gql.InputObjectFieldConfig{
+				Type: gql.NewNonNull(gql.Int),
+			},
+			"metric": &gql.InputObjectFieldConfig{
+				Type: schemaTypes.SimilarityMetricEnum,
+			},
+		},
+	})
+}
+
 type queryInputTypeConfig struct {
 	filter  *gql.InputObject
 	groupBy *gql.Enum
@@ -1168,6 +1751,10 @@ func (g *Generator) genTypeQueryableFieldList(
 				gql.NewList(gql.NewNonNull(config.groupBy)),
 				schemaTypes.GroupByArgDescription,
 			),
+			request.DistinctClause: schemaTypes.NewArgConfig(
+				gql.NewList(gql.NewNonNull(config.groupBy)),
+				schemaTypes.DistinctArgDescription,
+			),
 			"order":              schemaTypes.NewArgConfig(config.order, schemaTypes.OrderArgDescription),
 			request.ShowDeleted:  schemaTypes.NewArgConfig(gql.Boolean, showDeletedArgDescription),
 			request.LimitClause:  schemaTypes.NewArgConfig(gql.Int, schemaTypes.LimitArgDescription),
@@ -1175,6 +1762,13 @@ func (g *Generator) genTypeQueryableFieldList(
 		},
 	}
 
+	if similarArg, isSearchable := g.manager.schema.TypeMap()[name+"SimilarArg"]; isSearchable {
+		field.Args[request.SimilarClause] = schemaTypes.NewArgConfig(
+			similarArg,
+			schemaTypes.SimilarArgDescription,
+		)
+	}
+
 	return field
 }
 
@@ -1193,12 +1787,31 @@ func (g *Generator) appendIfNotExists(obj gql.Type) error {
 func (g *Generator) Reset() {
 	g.typeDefs = make([]*gql.Object, 0)
 	g.expandedFields = make(map[string]bool)
+	g.embeddedTypes = make(map[string]struct{})
+	g.counterTypes = make(map[string]struct{})
 }
 
 func genTypeName(obj gql.Type, name string) string {
 	return fmt.Sprintf("%s%s", obj.Name(), name)
 }
 
+// isComparableType returns true if values of the given type may be compared by the
+// min and max aggregates.
+func isComparableType(t gql.Type) bool {
+	return t == gql.Float || t == gql.Int || t == gql.String || t == gql.DateTime ||
+		t == schemaTypes.DecimalScalarType || t == schemaTypes.BigIntScalarType
+}
+
+// isComparableArray returns true if the given list is a list of values that may be compared
+// by the min and max aggregates.
+func isComparableArray(list *gql.List) bool {
+	// We have to compare the names here, as the gql lib we use
+	// does not have an easier way to compare non-nullable types
+	return isNumericArray(list) ||
+		list.OfType.Name() == gql.NewNonNull(gql.String).Name() ||
+		list.OfType == gql.String
+}
+
 // isNumericArray returns true if the given list is a list of numerical values.
 func isNumericArray(list *gql.List) bool {
 	// We have to compare the names here, as the gql lib we use
@@ -111,6 +111,7 @@ func defaultMutationType() *gql.Object {
 // default directives type.
 func defaultDirectivesType() []*gql.Directive {
 	return []*gql.Directive{
+		schemaTypes.CRDTDirective,
 		schemaTypes.ExplainDirective,
 	}
 }
@@ -138,12 +139,18 @@ func defaultTypes() []gql.Type {
 		gql.ID,
 		gql.Int,
 		gql.String,
+		schemaTypes.JSONScalarType,
+		schemaTypes.DecimalScalarType,
+		schemaTypes.BigIntScalarType,
 
 		// Base Query types
 
 		// Sort/Order enum
 		schemaTypes.OrderingEnum,
 
+		// Vector similarity metric enum
+		schemaTypes.SimilarityMetricEnum,
+
 		// Filter scalar blocks
 		schemaTypes.BooleanOperatorBlock,
 		schemaTypes.NotNullBooleanOperatorBlock,
@@ -155,11 +162,25 @@ func defaultTypes() []gql.Type {
 		schemaTypes.NotNullIntOperatorBlock,
 		schemaTypes.StringOperatorBlock,
 		schemaTypes.NotNullstringOperatorBlock,
+		schemaTypes.JSONOperatorBlock,
+		schemaTypes.DecimalOperatorBlock,
+		schemaTypes.BigIntOperatorBlock,
+
+		// Filter inline array blocks
+		schemaTypes.BooleanListOperatorBlock,
+		schemaTypes.NotNullBooleanListOperatorBlock,
+		schemaTypes.FloatListOperatorBlock,
+		schemaTypes.NotNullFloatListOperatorBlock,
+		schemaTypes.IntListOperatorBlock,
+		schemaTypes.NotNullIntListOperatorBlock,
+		schemaTypes.StringListOperatorBlock,
+		schemaTypes.NotNullStringListOperatorBlock,
 
 		schemaTypes.CommitsOrderArg,
 		schemaTypes.CommitLinkObject,
 		schemaTypes.CommitObject,
 
 		schemaTypes.ExplainEnum,
+		schemaTypes.CRDTEnum,
 	}
 }
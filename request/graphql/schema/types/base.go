@@ -291,6 +291,22 @@ var StringOperatorBlock = gql.NewInputObject(gql.InputObjectConfig{
 			Description: nlikeStringOperatorDescription,
 			Type:        gql.String,
 		},
+		"_ilike": &gql.InputObjectFieldConfig{
+			Description: ilikeStringOperatorDescription,
+			Type:        gql.String,
+		},
+		"_nilike": &gql.InputObjectFieldConfig{
+			Description: nilikeStringOperatorDescription,
+			Type:        gql.String,
+		},
+		"_regex": &gql.InputObjectFieldConfig{
+			Description: regexStringOperatorDescription,
+			Type:        gql.String,
+		},
+		"_match": &gql.InputObjectFieldConfig{
+			Description: matchStringOperatorDescription,
+			Type:        gql.String,
+		},
 	},
 })
 
@@ -323,9 +339,49 @@ var NotNullstringOperatorBlock = gql.NewInputObject(gql.InputObjectConfig{
 			Description: nlikeStringOperatorDescription,
 			Type:        gql.String,
 		},
+		"_ilike": &gql.InputObjectFieldConfig{
+			Description: ilikeStringOperatorDescription,
+			Type:        gql.String,
+		},
+		"_nilike": &gql.InputObjectFieldConfig{
+			Description: nilikeStringOperatorDescription,
+			Type:        gql.String,
+		},
+		"_regex": &gql.InputObjectFieldConfig{
+			Description: regexStringOperatorDescription,
+			Type:        gql.String,
+		},
+		"_match": &gql.InputObjectFieldConfig{
+			Description: matchStringOperatorDescription,
+			Type:        gql.String,
+		},
 	},
 })
 
+// BooleanListOperatorBlock filter block for [Boolean] types.
+var BooleanListOperatorBlock = NewListOperatorBlock("Boolean", BooleanOperatorBlock)
+
+// NotNullBooleanListOperatorBlock filter block for [Boolean!] types.
+var NotNullBooleanListOperatorBlock = NewListOperatorBlock("NotNullBoolean", NotNullBooleanOperatorBlock)
+
+// FloatListOperatorBlock filter block for [Float] types.
+var FloatListOperatorBlock = NewListOperatorBlock("Float", FloatOperatorBlock)
+
+// NotNullFloatListOperatorBlock filter block for [Float!] types.
+var NotNullFloatListOperatorBlock = NewListOperatorBlock("NotNullFloat", NotNullFloatOperatorBlock)
+
+// IntListOperatorBlock filter block for [Int] types.
+var IntListOperatorBlock = NewListOperatorBlock("Int", IntOperatorBlock)
+
+// NotNullIntListOperatorBlock filter block for [Int!] types.
+var NotNullIntListOperatorBlock = NewListOperatorBlock("NotNullInt", NotNullIntOperatorBlock)
+
+// StringListOperatorBlock filter block for [String] types.
+var StringListOperatorBlock = NewListOperatorBlock("String", StringOperatorBlock)
+
+// NotNullStringListOperatorBlock filter block for [String!] types.
+var NotNullStringListOperatorBlock = NewListOperatorBlock("NotNullString", NotNullstringOperatorBlock)
+
 // IdOperatorBlock filter block for ID types.
 var IdOperatorBlock = gql.NewInputObject(gql.InputObjectConfig{
 	Name:        "IDOperatorBlock",
@@ -349,3 +405,184 @@ var IdOperatorBlock = gql.NewInputObject(gql.InputObjectConfig{
 		},
 	},
 })
+
+// JSONOperatorBlock filter block for JSON types.
+var JSONOperatorBlock = gql.NewInputObject(gql.InputObjectConfig{
+	Name:        "JSONOperatorBlock",
+	Description: jsonOperatorBlockDescription,
+	Fields: gql.InputObjectConfigFieldMap{
+		"_path": &gql.InputObjectFieldConfig{
+			Description: pathOperatorDescription,
+			Type:        gql.String,
+		},
+		"_eq": &gql.InputObjectFieldConfig{
+			Description: eqOperatorDescription,
+			Type:        JSONScalarType,
+		},
+		"_ne": &gql.InputObjectFieldConfig{
+			Description: neOperatorDescription,
+			Type:        JSONScalarType,
+		},
+		"_gt": &gql.InputObjectFieldConfig{
+			Description: gtOperatorDescription,
+			Type:        JSONScalarType,
+		},
+		"_ge": &gql.InputObjectFieldConfig{
+			Description: geOperatorDescription,
+			Type:        JSONScalarType,
+		},
+		"_lt": &gql.InputObjectFieldConfig{
+			Description: ltOperatorDescription,
+			Type:        JSONScalarType,
+		},
+		"_le": &gql.InputObjectFieldConfig{
+			Description: leOperatorDescription,
+			Type:        JSONScalarType,
+		},
+		"_in": &gql.InputObjectFieldConfig{
+			Description: inOperatorDescription,
+			Type:        gql.NewList(JSONScalarType),
+		},
+		"_nin": &gql.InputObjectFieldConfig{
+			Description: ninOperatorDescription,
+			Type:        gql.NewList(JSONScalarType),
+		},
+		"_like": &gql.InputObjectFieldConfig{
+			Description: likeStringOperatorDescription,
+			Type:        gql.String,
+		},
+		"_nlike": &gql.InputObjectFieldConfig{
+			Description: nlikeStringOperatorDescription,
+			Type:        gql.String,
+		},
+	},
+})
+
+// DecimalOperatorBlock filter block for Decimal types.
+var DecimalOperatorBlock = gql.NewInputObject(gql.InputObjectConfig{
+	Name:        "DecimalOperatorBlock",
+	Description: decimalOperatorBlockDescription,
+	Fields: gql.InputObjectConfigFieldMap{
+		"_eq": &gql.InputObjectFieldConfig{
+			Description: eqOperatorDescription,
+			Type:        DecimalScalarType,
+		},
+		"_ne": &gql.InputObjectFieldConfig{
+			Description: neOperatorDescription,
+			Type:        DecimalScalarType,
+		},
+		"_gt": &gql.InputObjectFieldConfig{
+			Description: gtOperatorDescription,
+			Type:        DecimalScalarType,
+		},
+		"_ge": &gql.InputObjectFieldConfig{
+			Description: geOperatorDescription,
+			Type:        DecimalScalarType,
+		},
+		"_lt": &gql.InputObjectFieldConfig{
+			Description: ltOperatorDescription,
+			Type:        DecimalScalarType,
+		},
+		"_le": &gql.InputObjectFieldConfig{
+			Description: leOperatorDescription,
+			Type:        DecimalScalarType,
+		},
+		"_in": &gql.InputObjectFieldConfig{
+			Description: inOperatorDescription,
+			Type:        gql.NewList(DecimalScalarType),
+		},
+		"_nin": &gql.InputObjectFieldConfig{
+			Description: ninOperatorDescription,
+			Type:        gql.NewList(DecimalScalarType),
+		},
+	},
+})
+
+// BigIntOperatorBlock filter block for BigInt types.
+var BigIntOperatorBlock = gql.NewInputObject(gql.InputObjectConfig{
+	Name:        "BigIntOperatorBlock",
+	Description: bigIntOperatorBlockDescription,
+	Fields: gql.InputObjectConfigFieldMap{
+		"_eq": &gql.InputObjectFieldConfig{
+			Description: eqOperatorDescription,
+			Type:        BigIntScalarType,
+		},
+		"_ne": &gql.InputObjectFieldConfig{
+			Description: neOperatorDescription,
+			Type:        BigIntScalarType,
+		},
+		"_gt": &gql.InputObjectFieldConfig{
+			Description: gtOperatorDescription,
+			Type:        BigIntScalarType,
+		},
+		"_ge": &gql.InputObjectFieldConfig{
+			Description: geOperatorDescription,
+			Type:        BigIntScalarType,
+		},
+		"_lt": &gql.InputObjectFieldConfig{
+			Description: ltOperatorDescription,
+			Type:        BigIntScalarType,
+		},
+		"_le": &gql.InputObjectFieldConfig{
+			Description: leOperatorDescription,
+			Type:        BigIntScalarType,
+		},
+		"_in": &gql.InputObjectFieldConfig{
+			Description: inOperatorDescription,
+			Type:        gql.NewList(BigIntScalarType),
+		},
+		"_nin": &gql.InputObjectFieldConfig{
+			Description: ninOperatorDescription,
+			Type:        gql.NewList(BigIntScalarType),
+		},
+	},
+})
+
+// NewEnumOperatorBlock returns the filter block for the given enum type.
+func NewEnumOperatorBlock(enum *gql.Enum) *gql.InputObject {
+	return gql.NewInputObject(gql.InputObjectConfig{
+		Name:        enum.Name() + "OperatorBlock",
+		Description: enumOperatorBlockDescription,
+		Fields: gql.InputObjectConfigFieldMap{
+			"_eq": &gql.InputObjectFieldConfig{
+				Description: eqOperatorDescription,
+				Type:        enum,
+			},
+			"_ne": &gql.InputObjectFieldConfig{
+				Description: neOperatorDescription,
+				Type:        enum,
+			},
+			"_in": &gql.InputObjectFieldConfig{
+				Description: inOperatorDescription,
+				Type:        gql.NewList(enum),
+			},
+			"_nin": &gql.InputObjectFieldConfig{
+				Description: ninOperatorDescription,
+				Type:        gql.NewList(enum),
+			},
+		},
+	})
+}
+
+// NewListOperatorBlock returns the filter block for inline arrays of the given item type name,
+// matching their items against the given item filter block.
+func NewListOperatorBlock(itemTypeName string, itemOperatorBlock *gql.InputObject) *gql.InputObject {
+	return gql.NewInputObject(gql.InputObjectConfig{
+		Name:        itemTypeName + "ListOperatorBlock",
+		Description: listOperatorBlockDescription,
+		Fields: gql.InputObjectConfigFieldMap{
+			"_any": &gql.InputObjectFieldConfig{
+				Description: AnyOperatorDescription,
+				Type:        itemOperatorBlock,
+			},
+			"_all": &gql.InputObjectFieldConfig{
+				Description: AllOperatorDescription,
+				Type:        itemOperatorBlock,
+			},
+			"_none": &gql.InputObjectFieldConfig{
+				Description: NoneOperatorDescription,
+				Type:        itemOperatorBlock,
+			},
+		},
+	})
+}
@@ -37,6 +37,10 @@ var (
 	// 	CollectionID: Int
 	// 	SchemaVersionID: String
 	// 	Delta: String
+	// 	Time: DateTime
+	// 	Author: String
+	// 	AuthorTime: DateTime
+	// 	Message: String
 	// 	Previous: [Commit]
 	//  Links: [Commit]
 	// }
@@ -71,6 +75,22 @@ var (
 				Description: commitDeltaFieldDescription,
 				Type:        gql.String,
 			},
+			"time": &gql.Field{
+				Description: commitTimeFieldDescription,
+				Type:        gql.DateTime,
+			},
+			"author": &gql.Field{
+				Description: commitAuthorFieldDescription,
+				Type:        gql.String,
+			},
+			"authorTime": &gql.Field{
+				Description: commitAuthorTimeFieldDescription,
+				Type:        gql.DateTime,
+			},
+			"message": &gql.Field{
+				Description: commitMessageFieldDescription,
+				Type:        gql.String,
+			},
 			"links": &gql.Field{
 				Description: commitLinksDescription,
 				Type:        gql.NewList(CommitLinkObject),
@@ -129,6 +149,9 @@ var (
 		},
 	)
 
+	// CommitsFilterArg is the filter of the commits queries, by the fields of their commits.
+	CommitsFilterArg = newCommitsFilterArg()
+
 	commitFields = gql.NewEnum(
 		gql.EnumConfig{
 			Name:        "commitFields",
@@ -161,6 +184,7 @@ var (
 		Args: gql.FieldConfigArgument{
 			"dockey": NewArgConfig(gql.ID, commitDockeyArgDescription),
 			"field":  NewArgConfig(gql.String, commitFieldArgDescription),
+			"filter": NewArgConfig(CommitsFilterArg, commitFilterArgDescription),
 			"order":  NewArgConfig(CommitsOrderArg, OrderArgDescription),
 			"cid":    NewArgConfig(gql.ID, commitCIDArgDescription),
 			"groupBy": NewArgConfig(
@@ -184,6 +208,70 @@ var (
 		Args: gql.FieldConfigArgument{
 			"dockey": NewArgConfig(gql.NewNonNull(gql.ID), commitDockeyArgDescription),
 			"field":  NewArgConfig(gql.String, commitFieldArgDescription),
+			"filter": NewArgConfig(CommitsFilterArg, commitFilterArgDescription),
 		},
 	}
 )
+
+// newCommitsFilterArg returns the filter of the commits queries, which may be nested within
+// itself through the compound operators.
+func newCommitsFilterArg() *gql.InputObject {
+	var selfRefType *gql.InputObject
+	selfRefType = gql.NewInputObject(gql.InputObjectConfig{
+		Name:        "commitsFilterArg",
+		Description: commitFilterArgDescription,
+		Fields: (gql.InputObjectConfigFieldMapThunk)(func() (gql.InputObjectConfigFieldMap, error) {
+			return gql.InputObjectConfigFieldMap{
+				"_and": &gql.InputObjectFieldConfig{
+					Description: AndOperatorDescription,
+					Type:        gql.NewList(selfRefType),
+				},
+				"_or": &gql.InputObjectFieldConfig{
+					Description: OrOperatorDescription,
+					Type:        gql.NewList(selfRefType),
+				},
+				"_not": &gql.InputObjectFieldConfig{
+					Description: NotOperatorDescription,
+					Type:        selfRefType,
+				},
+				"height": &gql.InputObjectFieldConfig{
+					Description: commitHeightFieldDescription,
+					Type:        IntOperatorBlock,
+				},
+				"cid": &gql.InputObjectFieldConfig{
+					Description: commitCIDFieldDescription,
+					Type:        StringOperatorBlock,
+				},
+				"dockey": &gql.InputObjectFieldConfig{
+					Description: commitDockeyFieldDescription,
+					Type:        StringOperatorBlock,
+				},
+				"collectionID": &gql.InputObjectFieldConfig{
+					Description: commitCollectionIDFieldDescription,
+					Type:        IntOperatorBlock,
+				},
+				"schemaVersionId": &gql.InputObjectFieldConfig{
+					Description: commitSchemaVersionIDFieldDescription,
+					Type:        StringOperatorBlock,
+				},
+				"time": &gql.InputObjectFieldConfig{
+					Description: commitTimeFieldDescription,
+					Type:        DateTimeOperatorBlock,
+				},
+				"author": &gql.InputObjectFieldConfig{
+					Description: commitAuthorFieldDescription,
+					Type:        StringOperatorBlock,
+				},
+				"authorTime": &gql.InputObjectFieldConfig{
+					Description: commitAuthorTimeFieldDescription,
+					Type:        DateTimeOperatorBlock,
+				},
+				"message": &gql.InputObjectFieldConfig{
+					Description: commitMessageFieldDescription,
+					Type:        StringOperatorBlock,
+				},
+			}, nil
+		}),
+	})
+	return selfRefType
+}
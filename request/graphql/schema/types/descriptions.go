@@ -22,6 +22,21 @@ An optional set of fields for which to group the contents of this field by.
  the '_group' selector within the immediate child selector. If an empty set
  is provided, the restrictions mentioned still apply, although all results
  will appear within the same group.
+`
+	DistinctArgDescription string = `
+An optional set of fields by which results are considered distinct.  If this
+ argument is provided, only the first result of each set of results sharing the
+ same values for these fields will be returned.  It is applied after any ordering,
+ and before any limit.
+`
+	CountDistinctArgDescription string = `
+An optional set of fields by which the related objects are considered distinct.
+ If this argument is provided, only one of each set of objects sharing the same
+ values for these fields will be counted.
+`
+	CountDistinctItemsArgDescription string = `
+An optional flag that, if true, will cause only the distinct items of the array
+ to be counted.
 `
 	LimitArgDescription string = `
 An optional value that caps the number of results to the number provided.
@@ -31,6 +46,18 @@ An optional value that caps the number of results to the number provided.
 An optional value that skips the given number of results that would have
  otherwise been returned.  Commonly used alongside the 'limit' argument,
  this argument will still work on its own.
+`
+	SimilarArgDescription string = `
+An optional nearest-neighbour search, only the k documents whose vector field
+ is the most similar to the given vector will be returned, ordered from the most
+ to the least similar.  The distance of each document from the given vector may
+ be selected using the '_distance' field.
+`
+	cosineMetricDescription string = `
+Cosine distance, one minus the cosine of the angle between the vectors.
+`
+	l2MetricDescription string = `
+Euclidean distance between the vectors.
 `
 	commitDescription string = `
 Commit represents an individual commit to a MerkleCRDT, every mutation to a
@@ -83,6 +110,24 @@ The ID of the schema version that this commit was committed against. This ID all
 `
 	commitDeltaFieldDescription string = `
 The CBOR encoded representation of the value that is saved as part of this commit.
+`
+	commitTimeFieldDescription string = `
+The hybrid logical clock time at which this commit was made. Only recorded by commits to
+ collections with fields of the lwwhlc CRDT type, and null otherwise.
+`
+	commitAuthorFieldDescription string = `
+The identity of the writer of this commit, such as the ID of the peer that made it. Null if
+ no author was recorded.
+`
+	commitAuthorTimeFieldDescription string = `
+The wall-clock time at which this commit was made by its author. Null if no author or message
+ was recorded.
+`
+	commitMessageFieldDescription string = `
+The message given to the mutation that made this commit, if any.
+`
+	commitFilterArgDescription string = `
+An optional filter for the commits, limiting the results to commits matching the given criteria.
 `
 	commitLinkNameFieldDescription string = `
 The Name of the field that this linked commit mutated.
@@ -116,6 +161,16 @@ Returns the total sum of the specified field values within the specified child s
 Returns the average of the specified field values within the specified child sets. If
  multiple fields/sets are specified, the combined average of all items within each set
  (true average, not an average of averages) will be returned as a single value.
+`
+	MinFieldDescription string = `
+Returns the least of the specified field values within the specified child sets. If
+ multiple fields/sets are specified, the least value of all of them will be returned.
+ Numeric, String and DateTime values may be compared, and null values are ignored.
+`
+	MaxFieldDescription string = `
+Returns the greatest of the specified field values within the specified child sets. If
+ multiple fields/sets are specified, the greatest value of all of them will be returned.
+ Numeric, String and DateTime values may be compared, and null values are ignored.
 `
 	booleanOperatorBlockDescription string = `
 These are the set of filter operators available for use when filtering on Boolean
@@ -152,6 +207,22 @@ These are the set of filter operators available for use when filtering on String
 	notNullStringOperatorBlockDescription string = `
 These are the set of filter operators available for use when filtering on String!
  values.
+`
+	jsonOperatorBlockDescription string = `
+These are the set of filter operators available for use when filtering on JSON
+ values.
+`
+	decimalOperatorBlockDescription string = `
+These are the set of filter operators available for use when filtering on Decimal
+ values.
+`
+	bigIntOperatorBlockDescription string = `
+These are the set of filter operators available for use when filtering on BigInt
+ values.
+`
+	enumOperatorBlockDescription string = `
+These are the set of filter operators available for use when filtering on the values of
+ this enum.
 `
 	idOperatorBlockDescription string = `
 These are the set of filter operators available for use when filtering on ID
@@ -194,6 +265,44 @@ The like operator - if the target value contains the given sub-string the check
 The not-like operator - if the target value does not contain the given sub-string the check will
  pass. '%' characters may be used as wildcards, for example '_nlike: "%Ritchie"' would match on
  the string 'Quentin Tarantino'.
+`
+	ilikeStringOperatorDescription string = `
+The case-insensitive like operator - behaves as the like operator, but ignores the case of both
+ the target value and the given sub-string, for example '_ilike: "%ritchie"' would match on
+ strings ending in 'Ritchie'.
+`
+	nilikeStringOperatorDescription string = `
+The case-insensitive not-like operator - behaves as the not-like operator, but ignores the case
+ of both the target value and the given sub-string, for example '_nilike: "%ritchie"' would not
+ match on strings ending in 'Ritchie'.
+`
+	regexStringOperatorDescription string = `
+The regex operator - if the target value matches the given regular expression the check will
+ pass.  Expressions use the RE2 syntax, for example '_regex: "^Dennis (M\\. )?Ritchie$"' would
+ match on the strings 'Dennis Ritchie' and 'Dennis M. Ritchie'.
+`
+	matchStringOperatorDescription string = `
+The match operator - if the target value contains all the terms of the given text the check will
+ pass.  Terms are matched regardless of case and of English word endings, for example
+ '_match: "searching dogs"' would match on the string 'The dog searched'.  Common words such as
+ 'the' are ignored.
+`
+	pathOperatorDescription string = `
+The path operator - the other checks within this clause will be made against the value at the
+ given dot separated path within the target, for example '{_path: "a.b", _eq: 3}' would match on
+ the value '{"a": {"b": 3}}'.  Path segments that are numbers index into arrays.
+`
+	jsonScalarDescription string = `
+The JSON scalar type represents an arbitrary JSON value: an object, an array, a string, a number,
+ a boolean or null.
+`
+	decimalScalarDescription string = `
+The Decimal scalar type represents an arbitrary precision decimal number.  Values are returned
+ as strings so that no precision is lost, and may be given as strings or numbers.
+`
+	bigIntScalarDescription string = `
+The BigInt scalar type represents an arbitrary precision integer.  Values are returned as
+ strings so that no precision is lost, and may be given as strings or integers.
 `
 	AndOperatorDescription string = `
 The and operator - all checks within this clause must pass in order for this check to pass.
@@ -203,12 +312,57 @@ The or operator - only one check within this clause must pass in order for this
 `
 	NotOperatorDescription string = `
 The negative operator - this check will only pass if all checks within it fail.
+`
+	AnyOperatorDescription string = `
+The any operator - this check will pass if at least one item of the array passes all checks
+ within it.
+`
+	AllOperatorDescription string = `
+The all operator - this check will pass if every item of the array passes all checks within it.
+ Empty arrays always pass.
+`
+	NoneOperatorDescription string = `
+The none operator - this check will pass if no item of the array passes all checks within it.
+ Empty arrays always pass.
+`
+	listOperatorBlockDescription string = `
+These are the set of filter operators available for use when filtering on the items of an
+ inline array.
 `
 	ascOrderDescription string = `
 Sort the results in ascending order, e.g. null,1,2,3,a,b,c.
 `
 	descOrderDescription string = `
 Sort the results in descending order, e.g. c,b,a,3,2,1,null.
+`
+	crdtDirectiveDescription string = `
+Select the CRDT type holding the values of the field, which must support the kind of the
+ field, instead of the default type of its kind.
+`
+	crdtDirectiveTypeArgDescription string = `
+The CRDT type holding the values of the field.
+`
+	crdtEnumDescription string = `
+One of the CRDT types that may hold the values of fields.
+`
+	lwwCRDTDescription string = `
+Last-writer-wins register, keeping the value of the latest write. The default of scalar fields.
+`
+	pnCounterCRDTDescription string = `
+Positive-negative counter, summing concurrent increments. Only for Int and Float fields.
+`
+	orSetCRDTDescription string = `
+Observed-remove set, keeping concurrently added elements. Only for array fields.
+`
+	textCRDTDescription string = `
+Replicated growable array, merging concurrent character edits. Only for String fields.
+`
+	mvRegisterCRDTDescription string = `
+Multi-value register, keeping concurrently written values until a later write replaces them.
+`
+	lwwHLCCRDTDescription string = `
+Last-writer-wins register ordering writes by their hybrid logical clock time, rather than by
+ the height of their commits, such that the latest write wins even if made while offline.
 `
 	primaryDirectiveDescription string = `
 Indicate the primary side of a one-to-one relationship.
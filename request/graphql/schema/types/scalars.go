@@ -0,0 +1,160 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package types
+
+import (
+	"math/big"
+	"strconv"
+
+	gql "github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/language/ast"
+
+	"github.com/sourcenetwork/defradb/client"
+)
+
+// JSONScalarType is the scalar type of JSON fields, whose values may be any JSON value.
+var JSONScalarType = gql.NewScalar(gql.ScalarConfig{
+	Name:        "JSON",
+	Description: jsonScalarDescription,
+	Serialize: func(value any) any {
+		return value
+	},
+	ParseValue: func(value any) any {
+		return value
+	},
+	ParseLiteral: parseJSONLiteral,
+})
+
+// parseJSONLiteral converts the given GraphQL literal into the equivalent JSON value.
+//
+// Integers are returned as int64 and other numbers as float64.
+func parseJSONLiteral(valueAST ast.Value) any {
+	switch value := valueAST.(type) {
+	case *ast.ObjectValue:
+		obj := make(map[string]any, len(value.Fields))
+		for _, field := range value.Fields {
+			obj[field.Name.Value] = parseJSONLiteral(field.Value)
+		}
+		return obj
+
+	case *ast.ListValue:
+		list := make([]any, len(value.Values))
+		for i, item := range value.Values {
+			list[i] = parseJSONLiteral(item)
+		}
+		return list
+
+	case *ast.IntValue:
+		if i, err := strconv.ParseInt(value.Value, 10, 64); err == nil {
+			return i
+		}
+		return nil
+
+	case *ast.FloatValue:
+		if f, err := strconv.ParseFloat(value.Value, 64); err == nil {
+			return f
+		}
+		return nil
+
+	case *ast.StringValue:
+		return value.Value
+
+	case *ast.EnumValue:
+		return value.Value
+
+	case *ast.BooleanValue:
+		return value.Value
+
+	default:
+		return nil
+	}
+}
+
+// DecimalScalarType is the scalar type of Decimal fields, whose values are arbitrary
+// precision decimals.
+//
+// Values are serialized as decimal strings, and may be given as strings or numbers.
+var DecimalScalarType = gql.NewScalar(gql.ScalarConfig{
+	Name:        "Decimal",
+	Description: decimalScalarDescription,
+	Serialize: func(value any) any {
+		if r, ok := value.(*big.Rat); ok {
+			return client.FormatDecimal(r)
+		}
+		return value
+	},
+	ParseValue: func(value any) any {
+		r, err := client.ParseDecimal(value)
+		if err != nil {
+			return nil
+		}
+		return r
+	},
+	ParseLiteral: func(valueAST ast.Value) any {
+		literal, ok := numericLiteral(valueAST)
+		if !ok {
+			return nil
+		}
+		r, err := client.ParseDecimal(literal)
+		if err != nil {
+			return nil
+		}
+		return r
+	},
+})
+
+// BigIntScalarType is the scalar type of BigInt fields, whose values are arbitrary
+// precision integers.
+//
+// Values are serialized as integer strings, and may be given as strings or integers.
+var BigIntScalarType = gql.NewScalar(gql.ScalarConfig{
+	Name:        "BigInt",
+	Description: bigIntScalarDescription,
+	Serialize: func(value any) any {
+		if i, ok := value.(*big.Int); ok {
+			return i.String()
+		}
+		return value
+	},
+	ParseValue: func(value any) any {
+		i, err := client.ParseBigInt(value)
+		if err != nil {
+			return nil
+		}
+		return i
+	},
+	ParseLiteral: func(valueAST ast.Value) any {
+		literal, ok := numericLiteral(valueAST)
+		if !ok {
+			return nil
+		}
+		i, err := client.ParseBigInt(literal)
+		if err != nil {
+			return nil
+		}
+		return i
+	},
+})
+
+// numericLiteral returns the text of the given string or number literal, so that it may
+// be parsed without losing precision.
+func numericLiteral(valueAST ast.Value) (string, bool) {
+	switch value := valueAST.(type) {
+	case *ast.StringValue:
+		return value.Value, true
+	case *ast.IntValue:
+		return value.Value, true
+	case *ast.FloatValue:
+		return value.Value, true
+	default:
+		return "", false
+	}
+}
@@ -12,13 +12,18 @@ package types
 
 import (
 	gql "github.com/graphql-go/graphql"
+
+	"github.com/sourcenetwork/defradb/client"
 )
 
 const (
+	CRDTLabel     string = "crdt"
 	ExplainLabel  string = "explain"
 	PrimaryLabel  string = "primary"
 	RelationLabel string = "relation"
 
+	CRDTArgNameType string = "type"
+
 	ExplainArgNameType string = "type"
 	ExplainArgSimple   string = "simple"
 	ExplainArgExecute  string = "execute"
@@ -40,6 +45,54 @@ var (
 		},
 	})
 
+	// SimilarityMetricEnum is an enum for the metric by which the similarity of vectors is measured.
+	SimilarityMetricEnum = gql.NewEnum(gql.EnumConfig{
+		Name: "SimilarityMetric",
+		Values: gql.EnumValueConfigMap{
+			"COSINE": &gql.EnumValueConfig{
+				Description: cosineMetricDescription,
+				Value:       "COSINE",
+			},
+			"L2": &gql.EnumValueConfig{
+				Description: l2MetricDescription,
+				Value:       "L2",
+			},
+		},
+	})
+
+	// CRDTEnum is an enum of the CRDT types that may hold the values of fields, as given
+	// to the @crdt directive.
+	CRDTEnum = gql.NewEnum(gql.EnumConfig{
+		Name:        "CRDTType",
+		Description: crdtEnumDescription,
+		Values: gql.EnumValueConfigMap{
+			"lww": &gql.EnumValueConfig{
+				Value:       client.LWW_REGISTER,
+				Description: lwwCRDTDescription,
+			},
+			"pncounter": &gql.EnumValueConfig{
+				Value:       client.PN_COUNTER,
+				Description: pnCounterCRDTDescription,
+			},
+			"orset": &gql.EnumValueConfig{
+				Value:       client.OR_SET,
+				Description: orSetCRDTDescription,
+			},
+			"text": &gql.EnumValueConfig{
+				Value:       client.RGA_TEXT,
+				Description: textCRDTDescription,
+			},
+			"mvregister": &gql.EnumValueConfig{
+				Value:       client.MV_REGISTER,
+				Description: mvRegisterCRDTDescription,
+			},
+			"lwwhlc": &gql.EnumValueConfig{
+				Value:       client.LWW_HLC_REGISTER,
+				Description: lwwHLCCRDTDescription,
+			},
+		},
+	})
+
 	ExplainEnum = gql.NewEnum(gql.EnumConfig{
 		Name:        "ExplainType",
 		Description: "ExplainType is an enum selecting the type of explanation done by the @explain directive.",
@@ -73,6 +126,22 @@ var (
 		},
 	})
 
+	// CRDTDirective @crdt is used to select the CRDT type holding the values
+	// of a field, instead of the default type of its kind.
+	CRDTDirective = gql.NewDirective(gql.DirectiveConfig{
+		Name:        CRDTLabel,
+		Description: crdtDirectiveDescription,
+		Args: gql.FieldConfigArgument{
+			CRDTArgNameType: &gql.ArgumentConfig{
+				Description: crdtDirectiveTypeArgDescription,
+				Type:        gql.NewNonNull(CRDTEnum),
+			},
+		},
+		Locations: []string{
+			gql.DirectiveLocationFieldDefinition,
+		},
+	})
+
 	// PrimaryDirective @primary is used to indicate the primary
 	// side of a one-to-one relationship.
 	PrimaryDirective = gql.NewDirective(gql.DirectiveConfig{
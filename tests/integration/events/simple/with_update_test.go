@@ -11,7 +11,6 @@
 package simple
 
 import (
-	"context"
 	"testing"
 
 	"github.com/sourcenetwork/immutable"
@@ -42,21 +41,23 @@ func TestEventsSimpleWithUpdate(t *testing.T) {
 	assert.Nil(t, err)
 	docKey2 := doc2.Key().String()
 
+	ctx := testUtils.NewCommitContext()
+
 	test := testUtils.TestCase{
 		CollectionCalls: map[string][]func(client.Collection){
 			"users": []func(c client.Collection){
 				func(c client.Collection) {
-					err = c.Save(context.Background(), doc1)
+					err = c.Save(ctx, doc1)
 					assert.Nil(t, err)
 				},
 				func(c client.Collection) {
-					err = c.Save(context.Background(), doc2)
+					err = c.Save(ctx, doc2)
 					assert.Nil(t, err)
 				},
 				func(c client.Collection) {
 					// Update John
 					doc1.Set("Name", "Johnnnnn")
-					err = c.Save(context.Background(), doc1)
+					err = c.Save(ctx, doc1)
 					assert.Nil(t, err)
 				},
 			},
@@ -64,14 +65,14 @@ func TestEventsSimpleWithUpdate(t *testing.T) {
 		ExpectedUpdates: []testUtils.ExpectedUpdate{
 			{
 				DocKey: immutable.Some(docKey1),
-				Cid:    immutable.Some("bafybeiduhusqoizl5lmbszxkk42nqw4uxyvwxul45n2sszr6srwpdpmrdi"),
+				Cid:    immutable.Some("bafybeia2tqtshwqoaywgheioynzqfwcqfea7kpqlmlly4d2uc7kx35dy7q"),
 			},
 			{
 				DocKey: immutable.Some(docKey2),
 			},
 			{
 				DocKey: immutable.Some(docKey1),
-				Cid:    immutable.Some("bafybeife6ovwym6sr5aoz5w2eih6uhjva5ac7gsehkqx4z3bzlzuvp7cqy"),
+				Cid:    immutable.Some("bafybeicrqdnb3m7kv5tlrvya7o5ohrtoyhii43lc3hvvmpomx3ytwh3nu4"),
 			},
 		},
 	}
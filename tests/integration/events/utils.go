@@ -63,12 +63,18 @@ type ExpectedUpdate struct {
 
 const eventTimeout = 100 * time.Millisecond
 
+// NewCommitContext returns a context carrying the author and time of the commits of the test cases,
+// so that the CIDs of the commits are the same on every run.
+func NewCommitContext() context.Context {
+	return testUtils.NewCommitContext(context.Background())
+}
+
 func ExecuteRequestTestCase(
 	t *testing.T,
 	schema string,
 	testCase TestCase,
 ) {
-	ctx := context.Background()
+	ctx := NewCommitContext()
 
 	db, err := testUtils.NewBadgerMemoryDB(ctx, db.WithUpdateEvents())
 	require.NoError(t, err)
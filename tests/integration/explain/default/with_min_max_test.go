@@ -0,0 +1,133 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package test_explain_default
+
+import (
+	"testing"
+
+	explainUtils "github.com/sourcenetwork/defradb/tests/integration/explain"
+)
+
+var minMaxPattern = dataMap{
+	"explain": dataMap{
+		"selectTopNode": dataMap{
+			"minNode": dataMap{
+				"maxNode": dataMap{
+					"selectNode": dataMap{
+						"parallelNode": []dataMap{
+							{
+								"typeIndexJoin": dataMap{
+									"root": dataMap{
+										"scanNode": dataMap{},
+									},
+									"subType": dataMap{
+										"selectTopNode": dataMap{
+											"selectNode": dataMap{
+												"scanNode": dataMap{},
+											},
+										},
+									},
+								},
+							},
+							{
+								"typeIndexJoin": dataMap{
+									"root": dataMap{
+										"scanNode": dataMap{},
+									},
+									"subType": dataMap{
+										"selectTopNode": dataMap{
+											"selectNode": dataMap{
+												"scanNode": dataMap{},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	},
+}
+
+func TestDefaultExplainRequestWithMinMaxOnOneToManyJoinedField(t *testing.T) {
+	test := explainUtils.ExplainRequestTestCase{
+
+		Description: "Explain (default) request with min and max on a one-to-many joined field.",
+
+		Request: `query @explain {
+			author {
+				name
+				_min(books: {field: pages})
+				_max(books: {field: pages, filter: {pages: {_gt: 100}}})
+			}
+		}`,
+
+		Docs: map[int][]string{
+			// books
+			1: {
+				`{
+					"name": "Painted House",
+					"author_id": "bae-25fafcc7-f251-58c1-9495-ead73e676fb8",
+					"pages": 22
+				}`,
+			},
+			// authors
+			2: {
+				// _key: "bae-25fafcc7-f251-58c1-9495-ead73e676fb8"
+				`{
+					"name": "John Grisham",
+					"age": 65,
+					"verified": true,
+					"contact_id": "bae-1fe427b8-ab8d-56c3-9df2-826a6ce86fed"
+				}`,
+			},
+		},
+
+		ExpectedPatterns: []dataMap{minMaxPattern},
+
+		ExpectedTargets: []explainUtils.PlanNodeTargetCase{
+			{
+				TargetNodeName:    "minNode",
+				IncludeChildNodes: false,
+				ExpectedAttributes: dataMap{
+					"sources": []dataMap{
+						{
+							"fieldName":      "books",
+							"childFieldName": "pages",
+							"filter":         nil,
+						},
+					},
+				},
+			},
+			{
+				TargetNodeName:    "maxNode",
+				IncludeChildNodes: false,
+				ExpectedAttributes: dataMap{
+					"sources": []dataMap{
+						{
+							"fieldName":      "books",
+							"childFieldName": "pages",
+							"filter": dataMap{
+								"pages": dataMap{
+									"_gt": int(100),
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	runExplainTest(t, test)
+}
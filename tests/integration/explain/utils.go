@@ -44,8 +44,11 @@ var (
 		"createNode":    {},
 		"dagScanNode":   {},
 		"deleteNode":    {},
+		"distinctNode":  {},
 		"groupNode":     {},
 		"limitNode":     {},
+		"maxNode":       {},
+		"minNode":       {},
 		"multiScanNode": {},
 		"orderNode":     {},
 		"parallelNode":  {},
@@ -0,0 +1,613 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package index
+
+import (
+	"testing"
+
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+func TestQueryWithCompositeIndexWithEqualFilterOnAllFields(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Query with equal filters on all the fields of a composite index.",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users @index(fields: ["Verified", "Age"]) {
+						Name: String
+						Age: Int
+						Points: Float
+						Verified: Boolean
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "John",
+					"Age": 21,
+					"Points": 10.5,
+					"Verified": true
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "Bob",
+					"Age": 32,
+					"Points": -3.5,
+					"Verified": false
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "Alice",
+					"Age": 19,
+					"Points": 0,
+					"Verified": true
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "Fred",
+					"Age": 21,
+					"Verified": false
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Users(filter: {Verified: {_eq: false}, Age: {_eq: 21}}) {
+						Name
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"Name": "Fred",
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, []string{"Users"}, test)
+}
+
+func TestQueryWithCompositeIndexWithEqualFilterOnPrefixAndRangeOnSuffix(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Query with an equal filter on the first field of a composite index, and a range on the second.",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users @index(fields: ["Verified", "Age"]) {
+						Name: String
+						Age: Int
+						Points: Float
+						Verified: Boolean
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "John",
+					"Age": 21,
+					"Points": 10.5,
+					"Verified": true
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "Bob",
+					"Age": 32,
+					"Points": -3.5,
+					"Verified": false
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "Alice",
+					"Age": 19,
+					"Points": 0,
+					"Verified": true
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "Fred",
+					"Age": 21,
+					"Verified": false
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Users(filter: {Verified: {_eq: true}, Age: {_gt: 19}}) {
+						Name
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"Name": "John",
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, []string{"Users"}, test)
+}
+
+func TestQueryWithCompositeIndexWithFilterOnSuffixOnly(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Query with a filter on only the second field of a composite index.",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users @index(fields: ["Verified", "Age"]) {
+						Name: String
+						Age: Int
+						Points: Float
+						Verified: Boolean
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "John",
+					"Age": 21,
+					"Points": 10.5,
+					"Verified": true
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "Bob",
+					"Age": 32,
+					"Points": -3.5,
+					"Verified": false
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "Alice",
+					"Age": 19,
+					"Points": 0,
+					"Verified": true
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "Fred",
+					"Age": 21,
+					"Verified": false
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Users(filter: {Age: {_eq: 21}}, order: {Name: ASC}) {
+						Name
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"Name": "Fred",
+					},
+					{
+						"Name": "John",
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, []string{"Users"}, test)
+}
+
+func TestQueryWithCompositeIndexWithEqualFilterOnPrefixAndOrderOnSuffix(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Query with an equal filter on the first field of a composite index, ordered by the second.",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users @index(fields: ["Verified", "Age"]) {
+						Name: String
+						Age: Int
+						Points: Float
+						Verified: Boolean
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "John",
+					"Age": 21,
+					"Points": 10.5,
+					"Verified": true
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "Bob",
+					"Age": 32,
+					"Points": -3.5,
+					"Verified": false
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "Alice",
+					"Age": 19,
+					"Points": 0,
+					"Verified": true
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "Fred",
+					"Age": 21,
+					"Verified": false
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Users(filter: {Verified: {_eq: true}}, order: {Age: ASC}) {
+						Name
+						Age
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"Name": "Alice",
+						"Age":  uint64(19),
+					},
+					{
+						"Name": "John",
+						"Age":  uint64(21),
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, []string{"Users"}, test)
+}
+
+func TestQueryWithCompositeIndexWithEqualFilterOnPrefixAndDescendingOrderOnSuffix(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Query with an equal filter on the first field of a composite index, " +
+			"ordered descending by the second.",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users @index(fields: ["Verified", "Age"]) {
+						Name: String
+						Age: Int
+						Points: Float
+						Verified: Boolean
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "John",
+					"Age": 21,
+					"Points": 10.5,
+					"Verified": true
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "Bob",
+					"Age": 32,
+					"Points": -3.5,
+					"Verified": false
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "Alice",
+					"Age": 19,
+					"Points": 0,
+					"Verified": true
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "Fred",
+					"Age": 21,
+					"Verified": false
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Users(filter: {Verified: {_eq: false}}, order: {Age: DESC}) {
+						Name
+						Age
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"Name": "Bob",
+						"Age":  uint64(32),
+					},
+					{
+						"Name": "Fred",
+						"Age":  uint64(21),
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, []string{"Users"}, test)
+}
+
+func TestQueryWithCompositeIndexWithInFilterOnPrefixAndOrderOnAllFields(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Query with an in filter on the first field of a composite index, ordered by both fields.",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users @index(fields: ["Verified", "Age"]) {
+						Name: String
+						Age: Int
+						Points: Float
+						Verified: Boolean
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "John",
+					"Age": 21,
+					"Points": 10.5,
+					"Verified": true
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "Bob",
+					"Age": 32,
+					"Points": -3.5,
+					"Verified": false
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "Alice",
+					"Age": 19,
+					"Points": 0,
+					"Verified": true
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "Fred",
+					"Age": 21,
+					"Verified": false
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Users(filter: {Verified: {_in: [true, false]}}, order: {Verified: DESC, Age: DESC}) {
+						Name
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"Name": "John",
+					},
+					{
+						"Name": "Alice",
+					},
+					{
+						"Name": "Bob",
+					},
+					{
+						"Name": "Fred",
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, []string{"Users"}, test)
+}
+
+func TestQueryWithIndexWithOrderAndNoFilter(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Query ordered by an indexed field, without a filter.",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						Name: String @index
+						Age: Int @index
+						Points: Float @index
+						Verified: Boolean
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "John",
+					"Age": 21,
+					"Points": 10.5,
+					"Verified": true
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "Bob",
+					"Age": 32,
+					"Points": -3.5,
+					"Verified": false
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "Alice",
+					"Age": 19,
+					"Points": 0,
+					"Verified": true
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "Fred",
+					"Age": 21,
+					"Verified": false
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Users(order: {Points: ASC}) {
+						Name
+						Points
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"Name":   "Fred",
+						"Points": nil,
+					},
+					{
+						"Name":   "Bob",
+						"Points": float64(-3.5),
+					},
+					{
+						"Name":   "Alice",
+						"Points": float64(0),
+					},
+					{
+						"Name":   "John",
+						"Points": float64(10.5),
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, []string{"Users"}, test)
+}
+
+func TestQueryWithIndexWithDescendingOrderAndRangeFilter(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Query with a range filter on an indexed field, ordered descending by the same field.",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						Name: String @index
+						Age: Int @index
+						Points: Float @index
+						Verified: Boolean
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "John",
+					"Age": 21,
+					"Points": 10.5,
+					"Verified": true
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "Bob",
+					"Age": 32,
+					"Points": -3.5,
+					"Verified": false
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "Alice",
+					"Age": 19,
+					"Points": 0,
+					"Verified": true
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "Fred",
+					"Age": 21,
+					"Verified": false
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Users(filter: {Points: {_ge: 0}}, order: {Points: DESC}) {
+						Name
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"Name": "John",
+					},
+					{
+						"Name": "Alice",
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, []string{"Users"}, test)
+}
+
+func TestQueryWithCompositeIndexWithUnknownField(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Composite index on a field that does not exist.",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users @index(fields: ["Name", "Email"]) {
+						Name: String
+					}
+				`,
+				ExpectedError: "indexed field does not exist",
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, []string{"Users"}, test)
+}
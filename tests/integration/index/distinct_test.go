@@ -0,0 +1,229 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package index
+
+import (
+	"testing"
+
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+func TestQueryWithIndexWithDistinct(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple query with distinct on indexed field",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						Name: String @index
+						Age: Int @index
+						Points: Float @index
+						Verified: Boolean
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "John",
+					"Age": 21,
+					"Points": 10.5,
+					"Verified": true
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "Bob",
+					"Age": 32,
+					"Points": -3.5,
+					"Verified": false
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "Alice",
+					"Age": 19,
+					"Points": 0,
+					"Verified": true
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "Fred",
+					"Age": 21,
+					"Verified": false
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Users(distinct: [Age]) {
+						Age
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"Age": uint64(19),
+					},
+					{
+						"Age": uint64(21),
+					},
+					{
+						"Age": uint64(32),
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, []string{"Users"}, test)
+}
+
+func TestExplainQueryWithIndexWithDistinctShowsGroupedIndex(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Explain (simple) query with distinct on indexed field.",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						Name: String @index
+						Age: Int @index
+						Points: Float @index
+						Verified: Boolean
+					}
+				`,
+			},
+			testUtils.Request{
+				Request: `query @explain {
+					Users(distinct: [Age]) {
+						Name
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"explain": map[string]any{
+							"selectTopNode": map[string]any{
+								"distinctNode": map[string]any{
+									"fields": []string{"Age"},
+									"selectNode": map[string]any{
+										"filter": nil,
+										"scanNode": map[string]any{
+											"filter":         nil,
+											"collectionID":   "1",
+											"collectionName": "Users",
+											"spans":          []map[string]any{},
+											"index": map[string]any{
+												"name":      "users_Age",
+												"fields":    []string{"Age"},
+												"isOrdered": false,
+												"isGrouped": true,
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, []string{"Users"}, test)
+}
+
+func TestExecuteExplainQueryWithIndexWithDistinctSkipsDuplicates(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Explain (execute) query with distinct on indexed field.",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						Name: String @index
+						Age: Int @index
+						Points: Float @index
+						Verified: Boolean
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "John",
+					"Age": 21,
+					"Points": 10.5,
+					"Verified": true
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "Bob",
+					"Age": 32,
+					"Points": -3.5,
+					"Verified": false
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "Alice",
+					"Age": 19,
+					"Points": 0,
+					"Verified": true
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "Fred",
+					"Age": 21,
+					"Verified": false
+				}`,
+			},
+			testUtils.Request{
+				Request: `query @explain(type: execute) {
+					Users(distinct: [Age]) {
+						Name
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"explain": map[string]any{
+							"executionSuccess": true,
+							"sizeOfResult":     3,
+							"planExecutions":   uint64(4),
+							"selectTopNode": map[string]any{
+								"distinctNode": map[string]any{
+									"iterations": uint64(4),
+									"duplicates": uint64(1),
+									"selectNode": map[string]any{
+										"iterations":    uint64(5),
+										"filterMatches": uint64(4),
+										"scanNode": map[string]any{
+											"iterations":    uint64(5),
+											"docFetches":    uint64(5),
+											"filterMatches": uint64(4),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, []string{"Users"}, test)
+}
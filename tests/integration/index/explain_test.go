@@ -0,0 +1,386 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package index
+
+import (
+	"testing"
+
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+func TestExecuteExplainQueryWithIndexOnlyFetchesIndexedDocs(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Explain (execute) query with equal filter on indexed field.",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						Name: String @index
+						Age: Int @index
+						Points: Float @index
+						Verified: Boolean
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "John",
+					"Age": 21,
+					"Points": 10.5,
+					"Verified": true
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "Bob",
+					"Age": 32,
+					"Points": -3.5,
+					"Verified": false
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "Alice",
+					"Age": 19,
+					"Points": 0,
+					"Verified": true
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "Fred",
+					"Age": 21,
+					"Verified": false
+				}`,
+			},
+			testUtils.Request{
+				Request: `query @explain(type: execute) {
+					Users(filter: {Age: {_eq: 21}}) {
+						Name
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"explain": map[string]any{
+							"executionSuccess": true,
+							"sizeOfResult":     2,
+							"planExecutions":   uint64(3),
+							"selectTopNode": map[string]any{
+								"selectNode": map[string]any{
+									"iterations":    uint64(3),
+									"filterMatches": uint64(2),
+									"scanNode": map[string]any{
+										"iterations":    uint64(3),
+										"docFetches":    uint64(3),
+										"filterMatches": uint64(2),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, []string{"Users"}, test)
+}
+
+func TestExecuteExplainQueryWithIndexWithRangeFilterOnlyFetchesDocsInRange(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Explain (execute) query with range filter on indexed field.",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						Name: String @index
+						Age: Int @index
+						Points: Float @index
+						Verified: Boolean
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "John",
+					"Age": 21,
+					"Points": 10.5,
+					"Verified": true
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "Bob",
+					"Age": 32,
+					"Points": -3.5,
+					"Verified": false
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "Alice",
+					"Age": 19,
+					"Points": 0,
+					"Verified": true
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "Fred",
+					"Age": 21,
+					"Verified": false
+				}`,
+			},
+			testUtils.Request{
+				Request: `query @explain(type: execute) {
+					Users(filter: {Age: {_gt: 21}}) {
+						Name
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"explain": map[string]any{
+							"executionSuccess": true,
+							"sizeOfResult":     1,
+							"planExecutions":   uint64(2),
+							"selectTopNode": map[string]any{
+								"selectNode": map[string]any{
+									"iterations":    uint64(2),
+									"filterMatches": uint64(1),
+									"scanNode": map[string]any{
+										// The bounds of the index span are inclusive, so the documents
+										// with a value of 21 are fetched, but fail the filter.
+										"iterations":    uint64(2),
+										"docFetches":    uint64(4),
+										"filterMatches": uint64(1),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, []string{"Users"}, test)
+}
+
+func TestExplainQueryWithIndexShowsChosenIndex(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Explain (simple) query with equal filter on indexed field.",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						Name: String @index
+						Age: Int @index
+						Points: Float @index
+						Verified: Boolean
+					}
+				`,
+			},
+			testUtils.Request{
+				Request: `query @explain {
+					Users(filter: {Age: {_eq: 21}}) {
+						Name
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"explain": map[string]any{
+							"selectTopNode": map[string]any{
+								"selectNode": map[string]any{
+									"filter": nil,
+									"scanNode": map[string]any{
+										"filter": map[string]any{
+											"Age": map[string]any{
+												"_eq": 21,
+											},
+										},
+										"collectionID":   "1",
+										"collectionName": "Users",
+										"spans":          []map[string]any{},
+										"index": map[string]any{
+											"name":      "users_Age",
+											"fields":    []string{"Age"},
+											"isOrdered": false,
+											"isGrouped": false,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, []string{"Users"}, test)
+}
+
+func TestExplainQueryWithCompositeIndexAndOrderShowsOrderedIndex(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Explain (simple) query with filter on prefix of composite index and order on suffix.",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users @index(fields: ["Verified", "Age"]) {
+						Name: String
+						Age: Int
+						Points: Float
+						Verified: Boolean
+					}
+				`,
+			},
+			testUtils.Request{
+				Request: `query @explain {
+					Users(filter: {Verified: {_eq: true}}, order: {Age: DESC}) {
+						Name
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"explain": map[string]any{
+							"selectTopNode": map[string]any{
+								"orderNode": map[string]any{
+									"orderings": []map[string]any{
+										{
+											"direction": "DESC",
+											"fields":    []string{"Age"},
+										},
+									},
+									"selectNode": map[string]any{
+										"filter": nil,
+										"scanNode": map[string]any{
+											"filter": map[string]any{
+												"Verified": map[string]any{
+													"_eq": true,
+												},
+											},
+											"collectionID":   "1",
+											"collectionName": "Users",
+											"spans":          []map[string]any{},
+											"index": map[string]any{
+												"name":      "users_Verified_Age",
+												"fields":    []string{"Verified", "Age"},
+												"isOrdered": true,
+												"isGrouped": false,
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, []string{"Users"}, test)
+}
+
+func TestExecuteExplainQueryWithIndexOrderedByIndexDoesNotSort(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Explain (execute) query ordered by an indexed field.",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						Name: String @index
+						Age: Int @index
+						Points: Float @index
+						Verified: Boolean
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "John",
+					"Age": 21,
+					"Points": 10.5,
+					"Verified": true
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "Bob",
+					"Age": 32,
+					"Points": -3.5,
+					"Verified": false
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "Alice",
+					"Age": 19,
+					"Points": 0,
+					"Verified": true
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "Fred",
+					"Age": 21,
+					"Verified": false
+				}`,
+			},
+			testUtils.Request{
+				Request: `query @explain(type: execute) {
+					Users(filter: {Age: {_ge: 21}}, order: {Age: ASC}) {
+						Name
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"explain": map[string]any{
+							"executionSuccess": true,
+							"sizeOfResult":     3,
+							"planExecutions":   uint64(4),
+							"selectTopNode": map[string]any{
+								"orderNode": map[string]any{
+									// The orderNode iterates directly through the ordered
+									// source, once per result plus the final iteration.
+									"iterations": uint64(4),
+									"selectNode": map[string]any{
+										"iterations":    uint64(4),
+										"filterMatches": uint64(3),
+										"scanNode": map[string]any{
+											"iterations":    uint64(4),
+											"docFetches":    uint64(4),
+											"filterMatches": uint64(3),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, []string{"Users"}, test)
+}
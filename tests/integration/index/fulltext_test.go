@@ -0,0 +1,433 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package index
+
+import (
+	"testing"
+
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+func TestFullTextIndexWithMatchFilter(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Query with a full-text match filter, matching stemmed and lowercased terms.",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						Name: String
+						Notes: String @fulltext
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "John",
+					"Notes": "Dogs"
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "Bob",
+					"Notes": "Walking the dog, and feeding the cats"
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "Alice",
+					"Notes": "Searching for a lost cat"
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "Fred"
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Users(filter: {Notes: {_match: "DOG"}}) {
+						Name
+					}
+				}`,
+				Results: []map[string]any{
+					{"Name": "John"},
+					{"Name": "Bob"},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, []string{"Users"}, test)
+}
+
+func TestFullTextIndexWithMatchFilterOnMultipleTerms(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Query with a full-text match filter, requiring all the terms to match.",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						Name: String
+						Notes: String @fulltext
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "John",
+					"Notes": "Dogs"
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "Bob",
+					"Notes": "Walking the dog, and feeding the cats"
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "Alice",
+					"Notes": "Searching for a lost cat"
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "Fred"
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Users(filter: {Notes: {_match: "cat fed"}}) {
+						Name
+					}
+				}`,
+				Results: []map[string]any{},
+			},
+			testUtils.Request{
+				Request: `query {
+					Users(filter: {Notes: {_match: "searched cats"}}) {
+						Name
+					}
+				}`,
+				Results: []map[string]any{
+					{"Name": "Alice"},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, []string{"Users"}, test)
+}
+
+func TestFullTextIndexWithMatchFilterOfStopWordsOnly(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Query with a full-text match filter without any terms matches nothing.",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						Name: String
+						Notes: String @fulltext
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "John",
+					"Notes": "Dogs"
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "Bob",
+					"Notes": "Walking the dog, and feeding the cats"
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "Alice",
+					"Notes": "Searching for a lost cat"
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "Fred"
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Users(filter: {Notes: {_match: "the and for"}}) {
+						Name
+					}
+				}`,
+				Results: []map[string]any{},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, []string{"Users"}, test)
+}
+
+func TestFullTextIndexWithMatchFilterAfterUpdate(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Query with a full-text match filter after the indexed text is updated.",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						Name: String
+						Notes: String @fulltext
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "John",
+					"Notes": "Dogs"
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "Bob",
+					"Notes": "Walking the dog, and feeding the cats"
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "Alice",
+					"Notes": "Searching for a lost cat"
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "Fred"
+				}`,
+			},
+			testUtils.UpdateDoc{
+				DocID: 0,
+				Doc: `{
+					"Notes": "Horses"
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Users(filter: {Notes: {_match: "dog"}}) {
+						Name
+					}
+				}`,
+				Results: []map[string]any{
+					{"Name": "Bob"},
+				},
+			},
+			testUtils.Request{
+				Request: `query {
+					Users(filter: {Notes: {_match: "horse"}}) {
+						Name
+					}
+				}`,
+				Results: []map[string]any{
+					{"Name": "John"},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, []string{"Users"}, test)
+}
+
+func TestFullTextIndexWithScore(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Query with a full-text match filter, selecting and ordering by the relevance score.",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						Name: String
+						Notes: String @fulltext
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "John",
+					"Notes": "Dogs"
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "Bob",
+					"Notes": "Walking the dog, and feeding the cats"
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "Alice",
+					"Notes": "Searching for a lost cat"
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "Fred"
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Users(filter: {Notes: {_match: "cat"}}, order: {_score: DESC}) {
+						Name
+						_score
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						// "search", "lost" and "cat"
+						"Name":   "Alice",
+						"_score": 0.5773502691896258,
+					},
+					{
+						// "walk", "dog", "feed" and "cat"
+						"Name":   "Bob",
+						"_score": float64(0.5),
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, []string{"Users"}, test)
+}
+
+func TestFullTextIndexWithScoreWithoutMatchFilter(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Query selecting the relevance score without a full-text match filter.",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						Name: String
+						Notes: String @fulltext
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"Name": "John",
+					"Notes": "Dogs"
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Users {
+						Name
+						_score
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"Name":   "John",
+						"_score": nil,
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, []string{"Users"}, test)
+}
+
+func TestFullTextIndexOnNonStringField(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Full-text index on a field that is not a string.",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						Age: Int @fulltext
+					}
+				`,
+				ExpectedError: "full-text indexes must be built from a single string field",
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, []string{"Users"}, test)
+}
+
+func TestExplainQueryWithFullTextIndexShowsChosenIndex(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Explain (simple) query with a full-text match filter.",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						Name: String
+						Notes: String @fulltext
+					}
+				`,
+			},
+			testUtils.Request{
+				Request: `query @explain {
+					Users(filter: {Notes: {_match: "dog"}}) {
+						Name
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"explain": map[string]any{
+							"selectTopNode": map[string]any{
+								"selectNode": map[string]any{
+									"filter": nil,
+									"scanNode": map[string]any{
+										"filter": map[string]any{
+											"Notes": map[string]any{
+												"_match": "dog",
+											},
+										},
+										"collectionID":   "1",
+										"collectionName": "Users",
+										"spans":          []map[string]any{},
+										"index": map[string]any{
+											"name":      "users_Notes",
+											"fields":    []string{"Notes"},
+											"isOrdered": false,
+											"isGrouped": false,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, []string{"Users"}, test)
+}
@@ -0,0 +1,250 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package index
+
+import (
+	"testing"
+
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+func TestQueryWithIndexAfterUpdateOfIndexedField(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Query with filter on indexed field, after the field has been updated",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						Name: String @index
+						Age: Int @index
+						Points: Float @index
+						Verified: Boolean
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "John",
+					"Age": 21,
+					"Points": 10.5,
+					"Verified": true
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "Bob",
+					"Age": 32,
+					"Points": -3.5,
+					"Verified": false
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "Alice",
+					"Age": 19,
+					"Points": 0,
+					"Verified": true
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "Fred",
+					"Age": 21,
+					"Verified": false
+				}`,
+			},
+			testUtils.UpdateDoc{
+				CollectionID: 0,
+				DocID:        0,
+				Doc: `{
+					"Age": 33
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Users(filter: {Age: {_eq: 21}}) {
+						Name
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"Name": "Fred",
+					},
+				},
+			},
+			testUtils.Request{
+				Request: `query {
+					Users(filter: {Age: {_eq: 33}}) {
+						Name
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"Name": "John",
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, []string{"Users"}, test)
+}
+
+func TestQueryWithIndexAfterUpdateWithFilterOfIndexedField(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Query with filter on indexed field, after the field has been updated by an update mutation",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						Name: String @index
+						Age: Int @index
+						Points: Float @index
+						Verified: Boolean
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "John",
+					"Age": 21,
+					"Points": 10.5,
+					"Verified": true
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "Bob",
+					"Age": 32,
+					"Points": -3.5,
+					"Verified": false
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "Alice",
+					"Age": 19,
+					"Points": 0,
+					"Verified": true
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "Fred",
+					"Age": 21,
+					"Verified": false
+				}`,
+			},
+			testUtils.Request{
+				Request: `mutation {
+					update_Users(filter: {Name: {_eq: "Alice"}}, data: "{\"Name\": \"Alicia\"}") {
+						Name
+					}
+				}`,
+				// As the record no longer matches the filter it is not returned
+				Results: []map[string]any{},
+			},
+			testUtils.Request{
+				Request: `query {
+					Users(filter: {Name: {_in: ["Alice", "Alicia"]}}) {
+						Name
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"Name": "Alicia",
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, []string{"Users"}, test)
+}
+
+func TestQueryWithIndexAfterDelete(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Query with filter on indexed field, after a matching document has been deleted",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						Name: String @index
+						Age: Int @index
+						Points: Float @index
+						Verified: Boolean
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "John",
+					"Age": 21,
+					"Points": 10.5,
+					"Verified": true
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "Bob",
+					"Age": 32,
+					"Points": -3.5,
+					"Verified": false
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "Alice",
+					"Age": 19,
+					"Points": 0,
+					"Verified": true
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "Fred",
+					"Age": 21,
+					"Verified": false
+				}`,
+			},
+			testUtils.DeleteDoc{
+				CollectionID: 0,
+				DocID:        0,
+			},
+			testUtils.Request{
+				Request: `query {
+					Users(filter: {Age: {_eq: 21}}) {
+						Name
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"Name": "Fred",
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, []string{"Users"}, test)
+}
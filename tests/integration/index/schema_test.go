@@ -0,0 +1,83 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package index
+
+import (
+	"testing"
+
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+func TestSchemaWithIndexOnArrayFieldErrors(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Schema with an index on an array field",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						Name: String
+						Scores: [Int!] @index
+					}
+				`,
+				ExpectedError: "indexes are not supported for fields of the given kind",
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, []string{"Users"}, test)
+}
+
+func TestSchemaWithDuplicateIndexNamesErrors(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Schema with two indexes of the same name",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						Name: String @index(name: "users_index")
+						Age: Int @index(name: "users_index")
+					}
+				`,
+				ExpectedError: "duplicate index name",
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, []string{"Users"}, test)
+}
+
+func TestSchemaUpdateModifyingIndexesErrors(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Schema update removing an index",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						Name: String @index
+						Age: Int @index
+						Points: Float @index
+						Verified: Boolean
+					}
+				`,
+			},
+			testUtils.SchemaPatch{
+				Patch: `
+					[
+						{ "op": "remove", "path": "/Users/Indexes/0" }
+					]
+				`,
+				ExpectedError: "modifying the indexes of an existing collection is not supported",
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, []string{"Users"}, test)
+}
@@ -0,0 +1,399 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package index
+
+import (
+	"testing"
+
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+const uniqueConstraintViolatedError = "a document with the same values for the unique fields already exists"
+
+func TestUniqueIndexWithCreateOfDuplicateValue(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Create of a document with the same value for a unique field as another document.",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users @unique(fields: ["Name", "Age"]) {
+						Name: String
+						Age: Int
+						Email: String @unique
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"Name": "John",
+					"Age": 21,
+					"Email": "john@example.com"
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"Name": "Johnny",
+					"Age": 21,
+					"Email": "john@example.com"
+				}`,
+				ExpectedError: uniqueConstraintViolatedError,
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, []string{"Users"}, test)
+}
+
+func TestUniqueIndexWithCreateOfDuplicateCompositeValues(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Create of a document with the same values for composite unique fields as another document.",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users @unique(fields: ["Name", "Age"]) {
+						Name: String
+						Age: Int
+						Email: String @unique
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"Name": "John",
+					"Age": 21
+				}`,
+			},
+			testUtils.CreateDoc{
+				// The values of a composite unique index are only required to be unique together.
+				Doc: `{
+					"Name": "John",
+					"Age": 22
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"Name": "John",
+					"Age": 21,
+					"Email": "other@example.com"
+				}`,
+				ExpectedError: uniqueConstraintViolatedError,
+			},
+			testUtils.Request{
+				Request: `query {
+					Users(order: {Age: ASC}) {
+						Age
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"Age": uint64(21),
+					},
+					{
+						"Age": uint64(22),
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, []string{"Users"}, test)
+}
+
+func TestUniqueIndexWithCreateOfDuplicateNilValues(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Create of documents without a value for a unique field.",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users @unique(fields: ["Name", "Age"]) {
+						Name: String
+						Age: Int
+						Email: String @unique
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"Name": "John"
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"Name": "Fred"
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Users(filter: {Email: {_eq: null}}, order: {Name: ASC}) {
+						Name
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"Name": "Fred",
+					},
+					{
+						"Name": "John",
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, []string{"Users"}, test)
+}
+
+func TestUniqueIndexWithUpdateToDuplicateValue(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Update of a document to the same value for a unique field as another document.",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users @unique(fields: ["Name", "Age"]) {
+						Name: String
+						Age: Int
+						Email: String @unique
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"Name": "John",
+					"Email": "john@example.com"
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"Name": "Fred",
+					"Email": "fred@example.com"
+				}`,
+			},
+			testUtils.UpdateDoc{
+				DocID: 1,
+				Doc: `{
+					"Email": "john@example.com"
+				}`,
+				ExpectedError: uniqueConstraintViolatedError,
+			},
+			testUtils.Request{
+				Request: `query {
+					Users(filter: {Email: {_eq: "fred@example.com"}}) {
+						Name
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"Name": "Fred",
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, []string{"Users"}, test)
+}
+
+func TestUniqueIndexWithUpdateOfOtherField(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Update of a document with a unique field to a new value for another field.",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users @unique(fields: ["Name", "Age"]) {
+						Name: String
+						Age: Int
+						Email: String @unique
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"Name": "John",
+					"Email": "john@example.com"
+				}`,
+			},
+			testUtils.UpdateDoc{
+				Doc: `{
+					"Name": "Johnny"
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Users(filter: {Email: {_eq: "john@example.com"}}) {
+						Name
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"Name": "Johnny",
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, []string{"Users"}, test)
+}
+
+func TestUniqueIndexWithValueReleasedByUpdate(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Create of a document with a unique value previously held by an updated document.",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users @unique(fields: ["Name", "Age"]) {
+						Name: String
+						Age: Int
+						Email: String @unique
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"Name": "John",
+					"Email": "john@example.com"
+				}`,
+			},
+			testUtils.UpdateDoc{
+				Doc: `{
+					"Email": "johnny@example.com"
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"Name": "Fred",
+					"Email": "john@example.com"
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Users(filter: {Email: {_in: ["john@example.com", "johnny@example.com"]}}) {
+						Name
+						Email
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"Name":  "Fred",
+						"Email": "john@example.com",
+					},
+					{
+						"Name":  "John",
+						"Email": "johnny@example.com",
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, []string{"Users"}, test)
+}
+
+func TestUniqueIndexWithValueReleasedByDelete(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Create of a document with a unique value previously held by a deleted document.",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users @unique(fields: ["Name", "Age"]) {
+						Name: String
+						Age: Int
+						Email: String @unique
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"Name": "John",
+					"Email": "john@example.com"
+				}`,
+			},
+			testUtils.DeleteDoc{
+				DocID: 0,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"Name": "Fred",
+					"Email": "john@example.com"
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Users(filter: {Email: {_eq: "john@example.com"}}) {
+						Name
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"Name": "Fred",
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, []string{"Users"}, test)
+}
+
+func TestUniqueIndexWithOrder(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Query ordered by a unique field.",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users @unique(fields: ["Name", "Age"]) {
+						Name: String
+						Age: Int
+						Email: String @unique
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"Name": "John",
+					"Email": "john@example.com"
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"Name": "Alice",
+					"Email": "alice@example.com"
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"Name": "Fred",
+					"Email": "fred@example.com"
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Users(order: {Email: DESC}) {
+						Name
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"Name": "John",
+					},
+					{
+						"Name": "Fred",
+					},
+					{
+						"Name": "Alice",
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, []string{"Users"}, test)
+}
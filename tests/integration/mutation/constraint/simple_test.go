@@ -0,0 +1,309 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package constraint
+
+import (
+	"testing"
+
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+func TestMutationConstraintWithCreate(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Create of a document satisfying the constraints of its fields.",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						Name: String @constraint(minLength: 2, maxLength: 10, pattern: "^[A-Z]")
+						Age: Int @constraint(min: 0, max: 150)
+						Balance: Decimal @constraint(min: 0.5)
+						Tags: [String!] @constraint(maxLength: 2)
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"Name": "John",
+					"Age": 150,
+					"Balance": "0.5",
+					"Tags": ["a", "b"]
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Users {
+						Name
+						Age
+						Tags
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"Name": "John",
+						"Age":  uint64(150),
+						"Tags": []string{"a", "b"},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, []string{"Users"}, test)
+}
+
+func TestMutationConstraintWithCreateOfViolatingValuesErrors(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Create of a document violating the constraints of its fields lists every violation.",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						Name: String @constraint(minLength: 2, maxLength: 10, pattern: "^[A-Z]")
+						Age: Int @constraint(min: 0, max: 150)
+						Balance: Decimal @constraint(min: 0.5)
+						Tags: [String!] @constraint(maxLength: 2)
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"Name": "j",
+					"Age": -1,
+					"Balance": "0.25",
+					"Tags": ["a", "b", "c"]
+				}`,
+				ExpectedError: "the given values violate the constraints of their fields. Violations: [" +
+					"Name must have a length of at least 2 " +
+					"Name must match the pattern ^[A-Z] " +
+					"Age must be at least 0 " +
+					"Balance must be at least 0.5 " +
+					"Tags must have a length of at most 2]",
+			},
+			testUtils.Request{
+				// Ensure that no documents have been written.
+				Request: `query {
+					Users {
+						Name
+					}
+				}`,
+				Results: []map[string]any{},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, []string{"Users"}, test)
+}
+
+func TestMutationConstraintWithCreateMutationOfViolatingValueErrors(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Create mutation of a document violating the constraint of a field.",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						Name: String @constraint(minLength: 2, maxLength: 10, pattern: "^[A-Z]")
+						Age: Int @constraint(min: 0, max: 150)
+						Balance: Decimal @constraint(min: 0.5)
+						Tags: [String!] @constraint(maxLength: 2)
+					}
+				`,
+			},
+			testUtils.Request{
+				Request: `mutation {
+					create_Users(data: "{\"Name\": \"Johnathan Smith\"}") {
+						Name
+					}
+				}`,
+				ExpectedError: "Violations: [Name must have a length of at most 10]",
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, []string{"Users"}, test)
+}
+
+func TestMutationConstraintWithUpdateOfViolatingValueErrors(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Update of a document to a value violating the constraint of its field.",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						Name: String @constraint(minLength: 2, maxLength: 10, pattern: "^[A-Z]")
+						Age: Int @constraint(min: 0, max: 150)
+						Balance: Decimal @constraint(min: 0.5)
+						Tags: [String!] @constraint(maxLength: 2)
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"Name": "John",
+					"Age": 21
+				}`,
+			},
+			testUtils.UpdateDoc{
+				DocID: 0,
+				Doc: `{
+					"Age": 151
+				}`,
+				ExpectedError: "Violations: [Age must be at most 150]",
+			},
+			testUtils.Request{
+				Request: `query {
+					Users {
+						Age
+					}
+				}`,
+				Results: []map[string]any{
+					{"Age": uint64(21)},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, []string{"Users"}, test)
+}
+
+func TestMutationConstraintWithUpdateMutationOfViolatingValuesErrors(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Update mutation of a document to values violating the constraints of their fields.",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						Name: String @constraint(minLength: 2, maxLength: 10, pattern: "^[A-Z]")
+						Age: Int @constraint(min: 0, max: 150)
+						Balance: Decimal @constraint(min: 0.5)
+						Tags: [String!] @constraint(maxLength: 2)
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"Name": "John",
+					"Age": 21
+				}`,
+			},
+			testUtils.Request{
+				Request: `mutation {
+					update_Users(data: "{\"Name\": \"john\", \"Age\": 200}") {
+						Name
+					}
+				}`,
+				ExpectedError: "Violations: [Name must match the pattern ^[A-Z] Age must be at most 150]",
+			},
+			testUtils.Request{
+				Request: `mutation {
+					update_Users(data: "{\"Age\": 22}") {
+						Name
+						Age
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"Name": "John",
+						"Age":  uint64(22),
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, []string{"Users"}, test)
+}
+
+func TestMutationConstraintWithUpdateOfViolatingValueAndOperationErrors(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Update of a document violating constraints by both a value and an operation lists every violation.",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						Age: Int @constraint(max: 150)
+						Tags: [String!] @crdt(type: orset) @constraint(maxLength: 2)
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"Age": 21
+				}`,
+			},
+			testUtils.Request{
+				Request: `mutation {
+					update_Users(data: "{\"Age\": 200, \"Tags\": {\"_add\": [\"a\", \"b\", \"c\"]}}") {
+						Age
+					}
+				}`,
+				ExpectedError: "Violations: [Age must be at most 150 Tags must have a length of at most 2]",
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, []string{"Users"}, test)
+}
+
+func TestMutationConstraintWithCreateOfDecimalJustBelowMinErrors(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Create of a Decimal value below the minimum of its field by less than a float can hold.",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						Name: String @constraint(minLength: 2, maxLength: 10, pattern: "^[A-Z]")
+						Age: Int @constraint(min: 0, max: 150)
+						Balance: Decimal @constraint(min: 0.5)
+						Tags: [String!] @constraint(maxLength: 2)
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"Name": "John",
+					"Balance": "0.49999999999999999999"
+				}`,
+				ExpectedError: "Violations: [Balance must be at least 0.5]",
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, []string{"Users"}, test)
+}
+
+func TestMutationConstraintWithCreateOfBigIntJustAboveMaxErrors(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Create of a BigInt value above the maximum of its field by less than a float can hold.",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						Points: BigInt @constraint(max: 9007199254740992)
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"Points": "9007199254740993"
+				}`,
+				ExpectedError: "Violations: [Points must be at most 9007199254740992]",
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"Points": "9007199254740992"
+				}`,
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, []string{"Users"}, test)
+}
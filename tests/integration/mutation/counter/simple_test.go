@@ -0,0 +1,482 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package counter
+
+import (
+	"testing"
+
+	"github.com/sourcenetwork/immutable"
+
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+func TestMutationCounterWithCreate(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Create of a document with counter fields.",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Posts {
+						Title: String
+						Likes: Int @crdt(type: pncounter)
+						Score: Float @crdt(type: pncounter) @constraint(min: 0)
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"Title": "Hello",
+					"Likes": 3,
+					"Score": 1.5
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Posts {
+						Title
+						Likes
+						Score
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"Title": "Hello",
+						"Likes": uint64(3),
+						"Score": 1.5,
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, []string{"Posts"}, test)
+}
+
+func TestMutationCounterWithIncrements(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Increments of counter fields are added to their values.",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Posts {
+						Title: String
+						Likes: Int @crdt(type: pncounter)
+						Score: Float @crdt(type: pncounter) @constraint(min: 0)
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"Title": "Hello",
+					"Likes": 3,
+					"Score": 1.5
+				}`,
+			},
+			testUtils.Request{
+				Request: `mutation {
+					increment_Posts(data: "{\"Likes\": 2, \"Score\": 0.25}") {
+						Likes
+						Score
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"Likes": uint64(5),
+						"Score": 1.75,
+					},
+				},
+			},
+			testUtils.Request{
+				Request: `mutation {
+					increment_Posts(data: "{\"Likes\": 4}") {
+						Title
+						Likes
+						Score
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"Title": "Hello",
+						"Likes": uint64(9),
+						"Score": 1.75,
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, []string{"Posts"}, test)
+}
+
+func TestMutationCounterWithDecrement(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Negative increments decrement counter fields.",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Posts {
+						Title: String
+						Likes: Int @crdt(type: pncounter)
+						Score: Float @crdt(type: pncounter) @constraint(min: 0)
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"Title": "Hello",
+					"Likes": 3
+				}`,
+			},
+			testUtils.Request{
+				Request: `mutation {
+					increment_Posts(data: "{\"Likes\": -5}") {
+						Likes
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"Likes": int64(-2),
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, []string{"Posts"}, test)
+}
+
+func TestMutationCounterWithIncrementOfUnsetCounter(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Increments of counter fields without a value start from zero.",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Posts {
+						Title: String
+						Likes: Int @crdt(type: pncounter)
+						Score: Float @crdt(type: pncounter) @constraint(min: 0)
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"Title": "Hello"
+				}`,
+			},
+			testUtils.Request{
+				Request: `mutation {
+					increment_Posts(data: "{\"Likes\": 1}") {
+						Likes
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"Likes": uint64(1),
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, []string{"Posts"}, test)
+}
+
+func TestMutationCounterWithIncrementWithFilter(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Increments only the counters of the documents matching the given filter.",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Posts {
+						Title: String
+						Likes: Int @crdt(type: pncounter)
+						Score: Float @crdt(type: pncounter) @constraint(min: 0)
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"Title": "Hello",
+					"Likes": 3
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"Title": "World",
+					"Likes": 7
+				}`,
+			},
+			testUtils.Request{
+				Request: `mutation {
+					increment_Posts(filter: {Title: {_eq: "Hello"}}, data: "{\"Likes\": 1}") {
+						Title
+						Likes
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"Title": "Hello",
+						"Likes": uint64(4),
+					},
+				},
+			},
+			testUtils.Request{
+				Request: `query {
+					Posts {
+						Title
+						Likes
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"Title": "Hello",
+						"Likes": uint64(4),
+					},
+					{
+						"Title": "World",
+						"Likes": uint64(7),
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, []string{"Posts"}, test)
+}
+
+func TestMutationCounterWithUpdate(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Updates of counter fields set their values.",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Posts {
+						Title: String
+						Likes: Int @crdt(type: pncounter)
+						Score: Float @crdt(type: pncounter) @constraint(min: 0)
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"Title": "Hello",
+					"Likes": 3
+				}`,
+			},
+			testUtils.UpdateDoc{
+				Doc: `{
+					"Likes": 10
+				}`,
+			},
+			testUtils.Request{
+				Request: `mutation {
+					increment_Posts(data: "{\"Likes\": 1}") {
+						Likes
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"Likes": uint64(11),
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, []string{"Posts"}, test)
+}
+
+func TestMutationCounterWithIncrementOfNonCounterFieldErrors(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Increments of fields that are not counters are rejected.",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Posts {
+						Title: String
+						Likes: Int @crdt(type: pncounter)
+						Score: Float @crdt(type: pncounter) @constraint(min: 0)
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"Title": "Hello",
+					"Likes": 3
+				}`,
+			},
+			testUtils.Request{
+				Request: `mutation {
+					increment_Posts(data: "{\"Title\": 1}") {
+						Likes
+					}
+				}`,
+				ExpectedError: "only PN counter fields may be incremented. Field: Title",
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, []string{"Posts"}, test)
+}
+
+func TestMutationCounterWithIncrementOfIntByFloatErrors(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Increments must be numbers of the kind of their field.",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Posts {
+						Title: String
+						Likes: Int @crdt(type: pncounter)
+						Score: Float @crdt(type: pncounter) @constraint(min: 0)
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"Title": "Hello",
+					"Likes": 3
+				}`,
+			},
+			testUtils.Request{
+				Request: `mutation {
+					increment_Posts(data: "{\"Likes\": 1.5}") {
+						Likes
+					}
+				}`,
+				ExpectedError: "increments must be numbers of the kind of their field. Field: Likes, Value: 1.5",
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, []string{"Posts"}, test)
+}
+
+func TestMutationCounterWithIncrementViolatingConstraintErrors(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Increments resulting in values violating a constraint are rejected.",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Posts {
+						Title: String
+						Likes: Int @crdt(type: pncounter)
+						Score: Float @crdt(type: pncounter) @constraint(min: 0)
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"Title": "Hello",
+					"Score": 1.5
+				}`,
+			},
+			testUtils.Request{
+				Request: `mutation {
+					increment_Posts(data: "{\"Score\": -2}") {
+						Score
+					}
+				}`,
+				ExpectedError: "the given values violate the constraints of their fields. Violations: [Score must be at least 0]",
+			},
+			testUtils.Request{
+				Request: `query {
+					Posts {
+						Score
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"Score": 1.5,
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, []string{"Posts"}, test)
+}
+
+func TestMutationCounterWithConcurrentIncrementsFromPeers(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Concurrent changes to a counter on different peers are all kept once synced.",
+		Actions: []any{
+			testUtils.RandomNetworkingConfig(),
+			testUtils.RandomNetworkingConfig(),
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Posts {
+						Title: String
+						Likes: Int @crdt(type: pncounter)
+						Score: Float @crdt(type: pncounter) @constraint(min: 0)
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"Title": "Hello",
+					"Likes": 3
+				}`,
+			},
+			testUtils.ConnectPeers{
+				SourceNodeID: 0,
+				TargetNodeID: 1,
+			},
+			testUtils.UpdateDoc{
+				// Adds 2 likes on the first node.
+				NodeID: immutable.Some(0),
+				Doc: `{
+					"Likes": 5
+				}`,
+			},
+			testUtils.UpdateDoc{
+				// Adds 4 likes on the second node.
+				NodeID: immutable.Some(1),
+				Doc: `{
+					"Likes": 7
+				}`,
+			},
+			testUtils.WaitForSync{},
+			testUtils.Request{
+				Request: `query {
+					Posts {
+						Likes
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"Likes": uint64(9),
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, []string{"Posts"}, test)
+}
+
+func TestMutationCounterWithStringCounterErrors(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Counters may only be declared on numeric fields.",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Posts {
+						Title: String @crdt(type: pncounter)
+					}
+				`,
+				ExpectedError: "PN counters may only be used by int and float fields. Field: Title",
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, []string{"Posts"}, test)
+}
@@ -0,0 +1,123 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package lwwhlc
+
+import (
+	"testing"
+
+	"github.com/sourcenetwork/immutable"
+
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+func TestMutationLWWHLCWithUpdate(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "The latest write to a register ordered by time is kept.",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Notes {
+						Title: String
+						Status: String @crdt(type: lwwhlc)
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"Title": "Plan",
+					"Status": "draft"
+				}`,
+			},
+			testUtils.UpdateDoc{
+				Doc: `{
+					"Status": "published"
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Notes {
+						Title
+						Status
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"Title":  "Plan",
+						"Status": "published",
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, []string{"Notes"}, test)
+}
+
+func TestMutationLWWHLCWithLaterUpdateOnShorterBranch(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "The latest write wins across peers even if its branch of the DAG is shorter.",
+		Actions: []any{
+			testUtils.RandomNetworkingConfig(),
+			testUtils.RandomNetworkingConfig(),
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Notes {
+						Title: String
+						Status: String @crdt(type: lwwhlc)
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"Title": "Plan",
+					"Status": "draft"
+				}`,
+			},
+			testUtils.ConnectPeers{
+				SourceNodeID: 0,
+				TargetNodeID: 1,
+			},
+			testUtils.UpdateDoc{
+				NodeID: immutable.Some(0),
+				Doc: `{
+					"Status": "review"
+				}`,
+			},
+			testUtils.UpdateDoc{
+				NodeID: immutable.Some(0),
+				Doc: `{
+					"Status": "archived"
+				}`,
+			},
+			testUtils.UpdateDoc{
+				NodeID: immutable.Some(1),
+				Doc: `{
+					"Status": "published"
+				}`,
+			},
+			testUtils.WaitForSync{},
+			testUtils.Request{
+				Request: `query {
+					Notes {
+						Status
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"Status": "published",
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, []string{"Notes"}, test)
+}
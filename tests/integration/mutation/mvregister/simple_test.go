@@ -0,0 +1,194 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package mvregister
+
+import (
+	"testing"
+
+	"github.com/sourcenetwork/immutable"
+
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+func TestMutationMVRegisterWithUpdate(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Sequential writes to a register replace its value, leaving no conflicts.",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Notes {
+						Title: String
+						Status: String @crdt(type: mvregister)
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"Title": "Plan",
+					"Status": "draft"
+				}`,
+			},
+			testUtils.UpdateDoc{
+				Doc: `{
+					"Status": "published"
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Notes {
+						Status
+						_conflicts
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"Status":     "published",
+						"_conflicts": map[string]any{},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, []string{"Notes"}, test)
+}
+
+func TestMutationMVRegisterWithConcurrentUpdatesFromPeers(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Concurrent writes to a register on different peers are all kept once synced.",
+		Actions: []any{
+			testUtils.RandomNetworkingConfig(),
+			testUtils.RandomNetworkingConfig(),
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Notes {
+						Title: String
+						Status: String @crdt(type: mvregister)
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"Title": "Plan",
+					"Status": "draft"
+				}`,
+			},
+			testUtils.ConnectPeers{
+				SourceNodeID: 0,
+				TargetNodeID: 1,
+			},
+			testUtils.UpdateDoc{
+				NodeID: immutable.Some(0),
+				Doc: `{
+					"Status": "archived"
+				}`,
+			},
+			testUtils.UpdateDoc{
+				NodeID: immutable.Some(1),
+				Doc: `{
+					"Status": "published"
+				}`,
+			},
+			testUtils.WaitForSync{},
+			testUtils.Request{
+				Request: `query {
+					Notes {
+						Status
+						_conflicts
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"Status": "published",
+						"_conflicts": map[string]any{
+							"Status": []any{
+								map[string]any{
+									"cid":   "bafybeifae5g4pfd2rpak7giifj5ihrfhbbglsujugsgbvzuplipxqn7eei",
+									"value": "published",
+								},
+								map[string]any{
+									"cid":   "bafybeigtaza2yse2frxiz2qnnkuavjsskiwwajugmcksrxnmtg4v3vcwoi",
+									"value": "archived",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, []string{"Notes"}, test)
+}
+
+func TestMutationMVRegisterWithUpdateAfterConcurrentUpdates(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "A write to a register having observed its concurrent values resolves their conflict.",
+		Actions: []any{
+			testUtils.RandomNetworkingConfig(),
+			testUtils.RandomNetworkingConfig(),
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Notes {
+						Title: String
+						Status: String @crdt(type: mvregister)
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"Title": "Plan",
+					"Status": "draft"
+				}`,
+			},
+			testUtils.ConnectPeers{
+				SourceNodeID: 0,
+				TargetNodeID: 1,
+			},
+			testUtils.UpdateDoc{
+				NodeID: immutable.Some(0),
+				Doc: `{
+					"Status": "archived"
+				}`,
+			},
+			testUtils.UpdateDoc{
+				NodeID: immutable.Some(1),
+				Doc: `{
+					"Status": "published"
+				}`,
+			},
+			testUtils.WaitForSync{},
+			testUtils.UpdateDoc{
+				NodeID: immutable.Some(0),
+				Doc: `{
+					"Status": "review"
+				}`,
+			},
+			testUtils.WaitForSync{},
+			testUtils.Request{
+				Request: `query {
+					Notes {
+						Status
+						_conflicts
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"Status":     "review",
+						"_conflicts": map[string]any{},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, []string{"Notes"}, test)
+}
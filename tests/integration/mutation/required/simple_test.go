@@ -0,0 +1,233 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package required
+
+import (
+	"testing"
+
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+func TestMutationRequiredFieldWithCreate(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Create of a document with its required fields.",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						Name: String!
+						Email: String
+						Country: String! @default(value: "Portugal")
+					}
+				`,
+			},
+			testUtils.Request{
+				Request: `mutation {
+					create_Users(data: "{\"Name\": \"John\"}") {
+						Name
+						Email
+						Country
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"Name":    "John",
+						"Email":   nil,
+						"Country": "Portugal",
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, []string{"Users"}, test)
+}
+
+func TestMutationRequiredFieldWithCreateMutationWithoutValueErrors(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Create mutation of a document without a required field.",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						Name: String!
+						Email: String
+						Country: String! @default(value: "Portugal")
+					}
+				`,
+			},
+			testUtils.Request{
+				Request: `mutation {
+					create_Users(data: "{\"Email\": \"john@example.com\"}") {
+						Name
+					}
+				}`,
+				ExpectedError: "a value must be given for the required field. Field: Name",
+			},
+			testUtils.Request{
+				// Ensure that no documents have been written.
+				Request: `query {
+					Users {
+						Name
+					}
+				}`,
+				Results: []map[string]any{},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, []string{"Users"}, test)
+}
+
+func TestMutationRequiredFieldWithCreateWithoutValueErrors(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Create of a document without a required field.",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						Name: String!
+						Email: String
+						Country: String! @default(value: "Portugal")
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"Name": null,
+					"Email": "john@example.com"
+				}`,
+				ExpectedError: "a value must be given for the required field. Field: Name",
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, []string{"Users"}, test)
+}
+
+func TestMutationRequiredFieldWithUpdateToNullErrors(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Update of a required field of a document to null.",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						Name: String!
+						Email: String
+						Country: String! @default(value: "Portugal")
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"Name": "John"
+				}`,
+			},
+			testUtils.UpdateDoc{
+				DocID: 0,
+				Doc: `{
+					"Name": null
+				}`,
+				ExpectedError: "a value must be given for the required field. Field: Name",
+			},
+			testUtils.Request{
+				Request: `query {
+					Users {
+						Name
+					}
+				}`,
+				Results: []map[string]any{
+					{"Name": "John"},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, []string{"Users"}, test)
+}
+
+func TestMutationRequiredFieldWithUpdateMutationToNullErrors(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Update mutation of a required field to null.",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						Name: String!
+						Email: String
+						Country: String! @default(value: "Portugal")
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"Name": "John"
+				}`,
+			},
+			testUtils.Request{
+				Request: `mutation {
+					update_Users(data: "{\"Country\": null}") {
+						Name
+					}
+				}`,
+				ExpectedError: "a value must be given for the required field. Field: Country",
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, []string{"Users"}, test)
+}
+
+func TestMutationRequiredFieldWithUpdateOfOtherField(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Update of a field of a document with required fields.",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						Name: String!
+						Email: String
+						Country: String! @default(value: "Portugal")
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"Name": "John"
+				}`,
+			},
+			testUtils.UpdateDoc{
+				DocID: 0,
+				Doc: `{
+					"Email": "john@example.com"
+				}`,
+			},
+			testUtils.Request{
+				Request: `mutation {
+					update_Users(data: "{\"Country\": \"Spain\"}") {
+						Name
+						Email
+						Country
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"Name":    "John",
+						"Email":   "john@example.com",
+						"Country": "Spain",
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, []string{"Users"}, test)
+}
@@ -0,0 +1,334 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package set
+
+import (
+	"testing"
+
+	"github.com/sourcenetwork/immutable"
+
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+func TestMutationSetWithCreate(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Create of a document with set fields holds each element once, ordered by value.",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Notes {
+						Title: String
+						Tags: [String!] @crdt(type: orset)
+						Ratings: [Int] @crdt(type: orset) @constraint(maxLength: 3)
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"Title": "Groceries",
+					"Tags": ["urgent", "home", "urgent"],
+					"Ratings": [3, null, 1]
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Notes {
+						Title
+						Tags
+						Ratings
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"Title":   "Groceries",
+						"Tags":    []string{"home", "urgent"},
+						"Ratings": []immutable.Option[int64]{immutable.None[int64](), immutable.Some[int64](1), immutable.Some[int64](3)},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, []string{"Notes"}, test)
+}
+
+func TestMutationSetWithAddAndRemove(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Updates may add elements to, and remove elements from, set fields.",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Notes {
+						Title: String
+						Tags: [String!] @crdt(type: orset)
+						Ratings: [Int] @crdt(type: orset) @constraint(maxLength: 3)
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"Title": "Groceries",
+					"Tags": ["home", "urgent"]
+				}`,
+			},
+			testUtils.Request{
+				Request: `mutation {
+					update_Notes(data: "{\"Tags\": {\"_add\": [\"shopping\", \"home\"], \"_remove\": [\"urgent\"]}}") {
+						Tags
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"Tags": []string{"home", "shopping"},
+					},
+				},
+			},
+			testUtils.Request{
+				Request: `mutation {
+					update_Notes(data: "{\"Tags\": {\"_remove\": [\"home\", \"missing\"]}, \"Title\": \"Shopping\"}") {
+						Title
+						Tags
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"Title": "Shopping",
+						"Tags":  []string{"shopping"},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, []string{"Notes"}, test)
+}
+
+func TestMutationSetWithAddToUnsetField(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Elements may be added to set fields without a value.",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Notes {
+						Title: String
+						Tags: [String!] @crdt(type: orset)
+						Ratings: [Int] @crdt(type: orset) @constraint(maxLength: 3)
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"Title": "Groceries"
+				}`,
+			},
+			testUtils.Request{
+				Request: `mutation {
+					update_Notes(data: "{\"Ratings\": {\"_add\": [5, 2]}}") {
+						Ratings
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"Ratings": []immutable.Option[int64]{immutable.Some[int64](2), immutable.Some[int64](5)},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, []string{"Notes"}, test)
+}
+
+func TestMutationSetWithUpdateOfElements(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Updates of set fields to arrays replace their elements.",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Notes {
+						Title: String
+						Tags: [String!] @crdt(type: orset)
+						Ratings: [Int] @crdt(type: orset) @constraint(maxLength: 3)
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"Title": "Groceries",
+					"Tags": ["home", "urgent"]
+				}`,
+			},
+			testUtils.UpdateDoc{
+				Doc: `{
+					"Tags": ["work", "home"]
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Notes {
+						Tags
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"Tags": []string{"home", "work"},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, []string{"Notes"}, test)
+}
+
+func TestMutationSetWithUnknownOperationErrors(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Set fields may only be updated by _add and _remove operations.",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Notes {
+						Title: String
+						Tags: [String!] @crdt(type: orset)
+						Ratings: [Int] @crdt(type: orset) @constraint(maxLength: 3)
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"Title": "Groceries",
+					"Tags": ["home"]
+				}`,
+			},
+			testUtils.Request{
+				Request: `mutation {
+					update_Notes(data: "{\"Tags\": {\"_append\": [\"urgent\"]}}") {
+						Tags
+					}
+				}`,
+				ExpectedError: "OR set fields may only be updated by arrays, or objects of _add and _remove arrays. " +
+					"Field: Tags, Operation: _append",
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, []string{"Notes"}, test)
+}
+
+func TestMutationSetWithAddViolatingConstraintErrors(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Additions resulting in sets violating a constraint are rejected.",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Notes {
+						Title: String
+						Tags: [String!] @crdt(type: orset)
+						Ratings: [Int] @crdt(type: orset) @constraint(maxLength: 3)
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"Title": "Groceries",
+					"Ratings": [1, 2, 3]
+				}`,
+			},
+			testUtils.Request{
+				Request: `mutation {
+					update_Notes(data: "{\"Ratings\": {\"_add\": [4]}}") {
+						Ratings
+					}
+				}`,
+				ExpectedError: "the given values violate the constraints of their fields. " +
+					"Violations: [Ratings must have a length of at most 3]",
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, []string{"Notes"}, test)
+}
+
+func TestMutationSetWithStringSetErrors(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Sets may only be declared on array fields.",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Notes {
+						Title: String @crdt(type: orset)
+					}
+				`,
+				ExpectedError: "OR sets may only be used by array fields. Field: Title",
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, []string{"Notes"}, test)
+}
+
+func TestMutationSetWithConcurrentUpdatesFromPeers(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Concurrent additions to a set on different peers are all kept once synced.",
+		Actions: []any{
+			testUtils.RandomNetworkingConfig(),
+			testUtils.RandomNetworkingConfig(),
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Notes {
+						Title: String
+						Tags: [String!] @crdt(type: orset)
+						Ratings: [Int] @crdt(type: orset) @constraint(maxLength: 3)
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"Title": "Groceries",
+					"Tags": ["home"]
+				}`,
+			},
+			testUtils.ConnectPeers{
+				SourceNodeID: 0,
+				TargetNodeID: 1,
+			},
+			testUtils.UpdateDoc{
+				// Adds "urgent" on the first node.
+				NodeID: immutable.Some(0),
+				Doc: `{
+					"Tags": ["home", "urgent"]
+				}`,
+			},
+			testUtils.UpdateDoc{
+				// Removes "home" and adds "work" on the second node.
+				NodeID: immutable.Some(1),
+				Doc: `{
+					"Tags": ["work"]
+				}`,
+			},
+			testUtils.WaitForSync{},
+			testUtils.Request{
+				Request: `query {
+					Notes {
+						Tags
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"Tags": []string{"urgent", "work"},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, []string{"Notes"}, test)
+}
@@ -31,7 +31,7 @@ func TestMutationCreateSimpleReturnVersionCID(t *testing.T) {
 			{
 				"_version": []map[string]any{
 					{
-						"cid": "bafybeih7js7lp4wz3ihp4jftld7kwcbsl6zbzuz4kerqaxudpssi6yunma",
+						"cid": "bafybeih73ybsewwj3xf7ruzkhfjq32jz5yz2a76g2vu5dxxbrqb4ns43om",
 					},
 				},
 			},
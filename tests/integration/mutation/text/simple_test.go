@@ -0,0 +1,354 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package text
+
+import (
+	"testing"
+
+	"github.com/sourcenetwork/immutable"
+
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+func TestMutationTextWithCreate(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Create of a document with a text field holds the given text.",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Notes {
+						Title: String
+						Body: String @crdt(type: text) @constraint(maxLength: 24)
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"Title": "Greeting",
+					"Body": "Hello world"
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Notes {
+						Title
+						Body
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"Title": "Greeting",
+						"Body":  "Hello world",
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, []string{"Notes"}, test)
+}
+
+func TestMutationTextWithEdits(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Updates may insert text into, and delete text from, text fields at positions.",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Notes {
+						Title: String
+						Body: String @crdt(type: text) @constraint(maxLength: 24)
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"Title": "Greeting",
+					"Body": "Hello world"
+				}`,
+			},
+			testUtils.Request{
+				Request: `mutation {
+					update_Notes(data: "{\"Body\": {\"_edit\": [{\"position\": 6, \"insert\": \"brave \"}, {\"position\": 5, \"delete\": 1, \"insert\": \",\"}]}}") {
+						Body
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"Body": "Hello,brave world",
+					},
+				},
+			},
+			testUtils.Request{
+				Request: `query {
+					Notes {
+						Body
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"Body": "Hello,brave world",
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, []string{"Notes"}, test)
+}
+
+func TestMutationTextWithEditOfUnsetField(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Text may be inserted into text fields without a value.",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Notes {
+						Title: String
+						Body: String @crdt(type: text) @constraint(maxLength: 24)
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"Title": "Greeting"
+				}`,
+			},
+			testUtils.Request{
+				Request: `mutation {
+					update_Notes(data: "{\"Body\": {\"_edit\": [{\"position\": 0, \"insert\": \"Hi\"}]}}") {
+						Body
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"Body": "Hi",
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, []string{"Notes"}, test)
+}
+
+func TestMutationTextWithUpdateOfText(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Updates of text fields to strings replace their text.",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Notes {
+						Title: String
+						Body: String @crdt(type: text) @constraint(maxLength: 24)
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"Title": "Greeting",
+					"Body": "Hello world"
+				}`,
+			},
+			testUtils.UpdateDoc{
+				Doc: `{
+					"Body": "Goodbye world"
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Notes {
+						Body
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"Body": "Goodbye world",
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, []string{"Notes"}, test)
+}
+
+func TestMutationTextWithOutOfRangePositionErrors(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Edits of text fields at positions beyond their text are rejected.",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Notes {
+						Title: String
+						Body: String @crdt(type: text) @constraint(maxLength: 24)
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"Title": "Greeting",
+					"Body": "Hello"
+				}`,
+			},
+			testUtils.Request{
+				Request: `mutation {
+					update_Notes(data: "{\"Body\": {\"_edit\": [{\"position\": 6, \"insert\": \"!\"}]}}") {
+						Body
+					}
+				}`,
+				ExpectedError: "text edit position is out of range. Position: 6, Length: 5",
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, []string{"Notes"}, test)
+}
+
+func TestMutationTextWithUnknownOperationErrors(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Text fields may only be updated by _edit operations.",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Notes {
+						Title: String
+						Body: String @crdt(type: text) @constraint(maxLength: 24)
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"Title": "Greeting",
+					"Body": "Hello"
+				}`,
+			},
+			testUtils.Request{
+				Request: `mutation {
+					update_Notes(data: "{\"Body\": {\"_edit\": [{\"offset\": 0, \"insert\": \"!\"}]}}") {
+						Body
+					}
+				}`,
+				ExpectedError: "text fields may only be updated by strings, or objects of _edit arrays. " +
+					"Field: Body, Operation: offset",
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, []string{"Notes"}, test)
+}
+
+func TestMutationTextWithEditViolatingConstraintErrors(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Edits resulting in text violating a constraint are rejected.",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Notes {
+						Title: String
+						Body: String @crdt(type: text) @constraint(maxLength: 24)
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"Title": "Greeting",
+					"Body": "Hello world"
+				}`,
+			},
+			testUtils.Request{
+				Request: `mutation {
+					update_Notes(data: "{\"Body\": {\"_edit\": [{\"position\": 11, \"insert\": \", and all who dwell in it\"}]}}") {
+						Body
+					}
+				}`,
+				ExpectedError: "the given values violate the constraints of their fields. " +
+					"Violations: [Body must have a length of at most 24]",
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, []string{"Notes"}, test)
+}
+
+func TestMutationTextWithIntTextErrors(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Text CRDTs may only be declared on string fields.",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Notes {
+						Views: Int @crdt(type: text)
+					}
+				`,
+				ExpectedError: "text CRDTs may only be used by string fields. Field: Views",
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, []string{"Notes"}, test)
+}
+
+func TestMutationTextWithConcurrentEditsFromPeers(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Concurrent edits of a text on different peers are all kept once synced.",
+		Actions: []any{
+			testUtils.RandomNetworkingConfig(),
+			testUtils.RandomNetworkingConfig(),
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Notes {
+						Title: String
+						Body: String @crdt(type: text) @constraint(maxLength: 24)
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"Title": "Greeting",
+					"Body": "Hello world"
+				}`,
+			},
+			testUtils.ConnectPeers{
+				SourceNodeID: 0,
+				TargetNodeID: 1,
+			},
+			testUtils.UpdateDoc{
+				// Inserts "brave " on the first node.
+				NodeID: immutable.Some(0),
+				Doc: `{
+					"Body": "Hello brave world"
+				}`,
+			},
+			testUtils.UpdateDoc{
+				// Appends "!" on the second node.
+				NodeID: immutable.Some(1),
+				Doc: `{
+					"Body": "Hello world!"
+				}`,
+			},
+			testUtils.WaitForSync{},
+			testUtils.Request{
+				Request: `query {
+					Notes {
+						Body
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"Body": "Hello brave world!",
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, []string{"Notes"}, test)
+}
@@ -71,7 +71,7 @@ type P2PTestCase struct {
 }
 
 func setupDefraNode(t *testing.T, cfg *config.Config, seeds []string) (*node.Node, []client.DocKey, error) {
-	ctx := context.Background()
+	ctx := testutils.NewCommitContext(context.Background())
 
 	log.Info(ctx, "Building new memory store")
 	db, err := testutils.NewBadgerMemoryDB(ctx, coreDB.WithUpdateEvents())
@@ -191,7 +191,7 @@ func getDocument(ctx context.Context, db client.DB, dockey client.DocKey) (*clie
 }
 
 func executeTestCase(t *testing.T, test P2PTestCase) {
-	ctx := context.Background()
+	ctx := testutils.NewCommitContext(context.Background())
 
 	dockeys := []client.DocKey{}
 	nodes := []*node.Node{}
@@ -0,0 +1,69 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package replicator
+
+import (
+	"testing"
+
+	"github.com/sourcenetwork/immutable"
+
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+func TestP2POneToOneReplicatorWithBlob(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: []any{
+			testUtils.RandomNetworkingConfig(),
+			testUtils.RandomNetworkingConfig(),
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						Name: String
+						Avatar: Blob
+					}
+				`,
+			},
+			testUtils.ConfigureReplicator{
+				SourceNodeID: 0,
+				TargetNodeID: 1,
+			},
+			testUtils.CreateDoc{
+				// Create John on the first (source) node only, and allow the value and
+				// the content of the blob to sync
+				NodeID: immutable.Some(0),
+				Doc: `{
+					"Name": "John",
+					"Avatar": "aGVsbG8gd29ybGQ="
+				}`,
+			},
+			testUtils.WaitForSync{},
+			testUtils.Request{
+				Request: `query {
+					Users {
+						Avatar
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"Avatar": "bafybeihzszbqtjhlvhfkzxq6feqxp7ec4ddjjgi6y4j37axixi2wfizcri",
+					},
+				},
+			},
+			testUtils.GetBlob{
+				NodeID:  immutable.Some(1),
+				CID:     "bafybeihzszbqtjhlvhfkzxq6feqxp7ec4ddjjgi6y4j37axixi2wfizcri",
+				Content: []byte("hello world"),
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, []string{"Users"}, test)
+}
@@ -0,0 +1,166 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package replicator
+
+import (
+	"testing"
+
+	"github.com/sourcenetwork/immutable"
+
+	"github.com/sourcenetwork/defradb/client"
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+func TestP2POneToOneReplicatorWithUniqueConflictKeepsBothDocuments(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: []any{
+			testUtils.RandomNetworkingConfig(),
+			testUtils.RandomNetworkingConfig(),
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						Name: String
+						Email: String @unique
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				// Create Fred on the second (target) node only
+				NodeID: immutable.Some(1),
+				Doc: `{
+					"Name": "Fred",
+					"Email": "john@example.com"
+				}`,
+			},
+			testUtils.ConfigureReplicator{
+				SourceNodeID: 0,
+				TargetNodeID: 1,
+			},
+			testUtils.CreateDoc{
+				// Create John on the first (source) node only, with the same unique value as Fred,
+				// and allow the value to sync
+				NodeID: immutable.Some(0),
+				Doc: `{
+					"Name": "John",
+					"Email": "john@example.com"
+				}`,
+			},
+			testUtils.WaitForSync{},
+			testUtils.Request{
+				// The conflicting document is accepted by the target, as rejecting it would leave
+				// the nodes diverged, and remains reachable through the index.
+				NodeID: immutable.Some(1),
+				Request: `query {
+					Users(filter: {Email: {_eq: "john@example.com"}}, order: {Name: ASC}) {
+						Name
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"Name": "Fred",
+					},
+					{
+						"Name": "John",
+					},
+				},
+			},
+			testUtils.GetUniqueConflicts{
+				// The conflict is flagged on the target for the operator to resolve
+				NodeID: immutable.Some(1),
+				Results: []client.UniqueConflict{
+					{
+						IndexName:         "users_Email",
+						DocKey:            "bae-03b9e3f7-6389-551f-8f53-8c943f24b961",
+						ConflictingDocKey: "bae-3064b6af-c5ca-5901-8b55-3b7203106a75",
+					},
+				},
+			},
+			testUtils.GetUniqueConflicts{
+				NodeID:  immutable.Some(0),
+				Results: []client.UniqueConflict{},
+			},
+			testUtils.CreateDoc{
+				// Local writes of the conflicting value are still rejected
+				NodeID: immutable.Some(1),
+				Doc: `{
+					"Name": "Johnny",
+					"Email": "john@example.com"
+				}`,
+				ExpectedError: "a document with the same values for the unique fields already exists",
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, []string{"Users"}, test)
+}
+
+func TestP2POneToOneReplicatorWithUniqueConflictClearsConflictOnceResolved(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: []any{
+			testUtils.RandomNetworkingConfig(),
+			testUtils.RandomNetworkingConfig(),
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						Name: String
+						Email: String @unique
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				NodeID: immutable.Some(1),
+				Doc: `{
+					"Name": "Fred",
+					"Email": "john@example.com"
+				}`,
+			},
+			testUtils.ConfigureReplicator{
+				SourceNodeID: 0,
+				TargetNodeID: 1,
+			},
+			testUtils.CreateDoc{
+				NodeID: immutable.Some(0),
+				Doc: `{
+					"Name": "John",
+					"Email": "john@example.com"
+				}`,
+			},
+			testUtils.WaitForSync{},
+			testUtils.UpdateDoc{
+				// Resolve the conflict on the target by changing the value of Fred
+				NodeID: immutable.Some(1),
+				DocID:  0,
+				Doc: `{
+					"Email": "fred@example.com"
+				}`,
+			},
+			testUtils.GetUniqueConflicts{
+				NodeID:  immutable.Some(1),
+				Results: []client.UniqueConflict{},
+			},
+			testUtils.Request{
+				NodeID: immutable.Some(1),
+				Request: `query {
+					Users(filter: {Email: {_eq: "john@example.com"}}) {
+						Name
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"Name": "John",
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, []string{"Users"}, test)
+}
@@ -0,0 +1,131 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package blob
+
+import (
+	"testing"
+
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+func TestQueryBlob(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple query of a blob field, returning the CID of the blob.",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						Name: String
+						Avatar: Blob
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				// "Avatar" is "hello world" base64 encoded
+				Doc: `{
+					"Name": "John",
+					"Avatar": "aGVsbG8gd29ybGQ="
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Users {
+						Name
+						Avatar
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"Name":   "John",
+						"Avatar": "bafybeihzszbqtjhlvhfkzxq6feqxp7ec4ddjjgi6y4j37axixi2wfizcri",
+					},
+				},
+			},
+			testUtils.GetBlob{
+				CID:     "bafybeihzszbqtjhlvhfkzxq6feqxp7ec4ddjjgi6y4j37axixi2wfizcri",
+				Content: []byte("hello world"),
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, []string{"Users"}, test)
+}
+
+func TestQueryBlobAfterUpdate(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple query of a blob field after it is updated.",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						Name: String
+						Avatar: Blob
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"Name": "John",
+					"Avatar": "aGVsbG8gd29ybGQ="
+				}`,
+			},
+			testUtils.UpdateDoc{
+				// "Avatar" is "goodbye" base64 encoded
+				Doc: `{
+					"Avatar": "Z29vZGJ5ZQ=="
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Users {
+						Avatar
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"Avatar": "bafybeia74ki6hruzudz3tzpvcjjpxic2xnmekllvlhzkrljkazphwlrrd4",
+					},
+				},
+			},
+			testUtils.GetBlob{
+				CID:     "bafybeia74ki6hruzudz3tzpvcjjpxic2xnmekllvlhzkrljkazphwlrrd4",
+				Content: []byte("goodbye"),
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, []string{"Users"}, test)
+}
+
+func TestCreateBlobWithInvalidContent(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Create a document with blob content that is not base64 encoded.",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						Name: String
+						Avatar: Blob
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"Name": "John",
+					"Avatar": "not base64!"
+				}`,
+				ExpectedError: "blob values must be base64 encoded strings",
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, []string{"Users"}, test)
+}
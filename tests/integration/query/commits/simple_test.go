@@ -36,13 +36,13 @@ func TestQueryCommits(t *testing.T) {
 					}`,
 				Results: []map[string]any{
 					{
-						"cid": "bafybeihxvx3f7eejvco6zbxsidoeuph6ywpbo33lrqm3picna2aj7pdeiu",
+						"cid": "bafybeifulsbntzhikpilwjm6z657p6jvg2f6h2z5kkfmb5awzov5esumva",
 					},
 					{
-						"cid": "bafybeih25dvtgei2bryhlz24tbyfdcni5di7akgcx24pezxts27wz7v454",
+						"cid": "bafybeiaxk7ycdik3avaerrmxk4l5i53e4t5h6kihyefco3ui2bewplnyd4",
 					},
 					{
-						"cid": "bafybeiapquwo7dfow7b7ovwrn3nl4e2cv2g5eoufuzylq54b4o6tatfrny",
+						"cid": "bafybeigfgvalll75ic7wslfl5efcnmybvl75uxrwlknb7jzfzlwmmnc5ey",
 					},
 				},
 			},
@@ -79,22 +79,22 @@ func TestQueryCommitsMultipleDocs(t *testing.T) {
 					}`,
 				Results: []map[string]any{
 					{
-						"cid": "bafybeibkvya7a3qg2x5rqx5qz3r6vqbfddppwqkmxkygwpgzavjtr2dbsm",
+						"cid": "bafybeihiahyd4ajvv4dppjzjx5ty2hnwyfbw7ohsm36k6eyg35lmlj4niq",
 					},
 					{
-						"cid": "bafybeicb2o2enown46mt4rlzshoswuuxddf2anbc74eeydthrpyoloyziu",
+						"cid": "bafybeihzet2tggkbozjwvwkd5sb4lj3qnrhtifudmsybnhjw6izm4cszka",
 					},
 					{
-						"cid": "bafybeif5qvt4lyqcszyoe6nm66j2zf6doszhnrcmzpq7tlk2wflfvrfhbu",
+						"cid": "bafybeibgzqc7dzskehijw2ncsofwlbqictcdf7how5ccgpzdk4vwvxzzmu",
 					},
 					{
-						"cid": "bafybeihxvx3f7eejvco6zbxsidoeuph6ywpbo33lrqm3picna2aj7pdeiu",
+						"cid": "bafybeifulsbntzhikpilwjm6z657p6jvg2f6h2z5kkfmb5awzov5esumva",
 					},
 					{
-						"cid": "bafybeih25dvtgei2bryhlz24tbyfdcni5di7akgcx24pezxts27wz7v454",
+						"cid": "bafybeiaxk7ycdik3avaerrmxk4l5i53e4t5h6kihyefco3ui2bewplnyd4",
 					},
 					{
-						"cid": "bafybeiapquwo7dfow7b7ovwrn3nl4e2cv2g5eoufuzylq54b4o6tatfrny",
+						"cid": "bafybeigfgvalll75ic7wslfl5efcnmybvl75uxrwlknb7jzfzlwmmnc5ey",
 					},
 				},
 			},
@@ -125,15 +125,15 @@ func TestQueryCommitsWithSchemaVersionIdField(t *testing.T) {
 					}`,
 				Results: []map[string]any{
 					{
-						"cid":             "bafybeihxvx3f7eejvco6zbxsidoeuph6ywpbo33lrqm3picna2aj7pdeiu",
+						"cid":             "bafybeifulsbntzhikpilwjm6z657p6jvg2f6h2z5kkfmb5awzov5esumva",
 						"schemaVersionId": "bafkreibwyhaiseplil6tayn7spazp3qmc7nkoxdjb7uoe5zvcac4pgbwhy",
 					},
 					{
-						"cid":             "bafybeih25dvtgei2bryhlz24tbyfdcni5di7akgcx24pezxts27wz7v454",
+						"cid":             "bafybeiaxk7ycdik3avaerrmxk4l5i53e4t5h6kihyefco3ui2bewplnyd4",
 						"schemaVersionId": "bafkreibwyhaiseplil6tayn7spazp3qmc7nkoxdjb7uoe5zvcac4pgbwhy",
 					},
 					{
-						"cid":             "bafybeiapquwo7dfow7b7ovwrn3nl4e2cv2g5eoufuzylq54b4o6tatfrny",
+						"cid":             "bafybeigfgvalll75ic7wslfl5efcnmybvl75uxrwlknb7jzfzlwmmnc5ey",
 						"schemaVersionId": "bafkreibwyhaiseplil6tayn7spazp3qmc7nkoxdjb7uoe5zvcac4pgbwhy",
 					},
 				},
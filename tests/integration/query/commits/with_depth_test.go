@@ -36,13 +36,13 @@ func TestQueryCommitsWithDepth1(t *testing.T) {
 					}`,
 				Results: []map[string]any{
 					{
-						"cid": "bafybeihxvx3f7eejvco6zbxsidoeuph6ywpbo33lrqm3picna2aj7pdeiu",
+						"cid": "bafybeifulsbntzhikpilwjm6z657p6jvg2f6h2z5kkfmb5awzov5esumva",
 					},
 					{
-						"cid": "bafybeih25dvtgei2bryhlz24tbyfdcni5di7akgcx24pezxts27wz7v454",
+						"cid": "bafybeiaxk7ycdik3avaerrmxk4l5i53e4t5h6kihyefco3ui2bewplnyd4",
 					},
 					{
-						"cid": "bafybeiapquwo7dfow7b7ovwrn3nl4e2cv2g5eoufuzylq54b4o6tatfrny",
+						"cid": "bafybeigfgvalll75ic7wslfl5efcnmybvl75uxrwlknb7jzfzlwmmnc5ey",
 					},
 				},
 			},
@@ -81,16 +81,16 @@ func TestQueryCommitsWithDepth1WithUpdate(t *testing.T) {
 				Results: []map[string]any{
 					{
 						// "Age" field head
-						"cid":    "bafybeiepww5b67jrrliuiy27erfjuivwnjca5ptdpbxrrjrqkh3b2hckyy",
+						"cid":    "bafybeicpcai47yfc5bpieqnneedrhqt4geov6xz6cvhwumovajlpclt7pe",
 						"height": int64(2),
 					},
 					{
 						// "Name" field head (unchanged from create)
-						"cid":    "bafybeih25dvtgei2bryhlz24tbyfdcni5di7akgcx24pezxts27wz7v454",
+						"cid":    "bafybeiaxk7ycdik3avaerrmxk4l5i53e4t5h6kihyefco3ui2bewplnyd4",
 						"height": int64(1),
 					},
 					{
-						"cid":    "bafybeiebail45ch3n5rh7myumqn2jfeefnynba2ldwiesge3ddq5hu6olq",
+						"cid":    "bafybeifiauajzoniii6jfbvdhhlx34p3dbu6sihncekfdijn247vmff5dq",
 						"height": int64(2),
 					},
 				},
@@ -137,27 +137,27 @@ func TestQueryCommitsWithDepth2WithUpdate(t *testing.T) {
 				Results: []map[string]any{
 					{
 						// Composite head
-						"cid":    "bafybeidag5ogxiqsctdxktobw6gbq52pbfkmicp6np5ccuy6pjyvgobdxq",
+						"cid":    "bafybeicjp6qzy3cfjhblpj4ofje7rrp6euq56omph5rxdvlbncx2xtuvza",
 						"height": int64(3),
 					},
 					{
 						// Composite head -1
-						"cid":    "bafybeiepww5b67jrrliuiy27erfjuivwnjca5ptdpbxrrjrqkh3b2hckyy",
+						"cid":    "bafybeicpcai47yfc5bpieqnneedrhqt4geov6xz6cvhwumovajlpclt7pe",
 						"height": int64(2),
 					},
 					{
 						// "Name" field head (unchanged from create)
-						"cid":    "bafybeih25dvtgei2bryhlz24tbyfdcni5di7akgcx24pezxts27wz7v454",
+						"cid":    "bafybeiaxk7ycdik3avaerrmxk4l5i53e4t5h6kihyefco3ui2bewplnyd4",
 						"height": int64(1),
 					},
 					{
 						// "Age" field head
-						"cid":    "bafybeid2arccjpsmmocarl6s3fe7ygstbdlpujlksuflukmun4bvf5g4oe",
+						"cid":    "bafybeib76zvkj2pjs5oygxuafsbjgsd3i6xort3b3lx73vxcm22qfvmusq",
 						"height": int64(3),
 					},
 					{
 						// "Age" field head -1
-						"cid":    "bafybeiebail45ch3n5rh7myumqn2jfeefnynba2ldwiesge3ddq5hu6olq",
+						"cid":    "bafybeifiauajzoniii6jfbvdhhlx34p3dbu6sihncekfdijn247vmff5dq",
 						"height": int64(2),
 					},
 				},
@@ -195,22 +195,22 @@ func TestQueryCommitsWithDepth1AndMultipleDocs(t *testing.T) {
 					}`,
 				Results: []map[string]any{
 					{
-						"cid": "bafybeihxvx3f7eejvco6zbxsidoeuph6ywpbo33lrqm3picna2aj7pdeiu",
+						"cid": "bafybeifulsbntzhikpilwjm6z657p6jvg2f6h2z5kkfmb5awzov5esumva",
 					},
 					{
-						"cid": "bafybeih25dvtgei2bryhlz24tbyfdcni5di7akgcx24pezxts27wz7v454",
+						"cid": "bafybeiaxk7ycdik3avaerrmxk4l5i53e4t5h6kihyefco3ui2bewplnyd4",
 					},
 					{
-						"cid": "bafybeiapquwo7dfow7b7ovwrn3nl4e2cv2g5eoufuzylq54b4o6tatfrny",
+						"cid": "bafybeigfgvalll75ic7wslfl5efcnmybvl75uxrwlknb7jzfzlwmmnc5ey",
 					},
 					{
-						"cid": "bafybeifo3ujh2imjbx7d4g43eocureejdcclb2zpkzelwtt2omaxzxkxlm",
+						"cid": "bafybeifzotgwhhmtwwr3m7yql665rmxrc45moepkubdk2wazfqtdoqofrm",
 					},
 					{
-						"cid": "bafybeih2tjbbltowuprpc7q7tx7jw7zwlso5s3yck3o5gmi3dsr54ooyey",
+						"cid": "bafybeidhr23k2okbzbcyg4wuqljen23s4r5kb2xlvyfoa2dlfznfmvchxq",
 					},
 					{
-						"cid": "bafybeicy3ugjnpvsr6w74lh5se4dzvfncshtz6n54uw2gjzbk42hmojigq",
+						"cid": "bafybeiecdw2a5sg4snhdacvexoudi5ad2dpgoc7gmv5zwnzqvi6svzytfi",
 					},
 				},
 			},
@@ -37,15 +37,15 @@ func TestQueryCommitsWithDockeyAndLinkCount(t *testing.T) {
 					}`,
 				Results: []map[string]any{
 					{
-						"cid":    "bafybeihxvx3f7eejvco6zbxsidoeuph6ywpbo33lrqm3picna2aj7pdeiu",
+						"cid":    "bafybeifulsbntzhikpilwjm6z657p6jvg2f6h2z5kkfmb5awzov5esumva",
 						"_count": 0,
 					},
 					{
-						"cid":    "bafybeih25dvtgei2bryhlz24tbyfdcni5di7akgcx24pezxts27wz7v454",
+						"cid":    "bafybeiaxk7ycdik3avaerrmxk4l5i53e4t5h6kihyefco3ui2bewplnyd4",
 						"_count": 0,
 					},
 					{
-						"cid":    "bafybeiapquwo7dfow7b7ovwrn3nl4e2cv2g5eoufuzylq54b4o6tatfrny",
+						"cid":    "bafybeigfgvalll75ic7wslfl5efcnmybvl75uxrwlknb7jzfzlwmmnc5ey",
 						"_count": 2,
 					},
 				},
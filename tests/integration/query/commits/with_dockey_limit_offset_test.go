@@ -57,10 +57,10 @@ func TestQueryCommitsWithDockeyAndLimitAndOffset(t *testing.T) {
 					}`,
 				Results: []map[string]any{
 					{
-						"cid": "bafybeidag5ogxiqsctdxktobw6gbq52pbfkmicp6np5ccuy6pjyvgobdxq",
+						"cid": "bafybeicjp6qzy3cfjhblpj4ofje7rrp6euq56omph5rxdvlbncx2xtuvza",
 					},
 					{
-						"cid": "bafybeiepww5b67jrrliuiy27erfjuivwnjca5ptdpbxrrjrqkh3b2hckyy",
+						"cid": "bafybeicpcai47yfc5bpieqnneedrhqt4geov6xz6cvhwumovajlpclt7pe",
 					},
 				},
 			},
@@ -58,11 +58,11 @@ func TestQueryCommitsWithDockeyAndOrderAndLimitAndOffset(t *testing.T) {
 					}`,
 				Results: []map[string]any{
 					{
-						"cid":    "bafybeiebail45ch3n5rh7myumqn2jfeefnynba2ldwiesge3ddq5hu6olq",
+						"cid":    "bafybeifiauajzoniii6jfbvdhhlx34p3dbu6sihncekfdijn247vmff5dq",
 						"height": int64(2),
 					},
 					{
-						"cid":    "bafybeidag5ogxiqsctdxktobw6gbq52pbfkmicp6np5ccuy6pjyvgobdxq",
+						"cid":    "bafybeicjp6qzy3cfjhblpj4ofje7rrp6euq56omph5rxdvlbncx2xtuvza",
 						"height": int64(3),
 					},
 				},
@@ -44,23 +44,23 @@ func TestQueryCommitsWithDockeyAndOrderHeightDesc(t *testing.T) {
 					}`,
 				Results: []map[string]any{
 					{
-						"cid":    "bafybeiepww5b67jrrliuiy27erfjuivwnjca5ptdpbxrrjrqkh3b2hckyy",
+						"cid":    "bafybeicpcai47yfc5bpieqnneedrhqt4geov6xz6cvhwumovajlpclt7pe",
 						"height": int64(2),
 					},
 					{
-						"cid":    "bafybeiebail45ch3n5rh7myumqn2jfeefnynba2ldwiesge3ddq5hu6olq",
+						"cid":    "bafybeifiauajzoniii6jfbvdhhlx34p3dbu6sihncekfdijn247vmff5dq",
 						"height": int64(2),
 					},
 					{
-						"cid":    "bafybeihxvx3f7eejvco6zbxsidoeuph6ywpbo33lrqm3picna2aj7pdeiu",
+						"cid":    "bafybeifulsbntzhikpilwjm6z657p6jvg2f6h2z5kkfmb5awzov5esumva",
 						"height": int64(1),
 					},
 					{
-						"cid":    "bafybeih25dvtgei2bryhlz24tbyfdcni5di7akgcx24pezxts27wz7v454",
+						"cid":    "bafybeiaxk7ycdik3avaerrmxk4l5i53e4t5h6kihyefco3ui2bewplnyd4",
 						"height": int64(1),
 					},
 					{
-						"cid":    "bafybeiapquwo7dfow7b7ovwrn3nl4e2cv2g5eoufuzylq54b4o6tatfrny",
+						"cid":    "bafybeigfgvalll75ic7wslfl5efcnmybvl75uxrwlknb7jzfzlwmmnc5ey",
 						"height": int64(1),
 					},
 				},
@@ -99,23 +99,23 @@ func TestQueryCommitsWithDockeyAndOrderHeightAsc(t *testing.T) {
 					}`,
 				Results: []map[string]any{
 					{
-						"cid":    "bafybeihxvx3f7eejvco6zbxsidoeuph6ywpbo33lrqm3picna2aj7pdeiu",
+						"cid":    "bafybeifulsbntzhikpilwjm6z657p6jvg2f6h2z5kkfmb5awzov5esumva",
 						"height": int64(1),
 					},
 					{
-						"cid":    "bafybeih25dvtgei2bryhlz24tbyfdcni5di7akgcx24pezxts27wz7v454",
+						"cid":    "bafybeiaxk7ycdik3avaerrmxk4l5i53e4t5h6kihyefco3ui2bewplnyd4",
 						"height": int64(1),
 					},
 					{
-						"cid":    "bafybeiapquwo7dfow7b7ovwrn3nl4e2cv2g5eoufuzylq54b4o6tatfrny",
+						"cid":    "bafybeigfgvalll75ic7wslfl5efcnmybvl75uxrwlknb7jzfzlwmmnc5ey",
 						"height": int64(1),
 					},
 					{
-						"cid":    "bafybeiepww5b67jrrliuiy27erfjuivwnjca5ptdpbxrrjrqkh3b2hckyy",
+						"cid":    "bafybeicpcai47yfc5bpieqnneedrhqt4geov6xz6cvhwumovajlpclt7pe",
 						"height": int64(2),
 					},
 					{
-						"cid":    "bafybeiebail45ch3n5rh7myumqn2jfeefnynba2ldwiesge3ddq5hu6olq",
+						"cid":    "bafybeifiauajzoniii6jfbvdhhlx34p3dbu6sihncekfdijn247vmff5dq",
 						"height": int64(2),
 					},
 				},
@@ -154,23 +154,23 @@ func TestQueryCommitsWithDockeyAndOrderCidDesc(t *testing.T) {
 					}`,
 				Results: []map[string]any{
 					{
-						"cid":    "bafybeihxvx3f7eejvco6zbxsidoeuph6ywpbo33lrqm3picna2aj7pdeiu",
+						"cid":    "bafybeigfgvalll75ic7wslfl5efcnmybvl75uxrwlknb7jzfzlwmmnc5ey",
 						"height": int64(1),
 					},
 					{
-						"cid":    "bafybeih25dvtgei2bryhlz24tbyfdcni5di7akgcx24pezxts27wz7v454",
+						"cid":    "bafybeifulsbntzhikpilwjm6z657p6jvg2f6h2z5kkfmb5awzov5esumva",
 						"height": int64(1),
 					},
 					{
-						"cid":    "bafybeiepww5b67jrrliuiy27erfjuivwnjca5ptdpbxrrjrqkh3b2hckyy",
+						"cid":    "bafybeifiauajzoniii6jfbvdhhlx34p3dbu6sihncekfdijn247vmff5dq",
 						"height": int64(2),
 					},
 					{
-						"cid":    "bafybeiebail45ch3n5rh7myumqn2jfeefnynba2ldwiesge3ddq5hu6olq",
+						"cid":    "bafybeicpcai47yfc5bpieqnneedrhqt4geov6xz6cvhwumovajlpclt7pe",
 						"height": int64(2),
 					},
 					{
-						"cid":    "bafybeiapquwo7dfow7b7ovwrn3nl4e2cv2g5eoufuzylq54b4o6tatfrny",
+						"cid":    "bafybeiaxk7ycdik3avaerrmxk4l5i53e4t5h6kihyefco3ui2bewplnyd4",
 						"height": int64(1),
 					},
 				},
@@ -209,23 +209,23 @@ func TestQueryCommitsWithDockeyAndOrderCidAsc(t *testing.T) {
 					}`,
 				Results: []map[string]any{
 					{
-						"cid":    "bafybeiapquwo7dfow7b7ovwrn3nl4e2cv2g5eoufuzylq54b4o6tatfrny",
+						"cid":    "bafybeiaxk7ycdik3avaerrmxk4l5i53e4t5h6kihyefco3ui2bewplnyd4",
 						"height": int64(1),
 					},
 					{
-						"cid":    "bafybeiebail45ch3n5rh7myumqn2jfeefnynba2ldwiesge3ddq5hu6olq",
+						"cid":    "bafybeicpcai47yfc5bpieqnneedrhqt4geov6xz6cvhwumovajlpclt7pe",
 						"height": int64(2),
 					},
 					{
-						"cid":    "bafybeiepww5b67jrrliuiy27erfjuivwnjca5ptdpbxrrjrqkh3b2hckyy",
+						"cid":    "bafybeifiauajzoniii6jfbvdhhlx34p3dbu6sihncekfdijn247vmff5dq",
 						"height": int64(2),
 					},
 					{
-						"cid":    "bafybeih25dvtgei2bryhlz24tbyfdcni5di7akgcx24pezxts27wz7v454",
+						"cid":    "bafybeifulsbntzhikpilwjm6z657p6jvg2f6h2z5kkfmb5awzov5esumva",
 						"height": int64(1),
 					},
 					{
-						"cid":    "bafybeihxvx3f7eejvco6zbxsidoeuph6ywpbo33lrqm3picna2aj7pdeiu",
+						"cid":    "bafybeigfgvalll75ic7wslfl5efcnmybvl75uxrwlknb7jzfzlwmmnc5ey",
 						"height": int64(1),
 					},
 				},
@@ -278,39 +278,39 @@ func TestQueryCommitsWithDockeyAndOrderAndMultiUpdatesCidAsc(t *testing.T) {
 					 }`,
 				Results: []map[string]any{
 					{
-						"cid":    "bafybeihxvx3f7eejvco6zbxsidoeuph6ywpbo33lrqm3picna2aj7pdeiu",
+						"cid":    "bafybeifulsbntzhikpilwjm6z657p6jvg2f6h2z5kkfmb5awzov5esumva",
 						"height": int64(1),
 					},
 					{
-						"cid":    "bafybeih25dvtgei2bryhlz24tbyfdcni5di7akgcx24pezxts27wz7v454",
+						"cid":    "bafybeiaxk7ycdik3avaerrmxk4l5i53e4t5h6kihyefco3ui2bewplnyd4",
 						"height": int64(1),
 					},
 					{
-						"cid":    "bafybeiapquwo7dfow7b7ovwrn3nl4e2cv2g5eoufuzylq54b4o6tatfrny",
+						"cid":    "bafybeigfgvalll75ic7wslfl5efcnmybvl75uxrwlknb7jzfzlwmmnc5ey",
 						"height": int64(1),
 					},
 					{
-						"cid":    "bafybeiepww5b67jrrliuiy27erfjuivwnjca5ptdpbxrrjrqkh3b2hckyy",
+						"cid":    "bafybeicpcai47yfc5bpieqnneedrhqt4geov6xz6cvhwumovajlpclt7pe",
 						"height": int64(2),
 					},
 					{
-						"cid":    "bafybeiebail45ch3n5rh7myumqn2jfeefnynba2ldwiesge3ddq5hu6olq",
+						"cid":    "bafybeifiauajzoniii6jfbvdhhlx34p3dbu6sihncekfdijn247vmff5dq",
 						"height": int64(2),
 					},
 					{
-						"cid":    "bafybeidag5ogxiqsctdxktobw6gbq52pbfkmicp6np5ccuy6pjyvgobdxq",
+						"cid":    "bafybeicjp6qzy3cfjhblpj4ofje7rrp6euq56omph5rxdvlbncx2xtuvza",
 						"height": int64(3),
 					},
 					{
-						"cid":    "bafybeid2arccjpsmmocarl6s3fe7ygstbdlpujlksuflukmun4bvf5g4oe",
+						"cid":    "bafybeib76zvkj2pjs5oygxuafsbjgsd3i6xort3b3lx73vxcm22qfvmusq",
 						"height": int64(3),
 					},
 					{
-						"cid":    "bafybeiadnlnuu3gkfaf5fgib3iqfsntitb4pjrc5hffvmhpdjqgsxutc5q",
+						"cid":    "bafybeifj3d6q5jhf7qxoarykymhxm4hmshbrocnj3tril2bu36rmyzteym",
 						"height": int64(4),
 					},
 					{
-						"cid":    "bafybeifyiqii323sy5xvqkltdaj5wb4p2zsotepcmv6zqxfr73lky24qw4",
+						"cid":    "bafybeidf47gjhjw2gaykrrgtbu6p5ex7n3wvimmdljhhd4bebptewh4i4q",
 						"height": int64(4),
 					},
 				},
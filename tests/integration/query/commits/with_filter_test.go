@@ -0,0 +1,202 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package commits
+
+import (
+	"testing"
+
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+func TestQueryCommitsWithFilterOnHeight(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple all commits query with filter on height",
+		Actions: []any{
+			updateUserCollectionSchema(),
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+						"Name":	"John",
+						"Age":	21
+					}`,
+			},
+			testUtils.UpdateDoc{
+				CollectionID: 0,
+				DocID:        0,
+				Doc: `{
+					"Age":	22
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+						commits(filter: {height: {_eq: 2}}) {
+							cid
+							height
+						}
+					}`,
+				Results: []map[string]any{
+					{
+						"cid":    "bafybeicpcai47yfc5bpieqnneedrhqt4geov6xz6cvhwumovajlpclt7pe",
+						"height": int64(2),
+					},
+					{
+						"cid":    "bafybeifiauajzoniii6jfbvdhhlx34p3dbu6sihncekfdijn247vmff5dq",
+						"height": int64(2),
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, []string{"users"}, test)
+}
+
+func TestQueryCommitsWithFilterOnMessage(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple all commits query with filter on message",
+		Actions: []any{
+			updateUserCollectionSchema(),
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+						"Name":	"John",
+						"Age":	21
+					}`,
+			},
+			testUtils.Request{
+				Request: `mutation {
+						update_users(id: "bae-52b9170d-b77a-5887-b877-cbdbb99b009f", data: "{\"Age\": 22}", message: "Birthday") {
+							_key
+						}
+					}`,
+				Results: []map[string]any{
+					{
+						"_key": "bae-52b9170d-b77a-5887-b877-cbdbb99b009f",
+					},
+				},
+			},
+			testUtils.Request{
+				Request: `query {
+						commits(filter: {message: {_eq: "Birthday"}}) {
+							height
+							author
+							message
+						}
+					}`,
+				Results: []map[string]any{
+					{
+						"height":  int64(2),
+						"author":  testUtils.CommitAuthor,
+						"message": "Birthday",
+					},
+					{
+						"height":  int64(2),
+						"author":  testUtils.CommitAuthor,
+						"message": "Birthday",
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, []string{"users"}, test)
+}
+
+func TestQueryCommitsWithFilterOnAuthorTime(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple all commits query with filter on author time, recorded on every commit",
+		Actions: []any{
+			updateUserCollectionSchema(),
+			testUtils.Request{
+				Request: `mutation {
+						create_users(data: "{\"Name\": \"John\", \"Age\": 21}", message: "Add John") {
+							_key
+						}
+					}`,
+				Results: []map[string]any{
+					{
+						"_key": "bae-52b9170d-b77a-5887-b877-cbdbb99b009f",
+					},
+				},
+			},
+			testUtils.Request{
+				Request: `mutation {
+						update_users(id: "bae-52b9170d-b77a-5887-b877-cbdbb99b009f", data: "{\"Age\": 22}") {
+							_key
+						}
+					}`,
+				Results: []map[string]any{
+					{
+						"_key": "bae-52b9170d-b77a-5887-b877-cbdbb99b009f",
+					},
+				},
+			},
+			testUtils.Request{
+				Request: `query {
+						commits(filter: {authorTime: {_gt: "2000-01-01T00:00:00Z"}}) {
+							height
+							message
+						}
+					}`,
+				Results: []map[string]any{
+					{
+						"height":  int64(2),
+						"message": nil,
+					},
+					{
+						"height":  int64(1),
+						"message": "Add John",
+					},
+					{
+						"height":  int64(1),
+						"message": "Add John",
+					},
+					{
+						"height":  int64(2),
+						"message": nil,
+					},
+					{
+						"height":  int64(1),
+						"message": "Add John",
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, []string{"users"}, test)
+}
+
+func TestQueryCommitsWithFilterWithoutMessage(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple all commits query with filter on message, commits without message",
+		Actions: []any{
+			updateUserCollectionSchema(),
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+						"Name":	"John",
+						"Age":	21
+					}`,
+			},
+			testUtils.Request{
+				Request: `query {
+						commits(filter: {message: {_ne: null}}) {
+							cid
+						}
+					}`,
+				Results: []map[string]any{},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, []string{"users"}, test)
+}
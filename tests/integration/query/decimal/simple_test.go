@@ -0,0 +1,501 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package decimal
+
+import (
+	"testing"
+
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+func TestQueryDecimalAndBigInt(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple query of Decimal and BigInt fields.",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Products {
+						Name: String
+						Price: Decimal
+						Stock: BigInt
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "Pen",
+					"Price": "1.10",
+					"Stock": "12345678901234567890123"
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "Book",
+					"Price": "19.99",
+					"Stock": 5
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "Lamp",
+					"Price": 0.1,
+					"Stock": "-3"
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "Cup"
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Products {
+						Name
+						Price
+						Stock
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"Name":  "Lamp",
+						"Price": "0.1",
+						"Stock": "-3",
+					},
+					{
+						"Name":  "Cup",
+						"Price": nil,
+						"Stock": nil,
+					},
+					{
+						"Name":  "Pen",
+						"Price": "1.1",
+						"Stock": "12345678901234567890123",
+					},
+					{
+						"Name":  "Book",
+						"Price": "19.99",
+						"Stock": "5",
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, []string{"Products"}, test)
+}
+
+func TestQueryDecimalAndBigIntWithFilter(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple query of Decimal and BigInt fields, filtering by their values.",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Products {
+						Name: String
+						Price: Decimal
+						Stock: BigInt
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "Pen",
+					"Price": "1.10",
+					"Stock": "12345678901234567890123"
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "Book",
+					"Price": "19.99",
+					"Stock": 5
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "Lamp",
+					"Price": 0.1,
+					"Stock": "-3"
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "Cup"
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Products(filter: {Price: {_gt: "1.1"}}) {
+						Name
+					}
+				}`,
+				Results: []map[string]any{
+					{"Name": "Book"},
+				},
+			},
+			testUtils.Request{
+				Request: `query {
+					Products(filter: {Price: {_eq: 0.1}}) {
+						Name
+					}
+				}`,
+				Results: []map[string]any{
+					{"Name": "Lamp"},
+				},
+			},
+			testUtils.Request{
+				Request: `query {
+					Products(filter: {Stock: {_ge: "12345678901234567890123"}}) {
+						Name
+					}
+				}`,
+				Results: []map[string]any{
+					{"Name": "Pen"},
+				},
+			},
+			testUtils.Request{
+				Request: `query {
+					Products(filter: {Stock: {_in: [5, -3]}}) {
+						Name
+					}
+				}`,
+				Results: []map[string]any{
+					{"Name": "Lamp"},
+					{"Name": "Book"},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, []string{"Products"}, test)
+}
+
+func TestQueryDecimalAndBigIntWithOrder(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple query of Decimal and BigInt fields, ordering by their values.",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Products {
+						Name: String
+						Price: Decimal
+						Stock: BigInt
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "Pen",
+					"Price": "1.10",
+					"Stock": "12345678901234567890123"
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "Book",
+					"Price": "19.99",
+					"Stock": 5
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "Lamp",
+					"Price": 0.1,
+					"Stock": "-3"
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "Cup"
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Products(order: {Price: ASC}) {
+						Name
+					}
+				}`,
+				Results: []map[string]any{
+					{"Name": "Cup"},
+					{"Name": "Lamp"},
+					{"Name": "Pen"},
+					{"Name": "Book"},
+				},
+			},
+			testUtils.Request{
+				Request: `query {
+					Products(order: {Stock: DESC}) {
+						Name
+					}
+				}`,
+				Results: []map[string]any{
+					{"Name": "Pen"},
+					{"Name": "Book"},
+					{"Name": "Lamp"},
+					{"Name": "Cup"},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, []string{"Products"}, test)
+}
+
+func TestQueryDecimalAndBigIntWithSumAndAverage(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple query of the exact sum and average of Decimal and BigInt fields.",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Products {
+						Name: String
+						Price: Decimal
+						Stock: BigInt
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "Pen",
+					"Price": "1.10",
+					"Stock": "12345678901234567890123"
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "Book",
+					"Price": "19.99",
+					"Stock": 5
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "Lamp",
+					"Price": 0.1,
+					"Stock": "-3"
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "Cup"
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					_sum(Products: {field: Price})
+				}`,
+				Results: []map[string]any{
+					{
+						"_sum": "21.19",
+					},
+				},
+			},
+			testUtils.Request{
+				Request: `query {
+					_avg(Products: {field: Price})
+				}`,
+				Results: []map[string]any{
+					{
+						"_avg": "7.0633333333333333333333333333333333",
+					},
+				},
+			},
+			testUtils.Request{
+				Request: `query {
+					_sum(Products: {field: Stock})
+				}`,
+				Results: []map[string]any{
+					{
+						"_sum": "12345678901234567890125",
+					},
+				},
+			},
+			testUtils.Request{
+				Request: `query {
+					_avg(Products: {field: Stock})
+				}`,
+				Results: []map[string]any{
+					{
+						"_avg": "4115226300411522630041.6666666666666666666666666666666667",
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, []string{"Products"}, test)
+}
+
+func TestQueryDecimalAndBigIntAfterUpdate(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple query of Decimal and BigInt fields after they are updated.",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Products {
+						Name: String
+						Price: Decimal
+						Stock: BigInt
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "Pen",
+					"Price": "1.10",
+					"Stock": "12345678901234567890123"
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "Book",
+					"Price": "19.99",
+					"Stock": 5
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "Lamp",
+					"Price": 0.1,
+					"Stock": "-3"
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "Cup"
+				}`,
+			},
+			testUtils.UpdateDoc{
+				DocID: 1,
+				Doc: `{
+					"Price": "2.50",
+					"Stock": "99999999999999999999"
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Products(filter: {Name: {_eq: "Book"}}) {
+						Price
+						Stock
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"Price": "2.5",
+						"Stock": "99999999999999999999",
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, []string{"Products"}, test)
+}
+
+func TestQueryDecimalWithInvalidValue(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple create of a Decimal field with a value that is not a decimal.",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Products {
+						Name: String
+						Price: Decimal
+						Stock: BigInt
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"Name": "Pen",
+					"Price": "1/3"
+				}`,
+				ExpectedError: "invalid decimal value",
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, []string{"Products"}, test)
+}
+
+func TestQueryDecimalWithSumOfIntArray(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple query of the exact sum of a Decimal field and an Int array.",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Shops {
+						Name: String
+						Sales: [Int!]
+						products: [Products]
+					}
+
+					type Products {
+						Name: String
+						Price: Decimal
+						shop: Shops
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				// bae-dd74b610-f8b9-59fd-bb80-0354559f51b5
+				Doc: `{
+					"Name": "Corner Shop",
+					"Sales": [9007199254740992, 1]
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 1,
+				Doc: `{
+					"Name": "Pen",
+					"Price": "0.5",
+					"shop_id": "bae-dd74b610-f8b9-59fd-bb80-0354559f51b5"
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Shops {
+						_sum(products: {field: Price}, Sales: {})
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						// The values of the Int array are summed exactly, as are the prices.
+						"_sum": "9007199254740993.5",
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, []string{"Shops", "Products"}, test)
+}
@@ -0,0 +1,230 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package defaults
+
+import (
+	"testing"
+
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+func TestQueryDefaultValuesWithCreate(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple query of fields given their default values on create.",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					enum Role {
+						ADMIN
+						MEMBER
+					}
+
+					type Users {
+						Name: String
+						Age: Int @default(value: 18)
+						Points: Float @default(value: 1.5)
+						Verified: Boolean @default(value: false)
+						Country: String @default(value: "Portugal")
+						Role: Role @default(value: MEMBER)
+						Tags: [String!] @default(value: ["new"])
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"Name": "John"
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Users {
+						Name
+						Age
+						Points
+						Verified
+						Country
+						Role
+						Tags
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"Name":     "John",
+						"Age":      uint64(18),
+						"Points":   1.5,
+						"Verified": false,
+						"Country":  "Portugal",
+						"Role":     "MEMBER",
+						"Tags":     []string{"new"},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, []string{"Users"}, test)
+}
+
+func TestQueryDefaultValuesWithCreateOfGivenValues(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple query of fields with default values, given values on create.",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					enum Role {
+						ADMIN
+						MEMBER
+					}
+
+					type Users {
+						Name: String
+						Age: Int @default(value: 18)
+						Points: Float @default(value: 1.5)
+						Verified: Boolean @default(value: false)
+						Country: String @default(value: "Portugal")
+						Role: Role @default(value: MEMBER)
+						Tags: [String!] @default(value: ["new"])
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"Name": "John",
+					"Age": 21,
+					"Verified": true,
+					"Country": "Spain",
+					"Role": "ADMIN"
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Users {
+						Name
+						Age
+						Points
+						Verified
+						Country
+						Role
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"Name":     "John",
+						"Age":      uint64(21),
+						"Points":   1.5,
+						"Verified": true,
+						"Country":  "Spain",
+						"Role":     "ADMIN",
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, []string{"Users"}, test)
+}
+
+func TestQueryDefaultValuesWithFilter(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple query of fields with default values, filtering by a default value.",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					enum Role {
+						ADMIN
+						MEMBER
+					}
+
+					type Users {
+						Name: String
+						Age: Int @default(value: 18)
+						Points: Float @default(value: 1.5)
+						Verified: Boolean @default(value: false)
+						Country: String @default(value: "Portugal")
+						Role: Role @default(value: MEMBER)
+						Tags: [String!] @default(value: ["new"])
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"Name": "John"
+				}`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"Name": "Islam",
+					"Age": 30
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Users(filter: {Age: {_eq: 18}}) {
+						Name
+					}
+				}`,
+				Results: []map[string]any{
+					{"Name": "John"},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, []string{"Users"}, test)
+}
+
+func TestQueryDefaultValuesDescription(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Introspection of a field with a default value.",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					enum Role {
+						ADMIN
+						MEMBER
+					}
+
+					type Users {
+						Name: String
+						Age: Int @default(value: 18)
+						Points: Float @default(value: 1.5)
+						Verified: Boolean @default(value: false)
+						Country: String @default(value: "Portugal")
+						Role: Role @default(value: MEMBER)
+						Tags: [String!] @default(value: ["new"])
+					}
+				`,
+			},
+			testUtils.IntrospectionRequest{
+				Request: `query {
+					__type(name: "Users") {
+						fields {
+							name
+							description
+						}
+					}
+				}`,
+				ContainsData: map[string]any{
+					"__type": map[string]any{
+						"fields": []any{
+							map[string]any{
+								"name":        "Country",
+								"description": "\nDefaults to \"Portugal\" if no value is given on create.\n",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, []string{"Users"}, test)
+}
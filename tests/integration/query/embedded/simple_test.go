@@ -0,0 +1,715 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package embedded
+
+import (
+	"testing"
+
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+func TestQueryEmbedded(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple query of an embedded object field.",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Geo @embedded {
+						Lat: Float
+						Lng: Float
+					}
+
+					type Address @embedded {
+						City: String
+						Street: String
+						Geo: Geo
+					}
+
+					type Users {
+						Name: String
+						Address: Address
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "John",
+					"Address": {
+						"City": "Lisbon",
+						"Street": "Rua Augusta",
+						"Geo": {
+							"Lat": 38.71,
+							"Lng": -9.14
+						}
+					}
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "Islam",
+					"Address": {
+						"City": "Berlin",
+						"Street": "Unter den Linden",
+						"Geo": {
+							"Lat": 52.52,
+							"Lng": 13.4
+						}
+					}
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "Fred"
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Users(order: {Name: ASC}) {
+						Name
+						Address {
+							City
+							Geo {
+								Lat
+							}
+						}
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"Name":    "Fred",
+						"Address": nil,
+					},
+					{
+						"Name": "Islam",
+						"Address": map[string]any{
+							"City": "Berlin",
+							"Geo": map[string]any{
+								"Lat": 52.52,
+							},
+						},
+					},
+					{
+						"Name": "John",
+						"Address": map[string]any{
+							"City": "Lisbon",
+							"Geo": map[string]any{
+								"Lat": 38.71,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, []string{"Users"}, test)
+}
+
+func TestQueryEmbeddedWithAlias(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple query of an embedded object field, with aliases.",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Geo @embedded {
+						Lat: Float
+						Lng: Float
+					}
+
+					type Address @embedded {
+						City: String
+						Street: String
+						Geo: Geo
+					}
+
+					type Users {
+						Name: String
+						Address: Address
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "John",
+					"Address": {
+						"City": "Lisbon",
+						"Street": "Rua Augusta",
+						"Geo": {
+							"Lat": 38.71,
+							"Lng": -9.14
+						}
+					}
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "Islam",
+					"Address": {
+						"City": "Berlin",
+						"Street": "Unter den Linden",
+						"Geo": {
+							"Lat": 52.52,
+							"Lng": 13.4
+						}
+					}
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "Fred"
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Users(filter: {Name: {_eq: "John"}}) {
+						home: Address {
+							town: City
+						}
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"home": map[string]any{
+							"town": "Lisbon",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, []string{"Users"}, test)
+}
+
+func TestQueryEmbeddedWithFilter(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple query of an embedded object field, filtering by its nested fields.",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Geo @embedded {
+						Lat: Float
+						Lng: Float
+					}
+
+					type Address @embedded {
+						City: String
+						Street: String
+						Geo: Geo
+					}
+
+					type Users {
+						Name: String
+						Address: Address
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "John",
+					"Address": {
+						"City": "Lisbon",
+						"Street": "Rua Augusta",
+						"Geo": {
+							"Lat": 38.71,
+							"Lng": -9.14
+						}
+					}
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "Islam",
+					"Address": {
+						"City": "Berlin",
+						"Street": "Unter den Linden",
+						"Geo": {
+							"Lat": 52.52,
+							"Lng": 13.4
+						}
+					}
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "Fred"
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Users(filter: {Address: {City: {_eq: "Lisbon"}}}) {
+						Name
+					}
+				}`,
+				Results: []map[string]any{
+					{"Name": "John"},
+				},
+			},
+			testUtils.Request{
+				Request: `query {
+					Users(filter: {Address: {Geo: {Lat: {_gt: 50}}}}) {
+						Name
+					}
+				}`,
+				Results: []map[string]any{
+					{"Name": "Islam"},
+				},
+			},
+			testUtils.Request{
+				Request: `query {
+					Users(
+						filter: {
+							Address: {_or: [{City: {_eq: "Lisbon"}}, {Street: {_eq: "Unter den Linden"}}]}
+						},
+						order: {Name: ASC}
+					) {
+						Name
+					}
+				}`,
+				Results: []map[string]any{
+					{"Name": "Islam"},
+					{"Name": "John"},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, []string{"Users"}, test)
+}
+
+func TestQueryEmbeddedWithOrder(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple query of an embedded object field, ordering by its nested fields.",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Geo @embedded {
+						Lat: Float
+						Lng: Float
+					}
+
+					type Address @embedded {
+						City: String
+						Street: String
+						Geo: Geo
+					}
+
+					type Users {
+						Name: String
+						Address: Address
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "John",
+					"Address": {
+						"City": "Lisbon",
+						"Street": "Rua Augusta",
+						"Geo": {
+							"Lat": 38.71,
+							"Lng": -9.14
+						}
+					}
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "Islam",
+					"Address": {
+						"City": "Berlin",
+						"Street": "Unter den Linden",
+						"Geo": {
+							"Lat": 52.52,
+							"Lng": 13.4
+						}
+					}
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "Fred"
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Users(order: {Address: {City: DESC}}) {
+						Name
+					}
+				}`,
+				Results: []map[string]any{
+					{"Name": "John"},
+					{"Name": "Islam"},
+					{"Name": "Fred"},
+				},
+			},
+			testUtils.Request{
+				Request: `query {
+					Users(order: {Address: {Geo: {Lng: ASC}}}) {
+						Name
+					}
+				}`,
+				Results: []map[string]any{
+					{"Name": "Fred"},
+					{"Name": "John"},
+					{"Name": "Islam"},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, []string{"Users"}, test)
+}
+
+func TestQueryEmbeddedWithUpdateOfNestedField(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Updating a nested field of an embedded object leaves its other fields unchanged.",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Geo @embedded {
+						Lat: Float
+						Lng: Float
+					}
+
+					type Address @embedded {
+						City: String
+						Street: String
+						Geo: Geo
+					}
+
+					type Users {
+						Name: String
+						Address: Address
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "John",
+					"Address": {
+						"City": "Lisbon",
+						"Street": "Rua Augusta",
+						"Geo": {
+							"Lat": 38.71,
+							"Lng": -9.14
+						}
+					}
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "Islam",
+					"Address": {
+						"City": "Berlin",
+						"Street": "Unter den Linden",
+						"Geo": {
+							"Lat": 52.52,
+							"Lng": 13.4
+						}
+					}
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "Fred"
+				}`,
+			},
+			testUtils.UpdateDoc{
+				DocID: 0,
+				Doc: `{
+					"Address": {
+						"City": "Porto"
+					}
+				}`,
+			},
+			testUtils.UpdateDoc{
+				DocID: 0,
+				Doc: `{
+					"Address": {
+						"Geo": {
+							"Lng": -8.61
+						}
+					}
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Users(filter: {Name: {_eq: "John"}}) {
+						Address {
+							City
+							Street
+							Geo {
+								Lat
+								Lng
+							}
+						}
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"Address": map[string]any{
+							"City":   "Porto",
+							"Street": "Rua Augusta",
+							"Geo": map[string]any{
+								"Lat": 38.71,
+								"Lng": -8.61,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, []string{"Users"}, test)
+}
+
+func TestQueryEmbeddedWithUpdateToNull(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Updating an embedded object field to null removes the object.",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Geo @embedded {
+						Lat: Float
+						Lng: Float
+					}
+
+					type Address @embedded {
+						City: String
+						Street: String
+						Geo: Geo
+					}
+
+					type Users {
+						Name: String
+						Address: Address
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "John",
+					"Address": {
+						"City": "Lisbon",
+						"Street": "Rua Augusta",
+						"Geo": {
+							"Lat": 38.71,
+							"Lng": -9.14
+						}
+					}
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "Islam",
+					"Address": {
+						"City": "Berlin",
+						"Street": "Unter den Linden",
+						"Geo": {
+							"Lat": 52.52,
+							"Lng": 13.4
+						}
+					}
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "Fred"
+				}`,
+			},
+			testUtils.UpdateDoc{
+				DocID: 0,
+				Doc: `{
+					"Address": null
+				}`,
+			},
+			testUtils.UpdateDoc{
+				DocID: 0,
+				Doc: `{
+					"Address": {
+						"City": "Porto"
+					}
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Users(filter: {Name: {_eq: "John"}}) {
+						Address {
+							City
+							Street
+						}
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"Address": map[string]any{
+							"City":   "Porto",
+							"Street": nil,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, []string{"Users"}, test)
+}
+
+func TestQueryEmbeddedWithUpdateMutation(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Updating a nested field of an embedded object with an update mutation.",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Geo @embedded {
+						Lat: Float
+						Lng: Float
+					}
+
+					type Address @embedded {
+						City: String
+						Street: String
+						Geo: Geo
+					}
+
+					type Users {
+						Name: String
+						Address: Address
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "John",
+					"Address": {
+						"City": "Lisbon",
+						"Street": "Rua Augusta",
+						"Geo": {
+							"Lat": 38.71,
+							"Lng": -9.14
+						}
+					}
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "Islam",
+					"Address": {
+						"City": "Berlin",
+						"Street": "Unter den Linden",
+						"Geo": {
+							"Lat": 52.52,
+							"Lng": 13.4
+						}
+					}
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "Fred"
+				}`,
+			},
+			testUtils.Request{
+				Request: `mutation {
+					update_Users(
+						filter: {Address: {City: {_eq: "Berlin"}}},
+						data: "{\"Address\": {\"Street\": \"Friedrichstrasse\"}}"
+					) {
+						Name
+						Address {
+							City
+							Street
+						}
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"Name": "Islam",
+						"Address": map[string]any{
+							"City":   "Berlin",
+							"Street": "Friedrichstrasse",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, []string{"Users"}, test)
+}
+
+func TestQueryEmbeddedWithInvalidValue(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Embedded object fields may only be set to objects.",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Geo @embedded {
+						Lat: Float
+						Lng: Float
+					}
+
+					type Address @embedded {
+						City: String
+						Street: String
+						Geo: Geo
+					}
+
+					type Users {
+						Name: String
+						Address: Address
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"Name": "John",
+					"Address": "Lisbon"
+				}`,
+				ExpectedError: "embedded object fields may only be set to objects or null",
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"Name": "John",
+					"Address": {
+						"Country": "Portugal"
+					}
+				}`,
+				ExpectedError: "The given field does not exist. Name: Address.Country",
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, []string{"Users"}, test)
+}
@@ -0,0 +1,457 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package enum
+
+import (
+	"testing"
+
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+func TestQueryEnum(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple query of an enum field.",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					enum Status {
+						OPEN
+						CLOSED
+						BLOCKED
+					}
+
+					type Tickets {
+						Title: String
+						Status: Status
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Title": "Fix login",
+					"Status": "OPEN"
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Title": "Write docs",
+					"Status": "CLOSED"
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Title": "Upgrade deps",
+					"Status": "BLOCKED"
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Tickets {
+						Title
+						Status
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"Title":  "Write docs",
+						"Status": "CLOSED",
+					},
+					{
+						"Title":  "Upgrade deps",
+						"Status": "BLOCKED",
+					},
+					{
+						"Title":  "Fix login",
+						"Status": "OPEN",
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, []string{"Tickets"}, test)
+}
+
+func TestQueryEnumWithFilter(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple query of an enum field, filtering by its value.",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					enum Status {
+						OPEN
+						CLOSED
+						BLOCKED
+					}
+
+					type Tickets {
+						Title: String
+						Status: Status
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Title": "Fix login",
+					"Status": "OPEN"
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Title": "Write docs",
+					"Status": "CLOSED"
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Title": "Upgrade deps",
+					"Status": "BLOCKED"
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Tickets(filter: {Status: {_eq: OPEN}}) {
+						Title
+					}
+				}`,
+				Results: []map[string]any{
+					{"Title": "Fix login"},
+				},
+			},
+			testUtils.Request{
+				Request: `query {
+					Tickets(filter: {Status: {_nin: [OPEN, BLOCKED]}}) {
+						Title
+					}
+				}`,
+				Results: []map[string]any{
+					{"Title": "Write docs"},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, []string{"Tickets"}, test)
+}
+
+func TestQueryEnumWithFilterOfUnknownValue(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple query of an enum field, filtering by a value not of its enum.",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					enum Status {
+						OPEN
+						CLOSED
+						BLOCKED
+					}
+
+					type Tickets {
+						Title: String
+						Status: Status
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Title": "Fix login",
+					"Status": "OPEN"
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Title": "Write docs",
+					"Status": "CLOSED"
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Title": "Upgrade deps",
+					"Status": "BLOCKED"
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Tickets(filter: {Status: {_eq: DONE}}) {
+						Title
+					}
+				}`,
+				ExpectedError: "Argument \"filter\" has invalid value {Status: {_eq: DONE}}.",
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, []string{"Tickets"}, test)
+}
+
+func TestQueryEnumWithOrder(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple query of an enum field, ordering by the names of its values.",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					enum Status {
+						OPEN
+						CLOSED
+						BLOCKED
+					}
+
+					type Tickets {
+						Title: String
+						Status: Status
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Title": "Fix login",
+					"Status": "OPEN"
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Title": "Write docs",
+					"Status": "CLOSED"
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Title": "Upgrade deps",
+					"Status": "BLOCKED"
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Tickets(order: {Status: ASC}) {
+						Status
+					}
+				}`,
+				Results: []map[string]any{
+					{"Status": "BLOCKED"},
+					{"Status": "CLOSED"},
+					{"Status": "OPEN"},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, []string{"Tickets"}, test)
+}
+
+func TestQueryEnumWithCreateOfUnknownValue(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple create of an enum field with a value not of its enum.",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					enum Status {
+						OPEN
+						CLOSED
+						BLOCKED
+					}
+
+					type Tickets {
+						Title: String
+						Status: Status
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"Title": "Fix login",
+					"Status": "DONE"
+				}`,
+				ExpectedError: "value is not one of the values of the field's enum",
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, []string{"Tickets"}, test)
+}
+
+func TestQueryEnumWithUpdate(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple update of an enum field.",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					enum Status {
+						OPEN
+						CLOSED
+						BLOCKED
+					}
+
+					type Tickets {
+						Title: String
+						Status: Status
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Title": "Fix login",
+					"Status": "OPEN"
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Title": "Write docs",
+					"Status": "CLOSED"
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Title": "Upgrade deps",
+					"Status": "BLOCKED"
+				}`,
+			},
+			testUtils.UpdateDoc{
+				DocID: 0,
+				Doc: `{
+					"Status": "CLOSED"
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Tickets(filter: {Status: {_eq: CLOSED}}) {
+						Title
+					}
+				}`,
+				Results: []map[string]any{
+					{"Title": "Write docs"},
+					{"Title": "Fix login"},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, []string{"Tickets"}, test)
+}
+
+func TestQueryEnumWithUpdateOfUnknownValue(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple update of an enum field with a value not of its enum.",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					enum Status {
+						OPEN
+						CLOSED
+						BLOCKED
+					}
+
+					type Tickets {
+						Title: String
+						Status: Status
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Title": "Fix login",
+					"Status": "OPEN"
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Title": "Write docs",
+					"Status": "CLOSED"
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Title": "Upgrade deps",
+					"Status": "BLOCKED"
+				}`,
+			},
+			testUtils.UpdateDoc{
+				DocID: 0,
+				Doc: `{
+					"Status": "DONE"
+				}`,
+				ExpectedError: "value is not one of the values of the field's enum",
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, []string{"Tickets"}, test)
+}
+
+func TestQueryEnumWithUpdateWithFilterOfUnknownValue(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple update by filter of an enum field with a value not of its enum.",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					enum Status {
+						OPEN
+						CLOSED
+						BLOCKED
+					}
+
+					type Tickets {
+						Title: String
+						Status: Status
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Title": "Fix login",
+					"Status": "OPEN"
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Title": "Write docs",
+					"Status": "CLOSED"
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Title": "Upgrade deps",
+					"Status": "BLOCKED"
+				}`,
+			},
+			testUtils.Request{
+				Request: `mutation {
+					update_Tickets(filter: {Status: {_eq: OPEN}}, data: "{\"Status\": \"DONE\"}") {
+						Title
+					}
+				}`,
+				ExpectedError: "value is not one of the values of the field's enum",
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, []string{"Tickets"}, test)
+}
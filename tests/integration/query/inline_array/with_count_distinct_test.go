@@ -0,0 +1,129 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package inline_array
+
+import (
+	"testing"
+
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+func TestQueryInlineIntegerArrayWithCountDistinct(t *testing.T) {
+	test := testUtils.RequestTestCase{
+		Description: "Simple inline array, count distinct of integer array",
+		Request: `query {
+					users {
+						Name
+						_count(FavouriteIntegers: {distinct: true})
+					}
+				}`,
+		Docs: map[int][]string{
+			0: {
+				`{
+					"Name": "Shahzad",
+					"FavouriteIntegers": [-1, 2, -1, 1, 2, 0]
+				}`,
+			},
+		},
+		Results: []map[string]any{
+			{
+				"Name":   "Shahzad",
+				"_count": 4,
+			},
+		},
+	}
+
+	executeTestCase(t, test)
+}
+
+func TestQueryInlineIntegerArrayWithCountDistinctFalse(t *testing.T) {
+	test := testUtils.RequestTestCase{
+		Description: "Simple inline array, count with distinct false of integer array",
+		Request: `query {
+					users {
+						Name
+						_count(FavouriteIntegers: {distinct: false})
+					}
+				}`,
+		Docs: map[int][]string{
+			0: {
+				`{
+					"Name": "Shahzad",
+					"FavouriteIntegers": [-1, 2, -1, 1, 2, 0]
+				}`,
+			},
+		},
+		Results: []map[string]any{
+			{
+				"Name":   "Shahzad",
+				"_count": 6,
+			},
+		},
+	}
+
+	executeTestCase(t, test)
+}
+
+func TestQueryInlineNillableStringArrayWithCountDistinctWithFilterAndLimit(t *testing.T) {
+	test := testUtils.RequestTestCase{
+		Description: "Simple inline array, count distinct of nillable string array with filter and limit",
+		Request: `query {
+					users {
+						Name
+						_count(PageHeaders: {distinct: true, filter: {_ne: "empty"}, limit: 3})
+					}
+				}`,
+		Docs: map[int][]string{
+			0: {
+				`{
+					"Name": "Shahzad",
+					"PageHeaders": ["the page", null, "empty", "the page", null, "a page", "last page"]
+				}`,
+			},
+		},
+		Results: []map[string]any{
+			{
+				"Name":   "Shahzad",
+				"_count": 3,
+			},
+		},
+	}
+
+	executeTestCase(t, test)
+}
+
+func TestQueryInlineNillableStringArrayWithCountDistinctIncludesNull(t *testing.T) {
+	test := testUtils.RequestTestCase{
+		Description: "Simple inline array, count distinct of nillable string array counts null once",
+		Request: `query {
+					users {
+						Name
+						_count(PageHeaders: {distinct: true})
+					}
+				}`,
+		Docs: map[int][]string{
+			0: {
+				`{
+					"Name": "Shahzad",
+					"PageHeaders": ["the page", null, "the page", null]
+				}`,
+			},
+		},
+		Results: []map[string]any{
+			{
+				"Name":   "Shahzad",
+				"_count": 2,
+			},
+		},
+	}
+
+	executeTestCase(t, test)
+}
@@ -0,0 +1,183 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package inline_array
+
+import (
+	"testing"
+
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+func TestQueryInlineArrayWithAnyFilter(t *testing.T) {
+	test := testUtils.RequestTestCase{
+		Description: "Simple inline array with any filter",
+		Request: `query {
+					users(filter: {PreferredStrings: {_any: {_eq: "urgent"}}}) {
+						Name
+					}
+				}`,
+		Docs: map[int][]string{
+			0: {
+				`{
+					"Name": "John",
+					"FavouriteIntegers": [1, 2, 3],
+					"PreferredStrings": ["urgent", "later"],
+					"PageHeaders": ["Overview", null]
+				}`,
+				`{
+					"Name": "Shahzad",
+					"FavouriteIntegers": [5, 7],
+					"PreferredStrings": [],
+					"PageHeaders": ["Summary"]
+				}`,
+			},
+		},
+		Results: []map[string]any{
+			{
+				"Name": "John",
+			},
+		},
+	}
+
+	executeTestCase(t, test)
+}
+
+func TestQueryInlineArrayWithAllFilter(t *testing.T) {
+	test := testUtils.RequestTestCase{
+		Description: "Simple inline array with all filter",
+		Request: `query {
+					users(filter: {FavouriteIntegers: {_all: {_gt: 4}}}) {
+						Name
+					}
+				}`,
+		Docs: map[int][]string{
+			0: {
+				`{
+					"Name": "John",
+					"FavouriteIntegers": [1, 2, 3],
+					"PreferredStrings": ["urgent", "later"],
+					"PageHeaders": ["Overview", null]
+				}`,
+				`{
+					"Name": "Shahzad",
+					"FavouriteIntegers": [5, 7],
+					"PreferredStrings": [],
+					"PageHeaders": ["Summary"]
+				}`,
+			},
+		},
+		Results: []map[string]any{
+			{
+				"Name": "Shahzad",
+			},
+		},
+	}
+
+	executeTestCase(t, test)
+}
+
+func TestQueryInlineArrayWithAllFilterOnEmptyArray(t *testing.T) {
+	test := testUtils.RequestTestCase{
+		Description: "Simple inline array with all filter, empty array",
+		Request: `query {
+					users(filter: {PreferredStrings: {_all: {_eq: "urgent"}}}) {
+						Name
+					}
+				}`,
+		Docs: map[int][]string{
+			0: {
+				`{
+					"Name": "John",
+					"FavouriteIntegers": [1, 2, 3],
+					"PreferredStrings": ["urgent", "later"],
+					"PageHeaders": ["Overview", null]
+				}`,
+				`{
+					"Name": "Shahzad",
+					"FavouriteIntegers": [5, 7],
+					"PreferredStrings": [],
+					"PageHeaders": ["Summary"]
+				}`,
+			},
+		},
+		Results: []map[string]any{
+			{
+				"Name": "Shahzad",
+			},
+		},
+	}
+
+	executeTestCase(t, test)
+}
+
+func TestQueryInlineArrayWithNoneFilter(t *testing.T) {
+	test := testUtils.RequestTestCase{
+		Description: "Simple inline array with none filter",
+		Request: `query {
+					users(filter: {FavouriteIntegers: {_none: {_in: [2, 7]}}}) {
+						Name
+					}
+				}`,
+		Docs: map[int][]string{
+			0: {
+				`{
+					"Name": "John",
+					"FavouriteIntegers": [1, 2, 3],
+					"PreferredStrings": ["urgent", "later"],
+					"PageHeaders": ["Overview", null]
+				}`,
+				`{
+					"Name": "Shahzad",
+					"FavouriteIntegers": [5, 7],
+					"PreferredStrings": [],
+					"PageHeaders": ["Summary"]
+				}`,
+			},
+		},
+		Results: []map[string]any{},
+	}
+
+	executeTestCase(t, test)
+}
+
+func TestQueryInlineArrayWithAnyFilterOnNillableItems(t *testing.T) {
+	test := testUtils.RequestTestCase{
+		Description: "Simple inline array with any filter, nillable items",
+		Request: `query {
+					users(filter: {PageHeaders: {_any: {_eq: null}}}) {
+						Name
+					}
+				}`,
+		Docs: map[int][]string{
+			0: {
+				`{
+					"Name": "John",
+					"FavouriteIntegers": [1, 2, 3],
+					"PreferredStrings": ["urgent", "later"],
+					"PageHeaders": ["Overview", null]
+				}`,
+				`{
+					"Name": "Shahzad",
+					"FavouriteIntegers": [5, 7],
+					"PreferredStrings": [],
+					"PageHeaders": ["Summary"]
+				}`,
+			},
+		},
+		Results: []map[string]any{
+			{
+				"Name": "John",
+			},
+		},
+	}
+
+	executeTestCase(t, test)
+}
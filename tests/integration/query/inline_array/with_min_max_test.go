@@ -0,0 +1,197 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package inline_array
+
+import (
+	"testing"
+
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+func TestQueryInlineIntegerArrayWithMinMaxAndNullArray(t *testing.T) {
+	test := testUtils.RequestTestCase{
+		Description: "Simple inline array with no filter, min and max of nil integer array",
+		Request: `query {
+					users {
+						Name
+						_min(FavouriteIntegers: {})
+						_max(FavouriteIntegers: {})
+					}
+				}`,
+		Docs: map[int][]string{
+			0: {
+				`{
+					"Name": "John",
+					"FavouriteIntegers": null
+				}`,
+			},
+		},
+		Results: []map[string]any{
+			{
+				"Name": "John",
+				"_min": nil,
+				"_max": nil,
+			},
+		},
+	}
+
+	executeTestCase(t, test)
+}
+
+func TestQueryInlineIntegerArrayWithMinMaxAndPopulatedArray(t *testing.T) {
+	test := testUtils.RequestTestCase{
+		Description: "Simple inline array with no filter, min and max of integer array",
+		Request: `query {
+					users {
+						Name
+						_min(FavouriteIntegers: {})
+						_max(FavouriteIntegers: {})
+					}
+				}`,
+		Docs: map[int][]string{
+			0: {
+				`{
+					"Name": "Shahzad",
+					"FavouriteIntegers": [-1, 2, -1, 1, 0, 7]
+				}`,
+			},
+		},
+		Results: []map[string]any{
+			{
+				"Name": "Shahzad",
+				"_min": int64(-1),
+				"_max": int64(7),
+			},
+		},
+	}
+
+	executeTestCase(t, test)
+}
+
+func TestQueryInlineNillableIntegerArrayWithMinMaxAndPopulatedArray(t *testing.T) {
+	test := testUtils.RequestTestCase{
+		Description: "Simple inline array with no filter, min and max of nillable integer array",
+		Request: `query {
+					users {
+						Name
+						_min(TestScores: {})
+						_max(TestScores: {})
+					}
+				}`,
+		Docs: map[int][]string{
+			0: {
+				`{
+					"Name": "Shahzad",
+					"TestScores": [-1, null, 13, 0]
+				}`,
+			},
+		},
+		Results: []map[string]any{
+			{
+				"Name": "Shahzad",
+				"_min": int64(-1),
+				"_max": int64(13),
+			},
+		},
+	}
+
+	executeTestCase(t, test)
+}
+
+func TestQueryInlineFloatArrayWithMinMaxWithFilter(t *testing.T) {
+	test := testUtils.RequestTestCase{
+		Description: "Simple inline array, min and max of float array with filter",
+		Request: `query {
+					users {
+						Name
+						_min(FavouriteFloats: {filter: {_gt: 0}})
+						_max(FavouriteFloats: {filter: {_lt: 3}})
+					}
+				}`,
+		Docs: map[int][]string{
+			0: {
+				`{
+					"Name": "Shahzad",
+					"FavouriteFloats": [3.1425, -0.00000000001, 2.718, 0.5]
+				}`,
+			},
+		},
+		Results: []map[string]any{
+			{
+				"Name": "Shahzad",
+				"_min": 0.5,
+				"_max": 2.718,
+			},
+		},
+	}
+
+	executeTestCase(t, test)
+}
+
+func TestQueryInlineStringArrayWithMinMaxWithOrderAndLimit(t *testing.T) {
+	test := testUtils.RequestTestCase{
+		Description: "Simple inline array, min and max of string array with order and limit",
+		Request: `query {
+					users {
+						Name
+						_min(PreferredStrings: {})
+						_max(PreferredStrings: {order: ASC, limit: 2})
+					}
+				}`,
+		Docs: map[int][]string{
+			0: {
+				`{
+					"Name": "Shahzad",
+					"PreferredStrings": ["pears", "apples", "oranges", "bananas"]
+				}`,
+			},
+		},
+		Results: []map[string]any{
+			{
+				"Name": "Shahzad",
+				"_min": "apples",
+				"_max": "bananas",
+			},
+		},
+	}
+
+	executeTestCase(t, test)
+}
+
+func TestQueryInlineNillableStringArrayWithMinMax(t *testing.T) {
+	test := testUtils.RequestTestCase{
+		Description: "Simple inline array, min and max of nillable string array",
+		Request: `query {
+					users {
+						Name
+						_min(PageHeaders: {})
+						_max(PageHeaders: {})
+					}
+				}`,
+		Docs: map[int][]string{
+			0: {
+				`{
+					"Name": "Shahzad",
+					"PageHeaders": ["the page", null, "empty", "a page"]
+				}`,
+			},
+		},
+		Results: []map[string]any{
+			{
+				"Name": "Shahzad",
+				"_min": "a page",
+				"_max": "the page",
+			},
+		},
+	}
+
+	executeTestCase(t, test)
+}
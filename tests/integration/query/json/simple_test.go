@@ -0,0 +1,384 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package json
+
+import (
+	"testing"
+
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+func TestQueryJSON(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple query of a JSON field.",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						Name: String
+						Meta: JSON
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "John",
+					"Meta": {"a": {"b": 3}, "tags": ["x", "y"]}
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "Bob",
+					"Meta": {"a": {"b": 4.5}, "tags": ["y"]}
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "Alice",
+					"Meta": "plain"
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "Fred"
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Users {
+						Name
+						Meta
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"Name": "Fred",
+						"Meta": nil,
+					},
+					{
+						"Name": "Bob",
+						"Meta": map[string]any{
+							"a":    map[string]any{"b": 4.5},
+							"tags": []any{"y"},
+						},
+					},
+					{
+						"Name": "Alice",
+						"Meta": "plain",
+					},
+					{
+						"Name": "John",
+						"Meta": map[string]any{
+							"a":    map[string]any{"b": int64(3)},
+							"tags": []any{"x", "y"},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, []string{"Users"}, test)
+}
+
+func TestQueryJSONWithPathFilter(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple query of a JSON field, filtering by the value at a path.",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						Name: String
+						Meta: JSON
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "John",
+					"Meta": {"a": {"b": 3}, "tags": ["x", "y"]}
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "Bob",
+					"Meta": {"a": {"b": 4.5}, "tags": ["y"]}
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "Alice",
+					"Meta": "plain"
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "Fred"
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Users(filter: {Meta: {_path: "a.b", _eq: 3}}) {
+						Name
+					}
+				}`,
+				Results: []map[string]any{
+					{"Name": "John"},
+				},
+			},
+			testUtils.Request{
+				Request: `query {
+					Users(filter: {Meta: {_path: "a.b", _gt: 3}}) {
+						Name
+					}
+				}`,
+				Results: []map[string]any{
+					{"Name": "Bob"},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, []string{"Users"}, test)
+}
+
+func TestQueryJSONWithPathFilterIndexingArray(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple query of a JSON field, filtering by the value at a path into an array.",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						Name: String
+						Meta: JSON
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "John",
+					"Meta": {"a": {"b": 3}, "tags": ["x", "y"]}
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "Bob",
+					"Meta": {"a": {"b": 4.5}, "tags": ["y"]}
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "Alice",
+					"Meta": "plain"
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "Fred"
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Users(filter: {Meta: {_path: "tags.0", _eq: "y"}}) {
+						Name
+					}
+				}`,
+				Results: []map[string]any{
+					{"Name": "Bob"},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, []string{"Users"}, test)
+}
+
+func TestQueryJSONWithPathFilterOnMissingPath(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple query of a JSON field, filtering by the nil value at a missing path.",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						Name: String
+						Meta: JSON
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "John",
+					"Meta": {"a": {"b": 3}, "tags": ["x", "y"]}
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "Bob",
+					"Meta": {"a": {"b": 4.5}, "tags": ["y"]}
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "Alice",
+					"Meta": "plain"
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "Fred"
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Users(filter: {Meta: {_path: "a.c", _ne: null}}) {
+						Name
+					}
+				}`,
+				Results: []map[string]any{},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, []string{"Users"}, test)
+}
+
+func TestQueryJSONWithFilterOnObject(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple query of a JSON field, filtering by an object value.",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						Name: String
+						Meta: JSON
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "John",
+					"Meta": {"a": {"b": 3}, "tags": ["x", "y"]}
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "Bob",
+					"Meta": {"a": {"b": 4.5}, "tags": ["y"]}
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "Alice",
+					"Meta": "plain"
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "Fred"
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Users(filter: {Meta: {_path: "a", _eq: {b: 3}}}) {
+						Name
+					}
+				}`,
+				Results: []map[string]any{
+					{"Name": "John"},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, []string{"Users"}, test)
+}
+
+func TestQueryJSONAfterUpdate(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple query of a JSON field after it is updated with an object.",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						Name: String
+						Meta: JSON
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "John",
+					"Meta": {"a": {"b": 3}, "tags": ["x", "y"]}
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "Bob",
+					"Meta": {"a": {"b": 4.5}, "tags": ["y"]}
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "Alice",
+					"Meta": "plain"
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "Fred"
+				}`,
+			},
+			testUtils.UpdateDoc{
+				DocID: 2,
+				Doc: `{
+					"Meta": {"a": {"b": 3}}
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Users(filter: {Meta: {_path: "a.b", _eq: 3}}) {
+						Name
+					}
+				}`,
+				Results: []map[string]any{
+					{"Name": "Alice"},
+					{"Name": "John"},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, []string{"Users"}, test)
+}
@@ -0,0 +1,198 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package one_to_many
+
+import (
+	"testing"
+
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+var distinctBookAuthorDocs = map[int][]string{
+	//books
+	0: { // bae-fd541c25-229e-5280-b44b-e5c2af3e374d
+		`{
+			"name": "Painted House",
+			"rating": 4.9,
+			"author_id": "bae-41598f0c-19bc-5da6-813b-e80f14a10df3"
+		}`,
+		`{
+			"name": "A Time for Mercy",
+			"rating": 4.5,
+			"author_id": "bae-41598f0c-19bc-5da6-813b-e80f14a10df3"
+		}`,
+		`{
+			"name": "The Associate",
+			"rating": 4.5,
+			"author_id": "bae-41598f0c-19bc-5da6-813b-e80f14a10df3"
+		}`,
+		`{
+			"name": "Theif Lord",
+			"rating": 4.8,
+			"author_id": "bae-b769708d-f552-5c3d-a402-ccfd7ac7fb04"
+		}`,
+	},
+	//authors
+	1: {
+		// bae-41598f0c-19bc-5da6-813b-e80f14a10df3
+		`{
+			"name": "John Grisham",
+			"age": 65,
+			"verified": true
+		}`,
+		// bae-b769708d-f552-5c3d-a402-ccfd7ac7fb04
+		`{
+			"name": "Cornelia Funke",
+			"age": 62,
+			"verified": false
+		}`,
+	},
+}
+
+func TestQueryOneToManyWithChildDistinct(t *testing.T) {
+	test := testUtils.RequestTestCase{
+		Description: "One-to-many relation query from the one side with distinct on the child select",
+		Request: `query {
+				author {
+					name
+					published(distinct: [rating], order: {rating: DESC}) {
+						rating
+					}
+				}
+			}`,
+		Docs: distinctBookAuthorDocs,
+		Results: []map[string]any{
+			{
+				"name": "John Grisham",
+				"published": []map[string]any{
+					{
+						"rating": 4.9,
+					},
+					{
+						"rating": 4.5,
+					},
+				},
+			},
+			{
+				"name": "Cornelia Funke",
+				"published": []map[string]any{
+					{
+						"rating": 4.8,
+					},
+				},
+			},
+		},
+	}
+
+	executeTestCase(t, test)
+}
+
+func TestQueryOneToManyWithCountDistinct(t *testing.T) {
+	test := testUtils.RequestTestCase{
+		Description: "One-to-many relation query from the one side with count distinct",
+		Request: `query {
+				author {
+					name
+					_count(published: {distinct: [rating]})
+				}
+			}`,
+		Docs: distinctBookAuthorDocs,
+		Results: []map[string]any{
+			{
+				"name":   "John Grisham",
+				"_count": 2,
+			},
+			{
+				"name":   "Cornelia Funke",
+				"_count": 1,
+			},
+		},
+	}
+
+	executeTestCase(t, test)
+}
+
+func TestQueryOneToManyWithCountDistinctAndRenderedChild(t *testing.T) {
+	test := testUtils.RequestTestCase{
+		Description: "One-to-many relation query from the one side with count distinct alongside the non-distinct child",
+		Request: `query {
+				author {
+					name
+					_count(published: {distinct: [rating]})
+					published {
+						name
+					}
+				}
+			}`,
+		Docs: map[int][]string{
+			//books
+			0: distinctBookAuthorDocs[0][:3],
+			//authors
+			1: distinctBookAuthorDocs[1][:1],
+		},
+		Results: []map[string]any{
+			{
+				"name":   "John Grisham",
+				"_count": 2,
+				"published": []map[string]any{
+					{
+						"name": "The Associate",
+					},
+					{
+						"name": "Painted House",
+					},
+					{
+						"name": "A Time for Mercy",
+					},
+				},
+			},
+		},
+	}
+
+	executeTestCase(t, test)
+}
+
+func TestQueryOneToManyWithDistinctOnRelation(t *testing.T) {
+	test := testUtils.RequestTestCase{
+		Description: "One-to-many relation query from the many side with distinct on the related object",
+		Request: `query {
+				book(distinct: [author]) {
+					name
+				}
+			}`,
+		Docs:          distinctBookAuthorDocs,
+		ExpectedError: "distinct may only be applied to fields of the selected type",
+	}
+
+	executeTestCase(t, test)
+}
+
+func TestQueryOneToManyWithDistinctOnRelationID(t *testing.T) {
+	test := testUtils.RequestTestCase{
+		Description: "One-to-many relation query from the many side with distinct on the relation id",
+		Request: `query {
+				book(distinct: [author_id], order: {name: ASC}) {
+					name
+				}
+			}`,
+		Docs: distinctBookAuthorDocs,
+		Results: []map[string]any{
+			{
+				"name": "A Time for Mercy",
+			},
+			{
+				"name": "Theif Lord",
+			},
+		},
+	}
+
+	executeTestCase(t, test)
+}
@@ -0,0 +1,142 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package one_to_many
+
+import (
+	"testing"
+
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+var minMaxBookAuthorDocs = map[int][]string{
+	//books
+	0: { // bae-fd541c25-229e-5280-b44b-e5c2af3e374d
+		`{
+			"name": "Painted House",
+			"rating": 4.9,
+			"author_id": "bae-41598f0c-19bc-5da6-813b-e80f14a10df3"
+		}`,
+		`{
+			"name": "A Time for Mercy",
+			"rating": 4.5,
+			"author_id": "bae-41598f0c-19bc-5da6-813b-e80f14a10df3"
+		}`,
+		`{
+			"name": "The Associate",
+			"rating": 4.2,
+			"author_id": "bae-41598f0c-19bc-5da6-813b-e80f14a10df3"
+		}`,
+		`{
+			"name": "Theif Lord",
+			"rating": 4.8,
+			"author_id": "bae-b769708d-f552-5c3d-a402-ccfd7ac7fb04"
+		}`,
+	},
+	//authors
+	1: {
+		// bae-41598f0c-19bc-5da6-813b-e80f14a10df3
+		`{
+			"name": "John Grisham",
+			"age": 65,
+			"verified": true
+		}`,
+		// bae-b769708d-f552-5c3d-a402-ccfd7ac7fb04
+		`{
+			"name": "Cornelia Funke",
+			"age": 62,
+			"verified": false
+		}`,
+	},
+}
+
+func TestQueryOneToManyWithMinMax(t *testing.T) {
+	test := testUtils.RequestTestCase{
+		Description: "One-to-many relation query from many side with min and max",
+		Request: `query {
+				author {
+					name
+					_min(published: {field: rating})
+					_max(published: {field: name})
+				}
+			}`,
+		Docs: minMaxBookAuthorDocs,
+		Results: []map[string]any{
+			{
+				"name": "John Grisham",
+				"_min": 4.2,
+				"_max": "The Associate",
+			},
+			{
+				"name": "Cornelia Funke",
+				"_min": 4.8,
+				"_max": "Theif Lord",
+			},
+		},
+	}
+
+	executeTestCase(t, test)
+}
+
+func TestQueryOneToManyWithMinMaxWithFilter(t *testing.T) {
+	test := testUtils.RequestTestCase{
+		Description: "One-to-many relation query from many side with min and max with filter",
+		Request: `query {
+				author {
+					name
+					_min(published: {field: rating, filter: {rating: {_gt: 4.3}}})
+					_max(published: {field: rating, filter: {name: {_like: "%House%"}}})
+				}
+			}`,
+		Docs: minMaxBookAuthorDocs,
+		Results: []map[string]any{
+			{
+				"name": "John Grisham",
+				"_min": 4.5,
+				"_max": 4.9,
+			},
+			{
+				"name": "Cornelia Funke",
+				"_min": 4.8,
+				"_max": nil,
+			},
+		},
+	}
+
+	executeTestCase(t, test)
+}
+
+func TestQueryOneToManyWithMinMaxWithOrderAndLimit(t *testing.T) {
+	test := testUtils.RequestTestCase{
+		Description: "One-to-many relation query from many side with min and max with order and limit",
+		Request: `query {
+				author {
+					name
+					_min(published: {field: rating, order: {name: DESC}, limit: 1})
+					_max(published: {field: rating, order: {rating: ASC}, limit: 2})
+				}
+			}`,
+		Docs: minMaxBookAuthorDocs,
+		Results: []map[string]any{
+			{
+				"name": "John Grisham",
+				"_min": 4.2,
+				"_max": 4.5,
+			},
+			{
+				"name": "Cornelia Funke",
+				"_min": 4.8,
+				"_max": 4.8,
+			},
+		},
+	}
+
+	executeTestCase(t, test)
+}
@@ -0,0 +1,119 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package one_to_one
+
+import (
+	"testing"
+
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+func TestQueryOneToOneWithNotFilterOnRelation(t *testing.T) {
+	test := testUtils.RequestTestCase{
+		Description: "One-to-one relation query with not filter on related object",
+		Request: `query {
+					book(filter: {_not: {author: {name: {_eq: "John Grisham"}}}}) {
+						name
+					}
+				}`,
+		Docs: map[int][]string{
+			//books
+			0: {
+				// bae-fd541c25-229e-5280-b44b-e5c2af3e374d
+				`{
+					"name": "Painted House",
+					"rating": 4.9
+				}`,
+				// bae-d432bdfb-787d-5a1c-ac29-dc025ab80095
+				`{
+					"name": "Theif Lord",
+					"rating": 4.8
+				}`,
+			},
+			//authors
+			1: {
+				`{
+					"name": "John Grisham",
+					"age": 65,
+					"verified": true,
+					"published_id": "bae-fd541c25-229e-5280-b44b-e5c2af3e374d"
+				}`,
+				`{
+					"name": "Cornelia Funke",
+					"age": 62,
+					"verified": false,
+					"published_id": "bae-d432bdfb-787d-5a1c-ac29-dc025ab80095"
+				}`,
+			},
+		},
+		Results: []map[string]any{
+			{
+				"name": "Theif Lord",
+			},
+		},
+	}
+
+	executeTestCase(t, test)
+}
+
+func TestQueryOneToOneWithNotFilterOnRelationAndParent(t *testing.T) {
+	test := testUtils.RequestTestCase{
+		Description: "One-to-one relation query with not filter over relation and parent fields",
+		Request: `query {
+					book(filter: {_not: {_or: [{author: {age: {_gt: 63}}}, {rating: {_lt: 4.5}}]}}) {
+						name
+						author {
+							name
+						}
+					}
+				}`,
+		Docs: map[int][]string{
+			//books
+			0: {
+				// bae-fd541c25-229e-5280-b44b-e5c2af3e374d
+				`{
+					"name": "Painted House",
+					"rating": 4.9
+				}`,
+				// bae-d432bdfb-787d-5a1c-ac29-dc025ab80095
+				`{
+					"name": "Theif Lord",
+					"rating": 4.8
+				}`,
+			},
+			//authors
+			1: {
+				`{
+					"name": "John Grisham",
+					"age": 65,
+					"verified": true,
+					"published_id": "bae-fd541c25-229e-5280-b44b-e5c2af3e374d"
+				}`,
+				`{
+					"name": "Cornelia Funke",
+					"age": 62,
+					"verified": false,
+					"published_id": "bae-d432bdfb-787d-5a1c-ac29-dc025ab80095"
+				}`,
+			},
+		},
+		Results: []map[string]any{
+			{
+				"name": "Theif Lord",
+				"author": map[string]any{
+					"name": "Cornelia Funke",
+				},
+			},
+		},
+	}
+
+	executeTestCase(t, test)
+}
@@ -0,0 +1,357 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package similar
+
+import (
+	"testing"
+
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+func TestExplainQuerySimilar(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Explain (simple) query with similarity search.",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						Name: String
+						Age: Int
+						Embedding: [Float!] @vector(dimensions: 3)
+					}
+				`,
+			},
+			testUtils.Request{
+				Request: `query @explain {
+					Users(_similar: {field: Embedding, vector: [1, 0, 0], k: 3}) {
+						Name
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"explain": map[string]any{
+							"selectTopNode": map[string]any{
+								"similarNode": map[string]any{
+									"fieldName": "Embedding",
+									"k":         uint64(3),
+									"metric":    "COSINE",
+									"selectNode": map[string]any{
+										"filter": nil,
+										"scanNode": map[string]any{
+											"filter":         nil,
+											"collectionID":   "1",
+											"collectionName": "Users",
+											"spans": []map[string]any{
+												{
+													"start": "/1",
+													"end":   "/2",
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, []string{"Users"}, test)
+}
+
+func TestExecuteExplainQuerySimilar(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Explain (execute) query with similarity search.",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						Name: String
+						Age: Int
+						Embedding: [Float!] @vector(dimensions: 3)
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "John",
+					"Age": 21,
+					"Embedding": [1, 0, 0]
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "Alice",
+					"Age": 32,
+					"Embedding": [3, 4, 0]
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "Bob",
+					"Age": 19,
+					"Embedding": [0, 1, 0]
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "Fred",
+					"Age": 40,
+					"Embedding": [-1, 0, 0]
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "Islam",
+					"Age": 25
+				}`,
+			},
+			testUtils.Request{
+				Request: `query @explain(type: execute) {
+					Users(_similar: {field: Embedding, vector: [1, 0, 0], k: 2}) {
+						Name
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"explain": map[string]any{
+							"executionSuccess": true,
+							"sizeOfResult":     2,
+							"planExecutions":   uint64(3),
+							"selectTopNode": map[string]any{
+								"similarNode": map[string]any{
+									// The document without a vector is not compared.
+									"iterations":   uint64(3),
+									"docsCompared": uint64(4),
+									"selectNode": map[string]any{
+										"iterations":    uint64(6),
+										"filterMatches": uint64(5),
+										"scanNode": map[string]any{
+											"iterations":    uint64(6),
+											"docFetches":    uint64(6),
+											"filterMatches": uint64(5),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, []string{"Users"}, test)
+}
+
+func TestExplainQuerySimilarWithIndex(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Explain (simple) query with similarity search on an indexed vector field.",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						Name: String
+						Age: Int
+						Embedding: [Float!] @vector(dimensions: 3) @index
+					}
+				`,
+			},
+			testUtils.Request{
+				Request: `query @explain {
+					Users(_similar: {field: Embedding, vector: [1, 0, 0], k: 3}) {
+						Name
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"explain": map[string]any{
+							"selectTopNode": map[string]any{
+								"similarNode": map[string]any{
+									"fieldName": "Embedding",
+									"k":         uint64(3),
+									"metric":    "COSINE",
+									"selectNode": map[string]any{
+										"filter": nil,
+										"scanNode": map[string]any{
+											"filter":         nil,
+											"collectionID":   "1",
+											"collectionName": "Users",
+											"spans":          []map[string]any{},
+											"index": map[string]any{
+												"name":      "users_Embedding",
+												"fields":    []string{"Embedding"},
+												"isOrdered": false,
+												"isGrouped": false,
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, []string{"Users"}, test)
+}
+
+func TestExecuteExplainQuerySimilarWithIndex(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Explain (execute) query with similarity search on an indexed vector field.",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						Name: String
+						Age: Int
+						Embedding: [Float!] @vector(dimensions: 3) @index
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "John",
+					"Age": 21,
+					"Embedding": [1, 0, 0]
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "Alice",
+					"Age": 32,
+					"Embedding": [3, 4, 0]
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "Bob",
+					"Age": 19,
+					"Embedding": [0, 1, 0]
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "Fred",
+					"Age": 40,
+					"Embedding": [-1, 0, 0]
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "Islam",
+					"Age": 25
+				}`,
+			},
+			testUtils.Request{
+				Request: `query @explain(type: execute) {
+					Users(_similar: {field: Embedding, vector: [1, 0, 0], k: 2}) {
+						Name
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"explain": map[string]any{
+							"executionSuccess": true,
+							"sizeOfResult":     2,
+							"planExecutions":   uint64(3),
+							"selectTopNode": map[string]any{
+								"similarNode": map[string]any{
+									// Only the nearest documents found by the index are compared.
+									"iterations":   uint64(3),
+									"docsCompared": uint64(2),
+									"selectNode": map[string]any{
+										"iterations":    uint64(2),
+										"filterMatches": uint64(2),
+										"scanNode": map[string]any{
+											"iterations":    uint64(2),
+											"docFetches":    uint64(2),
+											"filterMatches": uint64(2),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, []string{"Users"}, test)
+}
+
+func TestExplainQuerySimilarWithIndexOfOtherMetric(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Explain (simple) query with similarity search by a metric other than that of the index.",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						Name: String
+						Age: Int
+						Embedding: [Float!] @vector(dimensions: 3) @index
+					}
+				`,
+			},
+			testUtils.Request{
+				Request: `query @explain {
+					Users(_similar: {field: Embedding, vector: [1, 0, 0], k: 3, metric: L2}) {
+						Name
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"explain": map[string]any{
+							"selectTopNode": map[string]any{
+								"similarNode": map[string]any{
+									"fieldName": "Embedding",
+									"k":         uint64(3),
+									"metric":    "L2",
+									"selectNode": map[string]any{
+										"filter": nil,
+										"scanNode": map[string]any{
+											"filter":         nil,
+											"collectionID":   "1",
+											"collectionName": "Users",
+											"spans": []map[string]any{
+												{
+													"start": "/1",
+													"end":   "/2",
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, []string{"Users"}, test)
+}
@@ -0,0 +1,768 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package similar
+
+import (
+	"testing"
+
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+func TestQuerySimilarWithCosineMetric(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple query with similarity search, defaulting to cosine distance.",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						Name: String
+						Age: Int
+						Embedding: [Float!] @vector(dimensions: 3)
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "John",
+					"Age": 21,
+					"Embedding": [1, 0, 0]
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "Alice",
+					"Age": 32,
+					"Embedding": [3, 4, 0]
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "Bob",
+					"Age": 19,
+					"Embedding": [0, 1, 0]
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "Fred",
+					"Age": 40,
+					"Embedding": [-1, 0, 0]
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "Islam",
+					"Age": 25
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Users(_similar: {field: Embedding, vector: [1, 0, 0], k: 3}) {
+						Name
+						_distance
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"Name":      "John",
+						"_distance": float64(0),
+					},
+					{
+						"Name":      "Alice",
+						"_distance": 0.4,
+					},
+					{
+						"Name":      "Bob",
+						"_distance": float64(1),
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, []string{"Users"}, test)
+}
+
+func TestQuerySimilarWithL2Metric(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple query with similarity search by euclidean distance.",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						Name: String
+						Age: Int
+						Embedding: [Float!] @vector(dimensions: 3)
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "John",
+					"Age": 21,
+					"Embedding": [1, 0, 0]
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "Alice",
+					"Age": 32,
+					"Embedding": [3, 4, 0]
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "Bob",
+					"Age": 19,
+					"Embedding": [0, 1, 0]
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "Fred",
+					"Age": 40,
+					"Embedding": [-1, 0, 0]
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "Islam",
+					"Age": 25
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Users(_similar: {field: Embedding, vector: [3, 4, 1], k: 2, metric: L2}) {
+						Name
+						_distance
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"Name":      "Alice",
+						"_distance": float64(1),
+					},
+					{
+						"Name":      "Bob",
+						"_distance": 4.358898943540674,
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, []string{"Users"}, test)
+}
+
+func TestQuerySimilarWithKGreaterThanNumberOfDocs(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple query with similarity search, skipping documents without a vector.",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						Name: String
+						Age: Int
+						Embedding: [Float!] @vector(dimensions: 3)
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "John",
+					"Age": 21,
+					"Embedding": [1, 0, 0]
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "Alice",
+					"Age": 32,
+					"Embedding": [3, 4, 0]
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "Bob",
+					"Age": 19,
+					"Embedding": [0, 1, 0]
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "Fred",
+					"Age": 40,
+					"Embedding": [-1, 0, 0]
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "Islam",
+					"Age": 25
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Users(_similar: {field: Embedding, vector: [1, 0, 0], k: 10}) {
+						Name
+					}
+				}`,
+				Results: []map[string]any{
+					{"Name": "John"},
+					{"Name": "Alice"},
+					{"Name": "Bob"},
+					{"Name": "Fred"},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, []string{"Users"}, test)
+}
+
+func TestQuerySimilarWithFilter(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple query with similarity search, searching only the documents matching the filter.",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						Name: String
+						Age: Int
+						Embedding: [Float!] @vector(dimensions: 3)
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "John",
+					"Age": 21,
+					"Embedding": [1, 0, 0]
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "Alice",
+					"Age": 32,
+					"Embedding": [3, 4, 0]
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "Bob",
+					"Age": 19,
+					"Embedding": [0, 1, 0]
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "Fred",
+					"Age": 40,
+					"Embedding": [-1, 0, 0]
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "Islam",
+					"Age": 25
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Users(
+						filter: {Age: {_gt: 20}},
+						_similar: {field: Embedding, vector: [0, 1, 0], k: 2}
+					) {
+						Name
+					}
+				}`,
+				Results: []map[string]any{
+					{"Name": "Alice"},
+					{"Name": "John"},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, []string{"Users"}, test)
+}
+
+func TestQuerySimilarWithOrder(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple query with similarity search, ordering the nearest documents.",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						Name: String
+						Age: Int
+						Embedding: [Float!] @vector(dimensions: 3)
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "John",
+					"Age": 21,
+					"Embedding": [1, 0, 0]
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "Alice",
+					"Age": 32,
+					"Embedding": [3, 4, 0]
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "Bob",
+					"Age": 19,
+					"Embedding": [0, 1, 0]
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "Fred",
+					"Age": 40,
+					"Embedding": [-1, 0, 0]
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "Islam",
+					"Age": 25
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Users(
+						order: {Age: DESC},
+						_similar: {field: Embedding, vector: [1, 0, 0], k: 3}
+					) {
+						Name
+					}
+				}`,
+				Results: []map[string]any{
+					{"Name": "Alice"},
+					{"Name": "John"},
+					{"Name": "Bob"},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, []string{"Users"}, test)
+}
+
+func TestQuerySimilarWithLimit(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple query with similarity search and limit.",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						Name: String
+						Age: Int
+						Embedding: [Float!] @vector(dimensions: 3)
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "John",
+					"Age": 21,
+					"Embedding": [1, 0, 0]
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "Alice",
+					"Age": 32,
+					"Embedding": [3, 4, 0]
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "Bob",
+					"Age": 19,
+					"Embedding": [0, 1, 0]
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "Fred",
+					"Age": 40,
+					"Embedding": [-1, 0, 0]
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "Islam",
+					"Age": 25
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Users(
+						limit: 1,
+						offset: 1,
+						_similar: {field: Embedding, vector: [1, 0, 0], k: 3}
+					) {
+						Name
+					}
+				}`,
+				Results: []map[string]any{
+					{"Name": "Alice"},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, []string{"Users"}, test)
+}
+
+func TestQuerySimilarAfterUpdate(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple query with similarity search after the vector of a document is updated.",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						Name: String
+						Age: Int
+						Embedding: [Float!] @vector(dimensions: 3)
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "John",
+					"Age": 21,
+					"Embedding": [1, 0, 0]
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "Alice",
+					"Age": 32,
+					"Embedding": [3, 4, 0]
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "Bob",
+					"Age": 19,
+					"Embedding": [0, 1, 0]
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "Fred",
+					"Age": 40,
+					"Embedding": [-1, 0, 0]
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "Islam",
+					"Age": 25
+				}`,
+			},
+			testUtils.UpdateDoc{
+				DocID: 3,
+				Doc: `{
+					"Embedding": [2, 0, 0]
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Users(_similar: {field: Embedding, vector: [1, 0, 0], k: 2, metric: L2}) {
+						Name
+					}
+				}`,
+				Results: []map[string]any{
+					{"Name": "John"},
+					{"Name": "Fred"},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, []string{"Users"}, test)
+}
+
+func TestQuerySimilarWithVectorOfWrongDimensions(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple query with similarity search by a vector of the wrong number of dimensions.",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						Name: String
+						Age: Int
+						Embedding: [Float!] @vector(dimensions: 3)
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "John",
+					"Age": 21,
+					"Embedding": [1, 0, 0]
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "Alice",
+					"Age": 32,
+					"Embedding": [3, 4, 0]
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "Bob",
+					"Age": 19,
+					"Embedding": [0, 1, 0]
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "Fred",
+					"Age": 40,
+					"Embedding": [-1, 0, 0]
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "Islam",
+					"Age": 25
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Users(_similar: {field: Embedding, vector: [1, 0], k: 2}) {
+						Name
+					}
+				}`,
+				ExpectedError: "the vector of a similarity search does not have the number of dimensions of the searched field",
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, []string{"Users"}, test)
+}
+
+func TestQueryDistanceWithoutSimilar(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple query selecting the distance without a similarity search.",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						Name: String
+						Age: Int
+						Embedding: [Float!] @vector(dimensions: 3)
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"Name": "John",
+					"Embedding": [1, 0, 0]
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Users {
+						Name
+						_distance
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"Name":      "John",
+						"_distance": nil,
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, []string{"Users"}, test)
+}
+
+func TestCreateWithVectorOfWrongDimensions(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Create of a document with a vector of the wrong number of dimensions.",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						Name: String
+						Age: Int
+						Embedding: [Float!] @vector(dimensions: 3)
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"Name": "John",
+					"Embedding": [1, 0]
+				}`,
+				ExpectedError: "vector value does not have the number of dimensions of its field",
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, []string{"Users"}, test)
+}
+
+func TestUpdateWithVectorOfWrongDimensions(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Update of a document with a vector of the wrong number of dimensions.",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						Name: String
+						Age: Int
+						Embedding: [Float!] @vector(dimensions: 3)
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "John",
+					"Age": 21,
+					"Embedding": [1, 0, 0]
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "Alice",
+					"Age": 32,
+					"Embedding": [3, 4, 0]
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "Bob",
+					"Age": 19,
+					"Embedding": [0, 1, 0]
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "Fred",
+					"Age": 40,
+					"Embedding": [-1, 0, 0]
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "Islam",
+					"Age": 25
+				}`,
+			},
+			testUtils.UpdateDoc{
+				DocID: 0,
+				Doc: `{
+					"Embedding": [1, 0, 0, 0]
+				}`,
+				ExpectedError: "vector value does not have the number of dimensions of its field",
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, []string{"Users"}, test)
+}
+
+func TestSchemaWithVectorOnNonFloatArrayField(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Vector directive on a field that is not a float array.",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						Embedding: [Int!] @vector(dimensions: 3)
+					}
+				`,
+				ExpectedError: "vector fields must be of type [Float!] with a positive number of dimensions",
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, []string{"Users"}, test)
+}
+
+func TestSchemaWithVectorWithoutDimensions(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Vector directive without dimensions.",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						Embedding: [Float!] @vector
+					}
+				`,
+				ExpectedError: "vector fields must be of type [Float!] with a positive number of dimensions",
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, []string{"Users"}, test)
+}
@@ -0,0 +1,569 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package similar
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+func TestQuerySimilarWithIndex(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple query with similarity search on an indexed vector field.",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						Name: String
+						Age: Int
+						Embedding: [Float!] @vector(dimensions: 3) @index
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "John",
+					"Age": 21,
+					"Embedding": [1, 0, 0]
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "Alice",
+					"Age": 32,
+					"Embedding": [3, 4, 0]
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "Bob",
+					"Age": 19,
+					"Embedding": [0, 1, 0]
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "Fred",
+					"Age": 40,
+					"Embedding": [-1, 0, 0]
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "Islam",
+					"Age": 25
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Users(_similar: {field: Embedding, vector: [1, 0, 0], k: 3}) {
+						Name
+						_distance
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"Name":      "John",
+						"_distance": float64(0),
+					},
+					{
+						"Name":      "Alice",
+						"_distance": 0.4,
+					},
+					{
+						"Name":      "Bob",
+						"_distance": float64(1),
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, []string{"Users"}, test)
+}
+
+func TestQuerySimilarWithIndexWithL2Metric(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple query with similarity search on a vector field indexed by euclidean distance.",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						Name: String
+						Age: Int
+						Embedding: [Float!] @vector(dimensions: 3) @index(metric: L2)
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "John",
+					"Age": 21,
+					"Embedding": [1, 0, 0]
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "Alice",
+					"Age": 32,
+					"Embedding": [3, 4, 0]
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "Bob",
+					"Age": 19,
+					"Embedding": [0, 1, 0]
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "Fred",
+					"Age": 40,
+					"Embedding": [-1, 0, 0]
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "Islam",
+					"Age": 25
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Users(_similar: {field: Embedding, vector: [3, 4, 1], k: 2, metric: L2}) {
+						Name
+						_distance
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"Name":      "Alice",
+						"_distance": float64(1),
+					},
+					{
+						"Name":      "Bob",
+						"_distance": 4.358898943540674,
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, []string{"Users"}, test)
+}
+
+func TestQuerySimilarWithIndexWithFilter(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple query with similarity search on an indexed vector field, with a filter.",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						Name: String
+						Age: Int
+						Embedding: [Float!] @vector(dimensions: 3) @index
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "John",
+					"Age": 21,
+					"Embedding": [1, 0, 0]
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "Alice",
+					"Age": 32,
+					"Embedding": [3, 4, 0]
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "Bob",
+					"Age": 19,
+					"Embedding": [0, 1, 0]
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "Fred",
+					"Age": 40,
+					"Embedding": [-1, 0, 0]
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "Islam",
+					"Age": 25
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Users(
+						filter: {Age: {_gt: 20}},
+						_similar: {field: Embedding, vector: [0, 1, 0], k: 2}
+					) {
+						Name
+					}
+				}`,
+				Results: []map[string]any{
+					{"Name": "Alice"},
+					{"Name": "John"},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, []string{"Users"}, test)
+}
+
+func TestQuerySimilarWithIndexAfterUpdate(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple query with similarity search on an indexed vector field after a vector is updated.",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						Name: String
+						Age: Int
+						Embedding: [Float!] @vector(dimensions: 3) @index
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "John",
+					"Age": 21,
+					"Embedding": [1, 0, 0]
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "Alice",
+					"Age": 32,
+					"Embedding": [3, 4, 0]
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "Bob",
+					"Age": 19,
+					"Embedding": [0, 1, 0]
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "Fred",
+					"Age": 40,
+					"Embedding": [-1, 0, 0]
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "Islam",
+					"Age": 25
+				}`,
+			},
+			testUtils.UpdateDoc{
+				DocID: 3,
+				Doc: `{
+					"Embedding": [2, 0, 0]
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Users(_similar: {field: Embedding, vector: [1, 0, 0], k: 2}) {
+						Name
+					}
+				}`,
+				Results: []map[string]any{
+					{"Name": "John"},
+					{"Name": "Fred"},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, []string{"Users"}, test)
+}
+
+func TestQuerySimilarWithIndexAfterDelete(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Simple query with similarity search on an indexed vector field after a document is deleted.",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						Name: String
+						Age: Int
+						Embedding: [Float!] @vector(dimensions: 3) @index
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "John",
+					"Age": 21,
+					"Embedding": [1, 0, 0]
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "Alice",
+					"Age": 32,
+					"Embedding": [3, 4, 0]
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "Bob",
+					"Age": 19,
+					"Embedding": [0, 1, 0]
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "Fred",
+					"Age": 40,
+					"Embedding": [-1, 0, 0]
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "Islam",
+					"Age": 25
+				}`,
+			},
+			testUtils.DeleteDoc{
+				DocID: 0,
+			},
+			testUtils.Request{
+				Request: `query {
+					Users(_similar: {field: Embedding, vector: [1, 0, 0], k: 10}) {
+						Name
+					}
+				}`,
+				Results: []map[string]any{
+					{"Name": "Alice"},
+					{"Name": "Bob"},
+					{"Name": "Fred"},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, []string{"Users"}, test)
+}
+
+func TestQuerySimilarWithIndexWithManyDocs(t *testing.T) {
+	// The documents are spread evenly around a circle, the furthest from the searched vector
+	// being the only ones to pass the filter, such that the index must be searched repeatedly.
+	actions := []any{
+		testUtils.SchemaUpdate{
+			Schema: `
+				type Users {
+					Name: String
+					Age: Int
+					Embedding: [Float!] @vector(dimensions: 3) @index
+				}
+			`,
+		},
+	}
+	for i := 0; i < 100; i++ {
+		angle := 2 * math.Pi * float64(i) / 100
+		actions = append(actions, testUtils.CreateDoc{
+			Doc: fmt.Sprintf(
+				`{"Name": "User%d", "Age": %d, "Embedding": [%f, %f, 0]}`,
+				i,
+				i,
+				math.Cos(angle),
+				math.Sin(angle),
+			),
+		})
+	}
+	actions = append(
+		actions,
+		testUtils.Request{
+			Request: `query {
+				Users(
+					filter: {Age: {_ge: 95}},
+					_similar: {field: Embedding, vector: [-1, 0, 0], k: 3}
+				) {
+					Name
+				}
+			}`,
+			Results: []map[string]any{
+				{"Name": "User95"},
+				{"Name": "User96"},
+				{"Name": "User97"},
+			},
+		},
+		testUtils.Request{
+			Request: `query {
+				Users(_similar: {field: Embedding, vector: [1, 0, 0], k: 3}) {
+					Name
+				}
+			}`,
+			Results: []map[string]any{
+				{"Name": "User0"},
+				{"Name": "User1"},
+				{"Name": "User99"},
+			},
+		},
+	)
+
+	test := testUtils.TestCase{
+		Description: "Simple query with similarity search on an indexed vector field with many documents.",
+		Actions:     actions,
+	}
+
+	testUtils.ExecuteTestCase(t, []string{"Users"}, test)
+}
+
+func TestQuerySimilarWithIndexAfterManyDeletes(t *testing.T) {
+	// Half of the documents, spread evenly around a circle, are deleted, such that the nodes
+	// of the index are relinked repeatedly.
+	actions := []any{
+		testUtils.SchemaUpdate{
+			Schema: `
+				type Users {
+					Name: String
+					Age: Int
+					Embedding: [Float!] @vector(dimensions: 3) @index
+				}
+			`,
+		},
+	}
+	for i := 0; i < 40; i++ {
+		angle := 2 * math.Pi * float64(i) / 40
+		actions = append(actions, testUtils.CreateDoc{
+			Doc: fmt.Sprintf(
+				`{"Name": "User%d", "Age": %d, "Embedding": [%f, %f, 0]}`,
+				i,
+				i,
+				math.Cos(angle),
+				math.Sin(angle),
+			),
+		})
+	}
+	for i := 0; i < 20; i++ {
+		actions = append(actions, testUtils.DeleteDoc{DocID: i})
+	}
+	actions = append(actions, testUtils.Request{
+		Request: `query {
+			Users(_similar: {field: Embedding, vector: [1, 0, 0], k: 3}) {
+				Name
+			}
+		}`,
+		Results: []map[string]any{
+			{"Name": "User39"},
+			{"Name": "User38"},
+			{"Name": "User37"},
+		},
+	})
+
+	test := testUtils.TestCase{
+		Description: "Simple query with similarity search on an indexed vector field after many deletes.",
+		Actions:     actions,
+	}
+
+	testUtils.ExecuteTestCase(t, []string{"Users"}, test)
+}
+
+func TestQuerySimilarWithIndexAfterDeletingAllButOne(t *testing.T) {
+	// Every node but the last is deleted, such that the entry point of the index is
+	// replaced repeatedly.
+	actions := []any{
+		testUtils.SchemaUpdate{
+			Schema: `
+				type Users {
+					Name: String
+					Age: Int
+					Embedding: [Float!] @vector(dimensions: 3) @index
+				}
+			`,
+		},
+	}
+	for i := 0; i < 40; i++ {
+		actions = append(actions, testUtils.CreateDoc{
+			Doc: fmt.Sprintf(`{"Name": "User%d", "Age": %d, "Embedding": [%d, 1, 0]}`, i, i, i),
+		})
+	}
+	for i := 0; i < 39; i++ {
+		actions = append(actions, testUtils.DeleteDoc{DocID: i})
+	}
+	actions = append(actions, testUtils.Request{
+		Request: `query {
+			Users(_similar: {field: Embedding, vector: [1, 0, 0], k: 3}) {
+				Name
+			}
+		}`,
+		Results: []map[string]any{
+			{"Name": "User39"},
+		},
+	})
+
+	test := testUtils.TestCase{
+		Description: "Simple query with similarity search on an indexed vector field after deleting all but one.",
+		Actions:     actions,
+	}
+
+	testUtils.ExecuteTestCase(t, []string{"Users"}, test)
+}
+
+func TestSchemaWithVectorIndexWithUnknownMetric(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Schema with a vector index measured by an unknown metric.",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						Embedding: [Float!] @vector(dimensions: 3) @index(metric: DOT)
+					}
+				`,
+				ExpectedError: "vector indexes must be built from a single vector field",
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, []string{"Users"}, test)
+}
@@ -0,0 +1,249 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package simple
+
+import (
+	"testing"
+
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+func TestQuerySimpleWithDistinct(t *testing.T) {
+	test := testUtils.RequestTestCase{
+		Description: "Simple query with distinct",
+		Request: `query {
+					users(distinct: [Name]) {
+						Name
+					}
+				}`,
+		Docs: map[int][]string{
+			0: {
+				`{
+					"Name": "John",
+					"Age": 21
+				}`,
+				`{
+					"Name": "Bob",
+					"Age": 32
+				}`,
+				`{
+					"Name": "John",
+					"Age": 55
+				}`,
+				`{
+					"Age": 19
+				}`,
+			},
+		},
+		Results: []map[string]any{
+			{
+				"Name": "Bob",
+			},
+			{
+				"Name": nil,
+			},
+			{
+				"Name": "John",
+			},
+		},
+	}
+
+	executeTestCase(t, test)
+}
+
+func TestQuerySimpleWithDistinctOnMultipleFields(t *testing.T) {
+	test := testUtils.RequestTestCase{
+		Description: "Simple query with distinct on multiple fields",
+		Request: `query {
+					users(distinct: [Name, Verified]) {
+						Name
+						Verified
+					}
+				}`,
+		Docs: map[int][]string{
+			0: {
+				`{
+					"Name": "John",
+					"Verified": true
+				}`,
+				`{
+					"Name": "John",
+					"Verified": false
+				}`,
+				`{
+					"Name": "John",
+					"Verified": true,
+					"Age": 55
+				}`,
+			},
+		},
+		Results: []map[string]any{
+			{
+				"Name":     "John",
+				"Verified": false,
+			},
+			{
+				"Name":     "John",
+				"Verified": true,
+			},
+		},
+	}
+
+	executeTestCase(t, test)
+}
+
+func TestQuerySimpleWithDistinctOnUnselectedField(t *testing.T) {
+	test := testUtils.RequestTestCase{
+		Description: "Simple query with distinct on an unselected field",
+		Request: `query {
+					users(distinct: [Name], order: {Age: ASC}) {
+						Age
+					}
+				}`,
+		Docs: map[int][]string{
+			0: {
+				`{
+					"Name": "John",
+					"Age": 21
+				}`,
+				`{
+					"Name": "Bob",
+					"Age": 32
+				}`,
+				`{
+					"Name": "John",
+					"Age": 19
+				}`,
+			},
+		},
+		Results: []map[string]any{
+			{
+				"Age": uint64(19),
+			},
+			{
+				"Age": uint64(32),
+			},
+		},
+	}
+
+	executeTestCase(t, test)
+}
+
+func TestQuerySimpleWithDistinctWithOrderAndLimit(t *testing.T) {
+	test := testUtils.RequestTestCase{
+		Description: "Simple query with distinct, the first document in order is yielded before the limit is applied",
+		Request: `query {
+					users(distinct: [Name], order: {Age: DESC}, limit: 2) {
+						Name
+						Age
+					}
+				}`,
+		Docs: map[int][]string{
+			0: {
+				`{
+					"Name": "John",
+					"Age": 21
+				}`,
+				`{
+					"Name": "Bob",
+					"Age": 32
+				}`,
+				`{
+					"Name": "John",
+					"Age": 55
+				}`,
+				`{
+					"Name": "Alice",
+					"Age": 19
+				}`,
+			},
+		},
+		Results: []map[string]any{
+			{
+				"Name": "John",
+				"Age":  uint64(55),
+			},
+			{
+				"Name": "Bob",
+				"Age":  uint64(32),
+			},
+		},
+	}
+
+	executeTestCase(t, test)
+}
+
+func TestQuerySimpleWithDistinctWithFilter(t *testing.T) {
+	test := testUtils.RequestTestCase{
+		Description: "Simple query with distinct and filter",
+		Request: `query {
+					users(distinct: [Verified], filter: {Age: {_gt: 20}}) {
+						Verified
+					}
+				}`,
+		Docs: map[int][]string{
+			0: {
+				`{
+					"Name": "John",
+					"Age": 21,
+					"Verified": true
+				}`,
+				`{
+					"Name": "Bob",
+					"Age": 32,
+					"Verified": true
+				}`,
+				`{
+					"Name": "Alice",
+					"Age": 19,
+					"Verified": false
+				}`,
+			},
+		},
+		Results: []map[string]any{
+			{
+				"Verified": true,
+			},
+		},
+	}
+
+	executeTestCase(t, test)
+}
+
+func TestQuerySimpleWithDistinctOnEmptyFieldSet(t *testing.T) {
+	test := testUtils.RequestTestCase{
+		Description: "Simple query with distinct on no fields, all documents share the same empty set of values",
+		Request: `query {
+					users(distinct: [], order: {Age: ASC}) {
+						Name
+					}
+				}`,
+		Docs: map[int][]string{
+			0: {
+				`{
+					"Name": "John",
+					"Age": 21
+				}`,
+				`{
+					"Name": "Alice",
+					"Age": 19
+				}`,
+			},
+		},
+		Results: []map[string]any{
+			{
+				"Name": "Alice",
+			},
+		},
+	}
+
+	executeTestCase(t, test)
+}
@@ -0,0 +1,139 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package simple
+
+import (
+	"testing"
+
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+func TestQuerySimpleWithRegexStringFilterBlock(t *testing.T) {
+	test := testUtils.RequestTestCase{
+		Description: "Simple query with basic regex-string filter",
+		Request: `query {
+					users(filter: {Name: {_regex: "^Viserys [IVX]+ "}}) {
+						Name
+					}
+				}`,
+		Docs: map[int][]string{
+			0: {
+				`{
+					"Name": "Daenerys Stormborn of House Targaryen, the First of Her Name",
+					"HeightM": 1.65
+				}`,
+				`{
+					"Name": "Viserys I Targaryen, King of the Andals",
+					"HeightM": 1.82
+				}`,
+			},
+		},
+		Results: []map[string]any{
+			{
+				"Name": "Viserys I Targaryen, King of the Andals",
+			},
+		},
+	}
+
+	executeTestCase(t, test)
+}
+
+func TestQuerySimpleWithRegexStringFilterBlockCaseInsensitive(t *testing.T) {
+	test := testUtils.RequestTestCase{
+		Description: "Simple query with case-insensitive regex-string filter",
+		Request: `query {
+					users(filter: {Name: {_regex: "(?i)STORMBORN"}}) {
+						Name
+					}
+				}`,
+		Docs: map[int][]string{
+			0: {
+				`{
+					"Name": "Daenerys Stormborn of House Targaryen, the First of Her Name",
+					"HeightM": 1.65
+				}`,
+				`{
+					"Name": "Viserys I Targaryen, King of the Andals",
+					"HeightM": 1.82
+				}`,
+			},
+		},
+		Results: []map[string]any{
+			{
+				"Name": "Daenerys Stormborn of House Targaryen, the First of Her Name",
+			},
+		},
+	}
+
+	executeTestCase(t, test)
+}
+
+func TestQuerySimpleWithRegexStringFilterBlockHasNoMatch(t *testing.T) {
+	test := testUtils.RequestTestCase{
+		Description: "Simple query with regex-string filter with no match",
+		Request: `query {
+					users(filter: {Name: {_regex: "^Targaryen"}}) {
+						Name
+					}
+				}`,
+		Docs: map[int][]string{
+			0: {
+				`{
+					"Name": "Daenerys Stormborn of House Targaryen, the First of Her Name",
+					"HeightM": 1.65
+				}`,
+				`{
+					"Name": "Viserys I Targaryen, King of the Andals",
+					"HeightM": 1.82
+				}`,
+			},
+		},
+		Results: []map[string]any{},
+	}
+
+	executeTestCase(t, test)
+}
+
+func TestQuerySimpleWithRegexStringFilterBlockInvalidPattern(t *testing.T) {
+	test := testUtils.RequestTestCase{
+		Description: "Simple query with regex-string filter with invalid pattern",
+		Request: `query {
+					users(filter: {Name: {_regex: "(Targaryen"}}) {
+						Name
+					}
+				}`,
+		Docs: map[int][]string{
+			0: {
+				`{
+					"Name": "Viserys I Targaryen, King of the Andals",
+					"HeightM": 1.82
+				}`,
+			},
+		},
+		ExpectedError: "invalid regular expression",
+	}
+
+	executeTestCase(t, test)
+}
+
+func TestQuerySimpleWithRegexStringFilterBlockInvalidPatternWithNoDocuments(t *testing.T) {
+	test := testUtils.RequestTestCase{
+		Description: "Simple query with regex-string filter with invalid pattern and no documents",
+		Request: `query {
+					users(filter: {Name: {_regex: "(Targaryen"}}) {
+						Name
+					}
+				}`,
+		ExpectedError: "invalid regular expression",
+	}
+
+	executeTestCase(t, test)
+}
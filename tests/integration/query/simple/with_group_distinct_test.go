@@ -0,0 +1,172 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package simple
+
+import (
+	"testing"
+
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+func TestQuerySimpleWithGroupByStringWithInnerGroupDistinct(t *testing.T) {
+	test := testUtils.RequestTestCase{
+		Description: "Simple query with group by string, with distinct on the inner group",
+		Request: `query {
+					users(groupBy: [Name]) {
+						Name
+						_group(distinct: [Age], order: {Verified: ASC}) {
+							Age
+							Verified
+						}
+					}
+				}`,
+		Docs: map[int][]string{
+			0: {
+				`{
+					"Name": "John",
+					"Age": 32,
+					"Verified": true
+				}`,
+				`{
+					"Name": "John",
+					"Age": 32,
+					"Verified": false
+				}`,
+				`{
+					"Name": "John",
+					"Age": 25,
+					"Verified": true
+				}`,
+				`{
+					"Name": "Alice",
+					"Age": 19,
+					"Verified": false
+				}`,
+			},
+		},
+		Results: []map[string]any{
+			{
+				"Name": "John",
+				"_group": []map[string]any{
+					{
+						"Age":      uint64(32),
+						"Verified": false,
+					},
+					{
+						"Age":      uint64(25),
+						"Verified": true,
+					},
+				},
+			},
+			{
+				"Name": "Alice",
+				"_group": []map[string]any{
+					{
+						"Age":      uint64(19),
+						"Verified": false,
+					},
+				},
+			},
+		},
+	}
+
+	executeTestCase(t, test)
+}
+
+func TestQuerySimpleWithGroupByStringWithInnerGroupDistinctAndLimit(t *testing.T) {
+	test := testUtils.RequestTestCase{
+		Description: "Simple query with group by string, with distinct and limit on the inner group",
+		Request: `query {
+					users(groupBy: [Name]) {
+						Name
+						_group(distinct: [Age], order: {Age: ASC}, limit: 1, offset: 1) {
+							Age
+						}
+					}
+				}`,
+		Docs: map[int][]string{
+			0: {
+				`{
+					"Name": "John",
+					"Age": 25
+				}`,
+				`{
+					"Name": "John",
+					"Age": 25
+				}`,
+				`{
+					"Name": "John",
+					"Age": 32
+				}`,
+				`{
+					"Name": "John",
+					"Age": 38
+				}`,
+			},
+		},
+		Results: []map[string]any{
+			{
+				"Name": "John",
+				"_group": []map[string]any{
+					{
+						"Age": uint64(32),
+					},
+				},
+			},
+		},
+	}
+
+	executeTestCase(t, test)
+}
+
+func TestQuerySimpleWithGroupByStringWithoutRenderedGroupAndCountDistinct(t *testing.T) {
+	test := testUtils.RequestTestCase{
+		Description: "Simple query with group by string, count distinct on non-rendered group",
+		Request: `query {
+					users(groupBy: [Name]) {
+						Name
+						_count(_group: {distinct: [Age]})
+					}
+				}`,
+		Docs: map[int][]string{
+			0: {
+				`{
+					"Name": "John",
+					"Age": 32
+				}`,
+				`{
+					"Name": "John",
+					"Age": 32
+				}`,
+				`{
+					"Name": "John",
+					"Age": 25
+				}`,
+				`{
+					"Name": "Alice",
+					"Age": 19
+				}`,
+			},
+		},
+		Results: []map[string]any{
+			{
+				"Name":   "Alice",
+				"_count": 1,
+			},
+			{
+				"Name":   "John",
+				"_count": 2,
+			},
+		},
+	}
+
+	executeTestCase(t, test)
+}
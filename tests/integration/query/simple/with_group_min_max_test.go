@@ -0,0 +1,107 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package simple
+
+import (
+	"testing"
+
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+func TestQuerySimpleWithGroupByStringWithoutRenderedGroupAndChildIntegerMinMax(t *testing.T) {
+	test := testUtils.RequestTestCase{
+		Description: "Simple query with group by string, min and max on non-rendered group integer value",
+		Request: `query {
+					users(groupBy: [Name]) {
+						Name
+						_min(_group: {field: Age})
+						_max(_group: {field: Age})
+					}
+				}`,
+		Docs: map[int][]string{
+			0: {
+				`{
+					"Name": "John",
+					"Age": 32
+				}`,
+				`{
+					"Name": "John",
+					"Age": 38
+				}`,
+				`{
+					"Name": "Alice",
+					"Age": -19
+				}`,
+			},
+		},
+		Results: []map[string]any{
+			{
+				"Name": "John",
+				"_min": uint64(32),
+				"_max": uint64(38),
+			},
+			{
+				"Name": "Alice",
+				"_min": int64(-19),
+				"_max": int64(-19),
+			},
+		},
+	}
+
+	executeTestCase(t, test)
+}
+
+func TestQuerySimpleWithGroupByStringWithoutRenderedGroupAndChildMinMaxWithFilter(t *testing.T) {
+	test := testUtils.RequestTestCase{
+		Description: "Simple query with group by string, min and max with filter on non-rendered group",
+		Request: `query {
+					users(groupBy: [Name]) {
+						Name
+						_min(_group: {field: Age, filter: {Age: {_gt: 26}}})
+						_max(_group: {field: Age, filter: {Age: {_lt: 38}}})
+					}
+				}`,
+		Docs: map[int][]string{
+			0: {
+				`{
+					"Name": "John",
+					"Age": 25
+				}`,
+				`{
+					"Name": "John",
+					"Age": 32
+				}`,
+				`{
+					"Name": "John",
+					"Age": 38
+				}`,
+				`{
+					"Name": "Alice",
+					"Age": 19
+				}`,
+			},
+		},
+		Results: []map[string]any{
+			{
+				"Name": "John",
+				"_min": uint64(32),
+				"_max": uint64(32),
+			},
+			{
+				"Name": "Alice",
+				"_min": nil,
+				"_max": uint64(19),
+			},
+		},
+	}
+
+	executeTestCase(t, test)
+}
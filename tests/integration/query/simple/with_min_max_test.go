@@ -0,0 +1,203 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package simple
+
+import (
+	"testing"
+
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+func TestQuerySimpleWithMinMaxOnEmptyCollection(t *testing.T) {
+	test := testUtils.RequestTestCase{
+		Description: "Simple query, min and max on empty",
+		Request: `query {
+					_min(users: {field: Age})
+					_max(users: {field: Age})
+				}`,
+		Results: []map[string]any{
+			{
+				"_min": nil,
+				"_max": nil,
+			},
+		},
+	}
+
+	executeTestCase(t, test)
+}
+
+func TestQuerySimpleWithMinMaxOnIntField(t *testing.T) {
+	test := testUtils.RequestTestCase{
+		Description: "Simple query, min and max of int field",
+		Request: `query {
+					_min(users: {field: Age})
+					_max(users: {field: Age})
+				}`,
+		Docs: map[int][]string{
+			0: {
+				`{
+					"Name": "John",
+					"Age": 21
+				}`,
+				`{
+					"Name": "Bob",
+					"Age": 32
+				}`,
+				`{
+					"Name": "Alice"
+				}`,
+			},
+		},
+		Results: []map[string]any{
+			{
+				"_min": uint64(21),
+				"_max": uint64(32),
+			},
+		},
+	}
+
+	executeTestCase(t, test)
+}
+
+func TestQuerySimpleWithMinMaxOnStringField(t *testing.T) {
+	test := testUtils.RequestTestCase{
+		Description: "Simple query, min and max of string field",
+		Request: `query {
+					_min(users: {field: Name})
+					_max(users: {field: Name})
+				}`,
+		Docs: map[int][]string{
+			0: {
+				`{
+					"Name": "John"
+				}`,
+				`{
+					"Name": "Bob"
+				}`,
+				`{
+					"Name": "Carlo"
+				}`,
+			},
+		},
+		Results: []map[string]any{
+			{
+				"_min": "Bob",
+				"_max": "John",
+			},
+		},
+	}
+
+	executeTestCase(t, test)
+}
+
+func TestQuerySimpleWithMinMaxOnDateTimeField(t *testing.T) {
+	test := testUtils.RequestTestCase{
+		Description: "Simple query, min and max of datetime field",
+		Request: `query {
+					_min(users: {field: CreatedAt})
+					_max(users: {field: CreatedAt})
+				}`,
+		Docs: map[int][]string{
+			0: {
+				`{
+					"Name": "John",
+					"CreatedAt": "2017-07-23T03:46:56.647Z"
+				}`,
+				`{
+					"Name": "Bob",
+					"CreatedAt": "2011-07-23T03:46:56.647Z"
+				}`,
+				`{
+					"Name": "Carlo",
+					"CreatedAt": "2020-07-23T03:46:56.647Z"
+				}`,
+			},
+		},
+		Results: []map[string]any{
+			{
+				"_min": "2011-07-23T03:46:56.647Z",
+				"_max": "2020-07-23T03:46:56.647Z",
+			},
+		},
+	}
+
+	executeTestCase(t, test)
+}
+
+func TestQuerySimpleWithMinMaxOnStringFieldOfTimes(t *testing.T) {
+	test := testUtils.RequestTestCase{
+		Description: "Simple query, min and max of string field holding times, compared as text",
+		Request: `query {
+					_min(users: {field: Name})
+					_max(users: {field: Name})
+				}`,
+		Docs: map[int][]string{
+			0: {
+				`{
+					"Name": "2020-07-23T10:00:00+05:00"
+				}`,
+				`{
+					"Name": "2020-07-23T06:00:00Z"
+				}`,
+			},
+		},
+		Results: []map[string]any{
+			{
+				"_min": "2020-07-23T06:00:00Z",
+				"_max": "2020-07-23T10:00:00+05:00",
+			},
+		},
+	}
+
+	executeTestCase(t, test)
+}
+
+func TestQuerySimpleWithMinMaxWithFilter(t *testing.T) {
+	test := testUtils.RequestTestCase{
+		Description: "Simple query, min and max of float field with filter",
+		Request: `query {
+					_min(users: {field: HeightM, filter: {Age: {_gt: 20}}})
+					_max(users: {field: HeightM, filter: {Age: {_gt: 20}}})
+				}`,
+		Docs: map[int][]string{
+			0: {
+				`{
+					"Name": "John",
+					"Age": 21,
+					"HeightM": 1.82
+				}`,
+				`{
+					"Name": "Bob",
+					"Age": 32,
+					"HeightM": 1.65
+				}`,
+				`{
+					"Name": "Alice",
+					"Age": 19,
+					"HeightM": 1.59
+				}`,
+				`{
+					"Name": "Carlo",
+					"Age": 55,
+					"HeightM": 1.71
+				}`,
+			},
+		},
+		Results: []map[string]any{
+			{
+				"_min": 1.65,
+				"_max": 1.82,
+			},
+		},
+	}
+
+	executeTestCase(t, test)
+}
@@ -46,14 +46,14 @@ func TestQuerySimpleWithEmbeddedLatestCommit(t *testing.T) {
 				"Age":  uint64(21),
 				"_version": []map[string]any{
 					{
-						"cid": "bafybeiaahzxsfz55nuqnsll42wxrbdjmy5si222l4ydbrwb53tpxnzdmwq",
+						"cid": "bafybeihkrryzo55gekggbllg3ooavqus3dmvpprcrmqjnnguvfx3kq2bai",
 						"links": []map[string]any{
 							{
-								"cid":  "bafybeibelbquwus6f4lek2uclnmt5o525qbg46pzgxkjmrfjg3hwg63r4i",
+								"cid":  "bafybeibpvbimvxte2wld5bqyb6q2ezvqafdek7ymbsltowbkcodaumz5ma",
 								"name": "Age",
 							},
 							{
-								"cid":  "bafybeih7josq7krvco7jc5z2mhiox4dge77zyn3v7gmemfjkkh3xkqwpni",
+								"cid":  "bafybeiegsuufwregisyvv72mdehdqygqnezto4665mnl4biajramzgc6su",
 								"name": "Name",
 							},
 						},
@@ -171,14 +171,14 @@ func TestQuerySimpleWithMultipleAliasedEmbeddedLatestCommit(t *testing.T) {
 				"Age":  uint64(21),
 				"_version": []map[string]any{
 					{
-						"cid": "bafybeiaahzxsfz55nuqnsll42wxrbdjmy5si222l4ydbrwb53tpxnzdmwq",
+						"cid": "bafybeihkrryzo55gekggbllg3ooavqus3dmvpprcrmqjnnguvfx3kq2bai",
 						"L1": []map[string]any{
 							{
-								"cid":  "bafybeibelbquwus6f4lek2uclnmt5o525qbg46pzgxkjmrfjg3hwg63r4i",
+								"cid":  "bafybeibpvbimvxte2wld5bqyb6q2ezvqafdek7ymbsltowbkcodaumz5ma",
 								"name": "Age",
 							},
 							{
-								"cid":  "bafybeih7josq7krvco7jc5z2mhiox4dge77zyn3v7gmemfjkkh3xkqwpni",
+								"cid":  "bafybeiegsuufwregisyvv72mdehdqygqnezto4665mnl4biajramzgc6su",
 								"name": "Name",
 							},
 						},
@@ -61,6 +61,12 @@ func TestSchemaAggregateInlineArrayCreatesUsersCount(t *testing.T) {
 										"type": map[string]any{
 											"name": "users__FavouriteIntegers__CountSelector",
 											"inputFields": []any{
+												map[string]any{
+													"name": "distinct",
+													"type": map[string]any{
+														"name": "Boolean",
+													},
+												},
 												map[string]any{
 													"name": "filter",
 													"type": map[string]any{
@@ -87,6 +93,12 @@ func TestSchemaAggregateInlineArrayCreatesUsersCount(t *testing.T) {
 										"type": map[string]any{
 											"name": "users__CountSelector",
 											"inputFields": []any{
+												map[string]any{
+													"name": "distinct",
+													"type": map[string]any{
+														"name": nil,
+													},
+												},
 												map[string]any{
 													"name": "filter",
 													"type": map[string]any{
@@ -384,59 +396,76 @@ func TestSchemaAggregateInlineArrayCreatesUsersAverage(t *testing.T) {
 	testUtils.ExecuteTestCase(t, []string{"users"}, test)
 }
 
-var aggregateGroupArg = map[string]any{
-	"name": "_group",
-	"type": map[string]any{
-		"name": "users__CountSelector",
-		"inputFields": []any{
-			map[string]any{
-				"name": "filter",
-				"type": map[string]any{
-					"name": "usersFilterArg",
-					"inputFields": []any{
-						map[string]any{
-							"name": "_and",
-							"type": map[string]any{
-								"name": nil,
+// newAggregateGroupArg returns the expected `_group` aggregate argument of the users
+// collection, whose `Favourites` inline array is filtered by the given operator block.
+func newAggregateGroupArg(favouritesOperatorBlock string) map[string]any {
+	return map[string]any{
+		"name": "_group",
+		"type": map[string]any{
+			"name": "users__CountSelector",
+			"inputFields": []any{
+				map[string]any{
+					"name": "distinct",
+					"type": map[string]any{
+						"name":        nil,
+						"inputFields": nil,
+					},
+				},
+				map[string]any{
+					"name": "filter",
+					"type": map[string]any{
+						"name": "usersFilterArg",
+						"inputFields": []any{
+							map[string]any{
+								"name": "Favourites",
+								"type": map[string]any{
+									"name": favouritesOperatorBlock,
+								},
 							},
-						},
-						map[string]any{
-							"name": "_key",
-							"type": map[string]any{
-								"name": "IDOperatorBlock",
+							map[string]any{
+								"name": "_and",
+								"type": map[string]any{
+									"name": nil,
+								},
 							},
-						},
-						map[string]any{
-							"name": "_not",
-							"type": map[string]any{
-								"name": "usersFilterArg",
+							map[string]any{
+								"name": "_key",
+								"type": map[string]any{
+									"name": "IDOperatorBlock",
+								},
 							},
-						},
-						map[string]any{
-							"name": "_or",
-							"type": map[string]any{
-								"name": nil,
+							map[string]any{
+								"name": "_not",
+								"type": map[string]any{
+									"name": "usersFilterArg",
+								},
+							},
+							map[string]any{
+								"name": "_or",
+								"type": map[string]any{
+									"name": nil,
+								},
 							},
 						},
 					},
 				},
-			},
-			map[string]any{
-				"name": "limit",
-				"type": map[string]any{
-					"name":        "Int",
-					"inputFields": nil,
+				map[string]any{
+					"name": "limit",
+					"type": map[string]any{
+						"name":        "Int",
+						"inputFields": nil,
+					},
 				},
-			},
-			map[string]any{
-				"name": "offset",
-				"type": map[string]any{
-					"name":        "Int",
-					"inputFields": nil,
+				map[string]any{
+					"name": "offset",
+					"type": map[string]any{
+						"name":        "Int",
+						"inputFields": nil,
+					},
 				},
 			},
 		},
-	},
+	}
 }
 
 var aggregateVersionArg = map[string]any{
@@ -513,6 +542,13 @@ func TestSchemaAggregateInlineArrayCreatesUsersNillableBooleanCountFilter(t *tes
 										"type": map[string]any{
 											"name": "users__Favourites__CountSelector",
 											"inputFields": []any{
+												map[string]any{
+													"name": "distinct",
+													"type": map[string]any{
+														"name":        "Boolean",
+														"inputFields": nil,
+													},
+												},
 												map[string]any{
 													"name": "filter",
 													"type": map[string]any{
@@ -548,6 +584,12 @@ func TestSchemaAggregateInlineArrayCreatesUsersNillableBooleanCountFilter(t *tes
 																	"name": nil,
 																},
 															},
+															map[string]any{
+																"name": "_not",
+																"type": map[string]any{
+																	"name": "BooleanFilterArg",
+																},
+															},
 															map[string]any{
 																"name": "_or",
 																"type": map[string]any{
@@ -574,7 +616,7 @@ func TestSchemaAggregateInlineArrayCreatesUsersNillableBooleanCountFilter(t *tes
 											},
 										},
 									},
-									aggregateGroupArg,
+									newAggregateGroupArg("BooleanListOperatorBlock"),
 									aggregateVersionArg,
 								},
 							},
@@ -639,6 +681,13 @@ func TestSchemaAggregateInlineArrayCreatesUsersBooleanCountFilter(t *testing.T)
 										"type": map[string]any{
 											"name": "users__Favourites__CountSelector",
 											"inputFields": []any{
+												map[string]any{
+													"name": "distinct",
+													"type": map[string]any{
+														"name":        "Boolean",
+														"inputFields": nil,
+													},
+												},
 												map[string]any{
 													"name": "filter",
 													"type": map[string]any{
@@ -674,6 +723,12 @@ func TestSchemaAggregateInlineArrayCreatesUsersBooleanCountFilter(t *testing.T)
 																	"name": nil,
 																},
 															},
+															map[string]any{
+																"name": "_not",
+																"type": map[string]any{
+																	"name": "NotNullBooleanFilterArg",
+																},
+															},
 															map[string]any{
 																"name": "_or",
 																"type": map[string]any{
@@ -700,7 +755,7 @@ func TestSchemaAggregateInlineArrayCreatesUsersBooleanCountFilter(t *testing.T)
 											},
 										},
 									},
-									aggregateGroupArg,
+									newAggregateGroupArg("NotNullBooleanListOperatorBlock"),
 									aggregateVersionArg,
 								},
 							},
@@ -765,6 +820,13 @@ func TestSchemaAggregateInlineArrayCreatesUsersNillableIntegerCountFilter(t *tes
 										"type": map[string]any{
 											"name": "users__Favourites__CountSelector",
 											"inputFields": []any{
+												map[string]any{
+													"name": "distinct",
+													"type": map[string]any{
+														"name":        "Boolean",
+														"inputFields": nil,
+													},
+												},
 												map[string]any{
 													"name": "filter",
 													"type": map[string]any{
@@ -824,6 +886,12 @@ func TestSchemaAggregateInlineArrayCreatesUsersNillableIntegerCountFilter(t *tes
 																	"name": nil,
 																},
 															},
+															map[string]any{
+																"name": "_not",
+																"type": map[string]any{
+																	"name": "IntFilterArg",
+																},
+															},
 															map[string]any{
 																"name": "_or",
 																"type": map[string]any{
@@ -850,7 +918,7 @@ func TestSchemaAggregateInlineArrayCreatesUsersNillableIntegerCountFilter(t *tes
 											},
 										},
 									},
-									aggregateGroupArg,
+									newAggregateGroupArg("IntListOperatorBlock"),
 									aggregateVersionArg,
 								},
 							},
@@ -915,6 +983,13 @@ func TestSchemaAggregateInlineArrayCreatesUsersIntegerCountFilter(t *testing.T)
 										"type": map[string]any{
 											"name": "users__Favourites__CountSelector",
 											"inputFields": []any{
+												map[string]any{
+													"name": "distinct",
+													"type": map[string]any{
+														"name":        "Boolean",
+														"inputFields": nil,
+													},
+												},
 												map[string]any{
 													"name": "filter",
 													"type": map[string]any{
@@ -974,6 +1049,12 @@ func TestSchemaAggregateInlineArrayCreatesUsersIntegerCountFilter(t *testing.T)
 																	"name": nil,
 																},
 															},
+															map[string]any{
+																"name": "_not",
+																"type": map[string]any{
+																	"name": "NotNullIntFilterArg",
+																},
+															},
 															map[string]any{
 																"name": "_or",
 																"type": map[string]any{
@@ -1000,7 +1081,7 @@ func TestSchemaAggregateInlineArrayCreatesUsersIntegerCountFilter(t *testing.T)
 											},
 										},
 									},
-									aggregateGroupArg,
+									newAggregateGroupArg("NotNullIntListOperatorBlock"),
 									aggregateVersionArg,
 								},
 							},
@@ -1065,6 +1146,13 @@ func TestSchemaAggregateInlineArrayCreatesUsersNillableFloatCountFilter(t *testi
 										"type": map[string]any{
 											"name": "users__Favourites__CountSelector",
 											"inputFields": []any{
+												map[string]any{
+													"name": "distinct",
+													"type": map[string]any{
+														"name":        "Boolean",
+														"inputFields": nil,
+													},
+												},
 												map[string]any{
 													"name": "filter",
 													"type": map[string]any{
@@ -1124,6 +1212,12 @@ func TestSchemaAggregateInlineArrayCreatesUsersNillableFloatCountFilter(t *testi
 																	"name": nil,
 																},
 															},
+															map[string]any{
+																"name": "_not",
+																"type": map[string]any{
+																	"name": "FloatFilterArg",
+																},
+															},
 															map[string]any{
 																"name": "_or",
 																"type": map[string]any{
@@ -1150,7 +1244,7 @@ func TestSchemaAggregateInlineArrayCreatesUsersNillableFloatCountFilter(t *testi
 											},
 										},
 									},
-									aggregateGroupArg,
+									newAggregateGroupArg("FloatListOperatorBlock"),
 									aggregateVersionArg,
 								},
 							},
@@ -1215,6 +1309,13 @@ func TestSchemaAggregateInlineArrayCreatesUsersFloatCountFilter(t *testing.T) {
 										"type": map[string]any{
 											"name": "users__Favourites__CountSelector",
 											"inputFields": []any{
+												map[string]any{
+													"name": "distinct",
+													"type": map[string]any{
+														"name":        "Boolean",
+														"inputFields": nil,
+													},
+												},
 												map[string]any{
 													"name": "filter",
 													"type": map[string]any{
@@ -1274,6 +1375,12 @@ func TestSchemaAggregateInlineArrayCreatesUsersFloatCountFilter(t *testing.T) {
 																	"name": nil,
 																},
 															},
+															map[string]any{
+																"name": "_not",
+																"type": map[string]any{
+																	"name": "NotNullFloatFilterArg",
+																},
+															},
 															map[string]any{
 																"name": "_or",
 																"type": map[string]any{
@@ -1300,7 +1407,7 @@ func TestSchemaAggregateInlineArrayCreatesUsersFloatCountFilter(t *testing.T) {
 											},
 										},
 									},
-									aggregateGroupArg,
+									newAggregateGroupArg("NotNullFloatListOperatorBlock"),
 									aggregateVersionArg,
 								},
 							},
@@ -1365,6 +1472,13 @@ func TestSchemaAggregateInlineArrayCreatesUsersNillableStringCountFilter(t *test
 										"type": map[string]any{
 											"name": "users__Favourites__CountSelector",
 											"inputFields": []any{
+												map[string]any{
+													"name": "distinct",
+													"type": map[string]any{
+														"name":        "Boolean",
+														"inputFields": nil,
+													},
+												},
 												map[string]any{
 													"name": "filter",
 													"type": map[string]any{
@@ -1382,6 +1496,12 @@ func TestSchemaAggregateInlineArrayCreatesUsersNillableStringCountFilter(t *test
 																	"name": "String",
 																},
 															},
+															map[string]any{
+																"name": "_ilike",
+																"type": map[string]any{
+																	"name": "String",
+																},
+															},
 															map[string]any{
 																"name": "_in",
 																"type": map[string]any{
@@ -1394,12 +1514,24 @@ func TestSchemaAggregateInlineArrayCreatesUsersNillableStringCountFilter(t *test
 																	"name": "String",
 																},
 															},
+															map[string]any{
+																"name": "_match",
+																"type": map[string]any{
+																	"name": "String",
+																},
+															},
 															map[string]any{
 																"name": "_ne",
 																"type": map[string]any{
 																	"name": "String",
 																},
 															},
+															map[string]any{
+																"name": "_nilike",
+																"type": map[string]any{
+																	"name": "String",
+																},
+															},
 															map[string]any{
 																"name": "_nin",
 																"type": map[string]any{
@@ -1412,12 +1544,24 @@ func TestSchemaAggregateInlineArrayCreatesUsersNillableStringCountFilter(t *test
 																	"name": "String",
 																},
 															},
+															map[string]any{
+																"name": "_not",
+																"type": map[string]any{
+																	"name": "StringFilterArg",
+																},
+															},
 															map[string]any{
 																"name": "_or",
 																"type": map[string]any{
 																	"name": nil,
 																},
 															},
+															map[string]any{
+																"name": "_regex",
+																"type": map[string]any{
+																	"name": "String",
+																},
+															},
 														},
 													},
 												},
@@ -1438,7 +1582,7 @@ func TestSchemaAggregateInlineArrayCreatesUsersNillableStringCountFilter(t *test
 											},
 										},
 									},
-									aggregateGroupArg,
+									newAggregateGroupArg("StringListOperatorBlock"),
 									aggregateVersionArg,
 								},
 							},
@@ -1503,6 +1647,13 @@ func TestSchemaAggregateInlineArrayCreatesUsersStringCountFilter(t *testing.T) {
 										"type": map[string]any{
 											"name": "users__Favourites__CountSelector",
 											"inputFields": []any{
+												map[string]any{
+													"name": "distinct",
+													"type": map[string]any{
+														"name":        "Boolean",
+														"inputFields": nil,
+													},
+												},
 												map[string]any{
 													"name": "filter",
 													"type": map[string]any{
@@ -1520,6 +1671,12 @@ func TestSchemaAggregateInlineArrayCreatesUsersStringCountFilter(t *testing.T) {
 																	"name": "String",
 																},
 															},
+															map[string]any{
+																"name": "_ilike",
+																"type": map[string]any{
+																	"name": "String",
+																},
+															},
 															map[string]any{
 																"name": "_in",
 																"type": map[string]any{
@@ -1532,12 +1689,24 @@ func TestSchemaAggregateInlineArrayCreatesUsersStringCountFilter(t *testing.T) {
 																	"name": "String",
 																},
 															},
+															map[string]any{
+																"name": "_match",
+																"type": map[string]any{
+																	"name": "String",
+																},
+															},
 															map[string]any{
 																"name": "_ne",
 																"type": map[string]any{
 																	"name": "String",
 																},
 															},
+															map[string]any{
+																"name": "_nilike",
+																"type": map[string]any{
+																	"name": "String",
+																},
+															},
 															map[string]any{
 																"name": "_nin",
 																"type": map[string]any{
@@ -1550,12 +1719,24 @@ func TestSchemaAggregateInlineArrayCreatesUsersStringCountFilter(t *testing.T) {
 																	"name": "String",
 																},
 															},
+															map[string]any{
+																"name": "_not",
+																"type": map[string]any{
+																	"name": "NotNullStringFilterArg",
+																},
+															},
 															map[string]any{
 																"name": "_or",
 																"type": map[string]any{
 																	"name": nil,
 																},
 															},
+															map[string]any{
+																"name": "_regex",
+																"type": map[string]any{
+																	"name": "String",
+																},
+															},
 														},
 													},
 												},
@@ -1576,7 +1757,7 @@ func TestSchemaAggregateInlineArrayCreatesUsersStringCountFilter(t *testing.T) {
 											},
 										},
 									},
-									aggregateGroupArg,
+									newAggregateGroupArg("NotNullStringListOperatorBlock"),
 									aggregateVersionArg,
 								},
 							},
@@ -60,6 +60,12 @@ func TestSchemaAggregateTopLevelCreatesCountGivenSchema(t *testing.T) {
 											"type": map[string]any{
 												"name": "users__CountSelector",
 												"inputFields": []any{
+													map[string]any{
+														"name": "distinct",
+														"type": map[string]any{
+															"name": nil,
+														},
+													},
 													map[string]any{
 														"name": "filter",
 														"type": map[string]any{
@@ -51,3 +51,38 @@ func TestIntrospectionExplainTypeDefined(t *testing.T) {
 
 	testUtils.ExecuteTestCase(t, []string{}, test)
 }
+
+// TestIntrospectionCRDTTypeDefined tests that the introspection query returns a schema that
+// defines the CRDTType enum of the @crdt directive.
+func TestIntrospectionCRDTTypeDefined(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: []any{
+			testUtils.IntrospectionRequest{
+				Request: `
+					query {
+						__schema {
+							types {
+								kind
+								name
+								description
+							}
+						}
+					}
+				`,
+				ContainsData: map[string]any{
+					"__schema": map[string]any{
+						"types": []any{
+							map[string]any{
+								"description": schemaTypes.CRDTEnum.Description(),
+								"kind":        "ENUM",
+								"name":        "CRDTType",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, []string{}, test)
+}
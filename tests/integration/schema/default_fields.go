@@ -113,6 +113,20 @@ var aggregateFields = fields{
 			"name": "Int",
 		},
 	},
+	map[string]any{
+		"name": "_max",
+		"type": map[string]any{
+			"kind": "SCALAR",
+			"name": "JSON",
+		},
+	},
+	map[string]any{
+		"name": "_min",
+		"type": map[string]any{
+			"kind": "SCALAR",
+			"name": "JSON",
+		},
+	},
 	map[string]any{
 		"name": "_sum",
 		"type": map[string]any{
@@ -167,6 +181,18 @@ var groupByArg = Field{
 	},
 }
 
+var distinctArg = Field{
+	"name": "distinct",
+	"type": map[string]any{
+		"name":        nil,
+		"inputFields": nil,
+		"ofType": map[string]any{
+			"kind": "NON_NULL",
+			"name": nil,
+		},
+	},
+}
+
 var limitArg = Field{
 	"name": "limit",
 	"type": map[string]any{
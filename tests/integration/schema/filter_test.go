@@ -136,6 +136,7 @@ var defaultUserArgsWithoutFilter = trimFields(
 		dockeysArg,
 		showDeletedArg,
 		groupByArg,
+		distinctArg,
 		limitArg,
 		offsetArg,
 		buildOrderArg("users", []argDef{
@@ -288,6 +289,7 @@ var defaultBookArgsWithoutFilter = trimFields(
 		dockeysArg,
 		showDeletedArg,
 		groupByArg,
+		distinctArg,
 		limitArg,
 		offsetArg,
 		buildOrderArg("book", []argDef{
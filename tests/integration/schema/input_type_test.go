@@ -180,6 +180,7 @@ var defaultGroupArgsWithoutOrder = trimFields(
 			},
 		}),
 		groupByArg,
+		distinctArg,
 		limitArg,
 		offsetArg,
 	},
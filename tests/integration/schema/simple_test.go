@@ -213,7 +213,7 @@ func TestSchemaSimpleCreatesSchemaGivenTypeWithStringField(t *testing.T) {
 	testUtils.ExecuteTestCase(t, []string{"users"}, test)
 }
 
-func TestSchemaSimpleErrorsGivenNonNullField(t *testing.T) {
+func TestSchemaSimpleCreatesSchemaGivenNonNullField(t *testing.T) {
 	test := testUtils.TestCase{
 		Actions: []any{
 			testUtils.SchemaUpdate{
@@ -222,7 +222,44 @@ func TestSchemaSimpleErrorsGivenNonNullField(t *testing.T) {
 						email: String!
 					}
 				`,
-				ExpectedError: "NonNull fields are not currently supported",
+			},
+			testUtils.IntrospectionRequest{
+				Request: `
+					query {
+						__type (name: "Users") {
+							name
+							fields {
+								name
+								type {
+								name
+								kind
+								ofType {
+									name
+									kind
+								}
+								}
+							}
+						}
+					}
+				`,
+				ContainsData: map[string]any{
+					"__type": map[string]any{
+						"name": "Users",
+						"fields": []any{
+							map[string]any{
+								"name": "email",
+								"type": map[string]any{
+									"name": nil,
+									"kind": "NON_NULL",
+									"ofType": map[string]any{
+										"name": "String",
+										"kind": "SCALAR",
+									},
+								},
+							},
+						},
+					},
+				},
 			},
 		},
 	}
@@ -230,6 +267,27 @@ func TestSchemaSimpleErrorsGivenNonNullField(t *testing.T) {
 	testUtils.ExecuteTestCase(t, []string{"Users"}, test)
 }
 
+func TestSchemaSimpleErrorsGivenNonNullRelationField(t *testing.T) {
+	test := testUtils.TestCase{
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Dogs {
+						name: String
+						owner: Users!
+					}
+					type Users {
+						Dogs: [Dogs]
+					}
+				`,
+				ExpectedError: "NonNull variants for type are not supported. Type: Users",
+			},
+		},
+	}
+
+	testUtils.ExecuteTestCase(t, []string{"Dogs", "Users"}, test)
+}
+
 func TestSchemaSimpleErrorsGivenNonNullManyRelationField(t *testing.T) {
 	test := testUtils.TestCase{
 		Actions: []any{
@@ -0,0 +1,218 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package constraint
+
+import (
+	"testing"
+
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+func TestSchemaUpdatesAddConstraint(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Test schema update, add constraint",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						Name: String
+						Age: Int
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "John",
+					"Age": 200
+				}`,
+			},
+			testUtils.SchemaPatch{
+				Patch: `
+					[
+						{ "op": "add", "path": "/Users/Schema/Constraints", "value": [{"Field": "Age", "Max": 150}] }
+					]
+				`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "Islam",
+					"Age": 151
+				}`,
+				ExpectedError: "Violations: [Age must be at most 150]",
+			},
+			testUtils.Request{
+				// Existing documents are not validated against the new constraint.
+				Request: `query {
+					Users {
+						Name
+						Age
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"Name": "John",
+						"Age":  uint64(200),
+					},
+				},
+			},
+		},
+	}
+	testUtils.ExecuteTestCase(t, []string{"Users"}, test)
+}
+
+func TestSchemaUpdatesReplaceConstraint(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Test schema update, replace bound of constraint",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						Name: String @constraint(maxLength: 4)
+					}
+				`,
+			},
+			testUtils.SchemaPatch{
+				Patch: `
+					[
+						{ "op": "replace", "path": "/Users/Schema/Constraints/0/MaxLength", "value": 8 }
+					]
+				`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "Shahzad"
+				}`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "Fredrick Jr"
+				}`,
+				ExpectedError: "Violations: [Name must have a length of at most 8]",
+			},
+		},
+	}
+	testUtils.ExecuteTestCase(t, []string{"Users"}, test)
+}
+
+func TestSchemaUpdatesRemoveConstraint(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Test schema update, remove constraint",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						Name: String @constraint(pattern: "^[A-Z]")
+					}
+				`,
+			},
+			testUtils.SchemaPatch{
+				Patch: `
+					[
+						{ "op": "remove", "path": "/Users/Schema/Constraints/0" }
+					]
+				`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "john"
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Users {
+						Name
+					}
+				}`,
+				Results: []map[string]any{
+					{"Name": "john"},
+				},
+			},
+		},
+	}
+	testUtils.ExecuteTestCase(t, []string{"Users"}, test)
+}
+
+func TestSchemaUpdatesAddConstraintOfInvalidKindErrors(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Test schema update, add numeric constraint to string field",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						Name: String
+					}
+				`,
+			},
+			testUtils.SchemaPatch{
+				Patch: `
+					[
+						{ "op": "add", "path": "/Users/Schema/Constraints", "value": [{"Field": "Name", "Min": 1}] }
+					]
+				`,
+				ExpectedError: "constraint is not valid for the field. Field: Name",
+			},
+		},
+	}
+	testUtils.ExecuteTestCase(t, []string{"Users"}, test)
+}
+
+func TestSchemaUpdatesAddConstraintWithInvalidPatternErrors(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Test schema update, add constraint with invalid pattern",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						Name: String
+					}
+				`,
+			},
+			testUtils.SchemaPatch{
+				Patch: `
+					[
+						{ "op": "add", "path": "/Users/Schema/Constraints", "value": [{"Field": "Name", "Pattern": "("}] }
+					]
+				`,
+				ExpectedError: "constraint is not valid for the field. Field: Name",
+			},
+		},
+	}
+	testUtils.ExecuteTestCase(t, []string{"Users"}, test)
+}
+
+func TestSchemaUpdatesAddConstraintOfUnknownFieldErrors(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Test schema update, add constraint of unknown field",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						Name: String
+					}
+				`,
+			},
+			testUtils.SchemaPatch{
+				Patch: `
+					[
+						{ "op": "add", "path": "/Users/Schema/Constraints", "value": [{"Field": "Age", "Min": 1}] }
+					]
+				`,
+				ExpectedError: "The given field does not exist. Name: Age",
+			},
+		},
+	}
+	testUtils.ExecuteTestCase(t, []string{"Users"}, test)
+}
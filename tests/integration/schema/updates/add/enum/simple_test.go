@@ -0,0 +1,126 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package enum
+
+import (
+	"testing"
+
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+func TestSchemaUpdatesAddEnumValue(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Test schema update, add value to enum",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					enum Status {
+						OPEN
+						CLOSED
+					}
+
+					type Tickets {
+						Title: String
+						Status: Status
+					}
+				`,
+			},
+			testUtils.SchemaPatch{
+				Patch: `
+					[
+						{ "op": "add", "path": "/Tickets/Schema/Enums/0/Values/-", "value": "BLOCKED" }
+					]
+				`,
+			},
+			testUtils.CreateDoc{
+				Doc: `{
+					"Title": "Upgrade deps",
+					"Status": "BLOCKED"
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Tickets(filter: {Status: {_eq: BLOCKED}}) {
+						Title
+						Status
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"Title":  "Upgrade deps",
+						"Status": "BLOCKED",
+					},
+				},
+			},
+		},
+	}
+	testUtils.ExecuteTestCase(t, []string{"Tickets"}, test)
+}
+
+func TestSchemaUpdatesAddDuplicateEnumValueErrors(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Test schema update, add existing value to enum",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					enum Status {
+						OPEN
+						CLOSED
+					}
+
+					type Tickets {
+						Title: String
+						Status: Status
+					}
+				`,
+			},
+			testUtils.SchemaPatch{
+				Patch: `
+					[
+						{ "op": "add", "path": "/Tickets/Schema/Enums/0/Values/-", "value": "OPEN" }
+					]
+				`,
+				ExpectedError: "enums must have at least one value, and no duplicate values. Enum: Status",
+			},
+		},
+	}
+	testUtils.ExecuteTestCase(t, []string{"Tickets"}, test)
+}
+
+func TestSchemaUpdatesRemoveEnumValueErrors(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Test schema update, remove value from enum",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					enum Status {
+						OPEN
+						CLOSED
+					}
+
+					type Tickets {
+						Title: String
+						Status: Status
+					}
+				`,
+			},
+			testUtils.SchemaPatch{
+				Patch: `
+					[
+						{ "op": "remove", "path": "/Tickets/Schema/Enums/0/Values/0" }
+					]
+				`,
+				ExpectedError: "the values of an enum may be added to, but not removed or reordered. Enum: Status",
+			},
+		},
+	}
+	testUtils.ExecuteTestCase(t, []string{"Tickets"}, test)
+}
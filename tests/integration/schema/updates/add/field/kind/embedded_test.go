@@ -0,0 +1,116 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package kind
+
+import (
+	"testing"
+
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+func TestSchemaUpdatesAddFieldKindEmbeddedWithCreate(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Test schema update, add field with kind embedded object (23) with create",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						Name: String
+					}
+				`,
+			},
+			testUtils.SchemaPatch{
+				Patch: `
+					[
+						{ "op": "add", "path": "/Users/Schema/Fields/-", "value": {"Name": "Foo", "Kind": "Embedded", "Schema": "Bar"} },
+						{ "op": "add", "path": "/Users/Schema/Fields/-", "value": {"Name": "Foo.Baz", "Kind": 11} }
+					]
+				`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "John",
+					"Foo": {
+						"Baz": "qux"
+					}
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Users(filter: {Foo: {Baz: {_eq: "qux"}}}) {
+						Name
+						Foo {
+							Baz
+						}
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"Name": "John",
+						"Foo": map[string]any{
+							"Baz": "qux",
+						},
+					},
+				},
+			},
+		},
+	}
+	testUtils.ExecuteTestCase(t, []string{"Users"}, test)
+}
+
+func TestSchemaUpdatesAddFieldKindEmbeddedWithoutTypeErrors(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Test schema update, add field with kind embedded object (23) without a type",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						Name: String
+					}
+				`,
+			},
+			testUtils.SchemaPatch{
+				Patch: `
+					[
+						{ "op": "add", "path": "/Users/Schema/Fields/-", "value": {"Name": "Foo", "Kind": 23} }
+					]
+				`,
+				ExpectedError: "embedded object fields must name their type, and may not hold relations. Field: Foo",
+			},
+		},
+	}
+	testUtils.ExecuteTestCase(t, []string{"Users"}, test)
+}
+
+func TestSchemaUpdatesAddFieldOfEmbeddedObjectWithoutHostErrors(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Test schema update, add field of an embedded object without its embedded object field",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						Name: String
+					}
+				`,
+			},
+			testUtils.SchemaPatch{
+				Patch: `
+					[
+						{ "op": "add", "path": "/Users/Schema/Fields/-", "value": {"Name": "Foo.Baz", "Kind": 11} }
+					]
+				`,
+				ExpectedError: "no embedded object field found for the given embedded field. Field: Foo.Baz",
+			},
+		},
+	}
+	testUtils.ExecuteTestCase(t, []string{"Users"}, test)
+}
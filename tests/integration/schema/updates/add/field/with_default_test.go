@@ -0,0 +1,123 @@
+// Copyright 2023 Democratized Data Foundation
+//
+// Use of this software is governed by the Business Source License
+// included in the file licenses/BSL.txt.
+//
+// As of the Change Date specified in that file, in accordance with
+// the Business Source License, use of this software will be governed
+// by the Apache License, Version 2.0, included in the file
+// licenses/APL.txt.
+
+package field
+
+import (
+	"testing"
+
+	testUtils "github.com/sourcenetwork/defradb/tests/integration"
+)
+
+func TestSchemaUpdatesAddFieldWithDefaultValueReadByExistingDocs(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Test schema update, add field with default value, read by existing documents",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						Name: String
+					}
+				`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "John"
+				}`,
+			},
+			testUtils.SchemaPatch{
+				Patch: `
+					[
+						{ "op": "add", "path": "/Users/Schema/Fields/-", "value": {"Name": "Email", "Kind": 11, "DefaultValue": "none"} },
+						{ "op": "add", "path": "/Users/Schema/Fields/-", "value": {"Name": "Age", "Kind": 4, "DefaultValue": 18} }
+					]
+				`,
+			},
+			testUtils.CreateDoc{
+				CollectionID: 0,
+				Doc: `{
+					"Name": "Shahzad",
+					"Email": "shahzad@example.com"
+				}`,
+			},
+			testUtils.Request{
+				Request: `query {
+					Users(filter: {Age: {_eq: 18}}) {
+						Name
+						Email
+						Age
+					}
+				}`,
+				Results: []map[string]any{
+					{
+						"Name":  "John",
+						"Email": "none",
+						"Age":   uint64(18),
+					},
+					{
+						"Name":  "Shahzad",
+						"Email": "shahzad@example.com",
+						"Age":   uint64(18),
+					},
+				},
+			},
+		},
+	}
+	testUtils.ExecuteTestCase(t, []string{"Users"}, test)
+}
+
+func TestSchemaUpdatesAddFieldWithDefaultValueOfInvalidTypeErrors(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Test schema update, add field with default value not of the field's kind",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						Name: String
+					}
+				`,
+			},
+			testUtils.SchemaPatch{
+				Patch: `
+					[
+						{ "op": "add", "path": "/Users/Schema/Fields/-", "value": {"Name": "Age", "Kind": 4, "DefaultValue": "eighteen"} }
+					]
+				`,
+				ExpectedError: "default value is not a valid value of the field. Field: Age, Value: \"eighteen\"",
+			},
+		},
+	}
+	testUtils.ExecuteTestCase(t, []string{"Users"}, test)
+}
+
+func TestSchemaUpdatesAddFieldWithDefaultValueOfBlobErrors(t *testing.T) {
+	test := testUtils.TestCase{
+		Description: "Test schema update, add blob field with default value",
+		Actions: []any{
+			testUtils.SchemaUpdate{
+				Schema: `
+					type Users {
+						Name: String
+					}
+				`,
+			},
+			testUtils.SchemaPatch{
+				Patch: `
+					[
+						{ "op": "add", "path": "/Users/Schema/Fields/-", "value": {"Name": "Avatar", "Kind": 14, "DefaultValue": "abc"} }
+					]
+				`,
+				ExpectedError: "default value is not a valid value of the field. Field: Avatar",
+			},
+		},
+	}
+	testUtils.ExecuteTestCase(t, []string{"Users"}, test)
+}
@@ -13,6 +13,7 @@ package tests
 import (
 	"github.com/sourcenetwork/immutable"
 
+	"github.com/sourcenetwork/defradb/client"
 	"github.com/sourcenetwork/defradb/config"
 )
 
@@ -171,6 +172,37 @@ type Request struct {
 	ExpectedError string
 }
 
+// GetBlob represents the reading of the content of a blob from the DAG store of a node.
+type GetBlob struct {
+	// NodeID may hold the ID (index) of a node to read the blob from.
+	//
+	// If a value is not provided the blob will be read from all nodes, in which case
+	// the expected content must match across all nodes.
+	NodeID immutable.Option[int]
+
+	// The CID of the blob to read.
+	CID string
+
+	// The expected content of the blob.
+	Content []byte
+}
+
+// GetUniqueConflicts represents the reading of the conflicts flagged between the documents
+// of a collection holding the same values for the fields of a unique index.
+type GetUniqueConflicts struct {
+	// NodeID may hold the ID (index) of a node to read the conflicts from.
+	//
+	// If a value is not provided the conflicts will be read from all nodes, in which case
+	// the expected conflicts must match across all nodes.
+	NodeID immutable.Option[int]
+
+	// The collection in which the conflicts are flagged.
+	CollectionID int
+
+	// The expected conflicts.
+	Results []client.UniqueConflict
+}
+
 // TransactionRequest2 represents a transactional request.
 //
 // A new transaction will be created for the first TransactionRequest2 of any given
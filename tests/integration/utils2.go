@@ -11,6 +11,7 @@
 package tests
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"os"
@@ -22,11 +23,13 @@ import (
 	"time"
 
 	badger "github.com/dgraph-io/badger/v3"
+	"github.com/ipfs/go-cid"
 	"github.com/sourcenetwork/immutable"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	"github.com/sourcenetwork/defradb/client"
+	"github.com/sourcenetwork/defradb/core"
 	"github.com/sourcenetwork/defradb/datastore"
 	badgerds "github.com/sourcenetwork/defradb/datastore/badger/v3"
 	"github.com/sourcenetwork/defradb/datastore/memory"
@@ -79,6 +82,18 @@ var (
 
 const subscriptionTimeout = 1 * time.Second
 
+// CommitAuthor and CommitTime are the author of the commits of the test cases and the time at
+// which they are made, so that the blocks of the commits, and so their CIDs, are the same on
+// every run and on every node.
+const CommitAuthor = "defradb-tests"
+
+var CommitTime = time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+// NewCommitContext returns a child of the given context carrying the CommitAuthor and CommitTime.
+func NewCommitContext(ctx context.Context) context.Context {
+	return core.WithCommitAuthor(core.WithCommitTime(ctx, CommitTime), CommitAuthor)
+}
+
 var databaseDir string
 var rootDatabaseDir string
 
@@ -285,7 +300,7 @@ func ExecuteTestCase(
 		return
 	}
 
-	ctx := context.Background()
+	ctx := NewCommitContext(context.Background())
 	dbts := GetDatabaseTypes()
 	// Assert that this is not empty to protect against accidental mis-configurations,
 	// otherwise an empty set would silently pass all the tests.
@@ -395,6 +410,12 @@ func executeTestCase(
 		case Request:
 			executeRequest(ctx, t, nodes, testCase, action)
 
+		case GetBlob:
+			getBlob(ctx, t, nodes, testCase, action)
+
+		case GetUniqueConflicts:
+			getUniqueConflicts(ctx, t, collections, testCase, action)
+
 		case IntrospectionRequest:
 			assertIntrospectionResults(ctx, t, testCase.Description, db, action)
 
@@ -425,6 +446,51 @@ func executeTestCase(
 	}
 }
 
+// getBlob reads the content of a blob from the given nodes, asserting that it matches
+// the expected content.
+func getBlob(
+	ctx context.Context,
+	t *testing.T,
+	nodes []*node.Node,
+	testCase TestCase,
+	action GetBlob,
+) {
+	blobCID, err := cid.Decode(action.CID)
+	require.NoError(t, err, testCase.Description)
+
+	for _, node := range getNodes(action.NodeID, nodes) {
+		root, err := datastore.GetBlobRoot(ctx, node.DB.Blockstore(), blobCID)
+		require.NoError(t, err, testCase.Description)
+
+		var content bytes.Buffer
+		err = datastore.WriteBlob(ctx, node.DB.Blockstore(), root, &content)
+		require.NoError(t, err, testCase.Description)
+
+		assert.Equal(t, action.Content, content.Bytes(), testCase.Description)
+	}
+}
+
+// getUniqueConflicts reads the unique conflicts flagged within the given collections, asserting
+// that they match the expected conflicts.
+func getUniqueConflicts(
+	ctx context.Context,
+	t *testing.T,
+	collections [][]client.Collection,
+	testCase TestCase,
+	action GetUniqueConflicts,
+) {
+	for _, nodeCollections := range getNodeCollections(action.NodeID, collections) {
+		conflicts, err := nodeCollections[action.CollectionID].GetUniqueConflicts(ctx)
+		require.NoError(t, err, testCase.Description)
+
+		expected := action.Results
+		if expected == nil {
+			expected = []client.UniqueConflict{}
+		}
+		assert.Equal(t, expected, conflicts, testCase.Description)
+	}
+}
+
 // closeNodes closes all the given nodes, ensuring that resources are properly released.
 func closeNodes(
 	ctx context.Context,